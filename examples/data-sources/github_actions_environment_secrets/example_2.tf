@@ -0,0 +1,12 @@
+# Fail the plan if the environment lacks the secrets the deployment requires.
+data "github_actions_environment_secrets" "production" {
+  name        = "exampleRepo"
+  environment = "production"
+
+  lifecycle {
+    postcondition {
+      condition     = length(setsubtract(["DEPLOY_KEY", "API_TOKEN"], self.secret_names)) == 0
+      error_message = "The production environment lacks required secrets: ${join(", ", setsubtract(["DEPLOY_KEY", "API_TOKEN"], self.secret_names))}."
+    }
+  }
+}
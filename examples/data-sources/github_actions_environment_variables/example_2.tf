@@ -0,0 +1,12 @@
+# Fail the plan if the environment lacks the variables the deployment requires.
+data "github_actions_environment_variables" "production" {
+  name        = "exampleRepo"
+  environment = "production"
+
+  lifecycle {
+    postcondition {
+      condition     = length(setsubtract(["CLUSTER", "REGION"], self.variable_names)) == 0
+      error_message = "The production environment lacks required variables: ${join(", ", setsubtract(["CLUSTER", "REGION"], self.variable_names))}."
+    }
+  }
+}
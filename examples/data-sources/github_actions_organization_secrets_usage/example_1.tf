@@ -0,0 +1,8 @@
+data "github_actions_organization_secrets_usage" "usage" {}
+
+output "deploy_key_blast_radius" {
+  value = one([
+    for secret in data.github_actions_organization_secrets_usage.usage.secrets :
+    secret.repositories if secret.name == "DEPLOY_KEY"
+  ])
+}
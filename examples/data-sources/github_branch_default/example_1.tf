@@ -0,0 +1,3 @@
+data "github_branch_default" "example" {
+  repository = "example"
+}
@@ -0,0 +1,13 @@
+data "github_dependabot_alerts" "critical" {
+  repository = "example"
+  severities = ["critical", "high"]
+}
+
+resource "github_issue" "remediation" {
+  for_each = { for alert in data.github_dependabot_alerts.critical.alerts : alert.number => alert }
+
+  repository = "example"
+  title      = "Upgrade ${each.value.package} to ${coalesce(each.value.first_patched_version, "a fixed version")}"
+  body       = "${each.value.summary}: versions ${each.value.vulnerable_version_range} of ${each.value.package} in `${each.value.manifest_path}` are vulnerable, see ${each.value.html_url}."
+  labels     = ["security"]
+}
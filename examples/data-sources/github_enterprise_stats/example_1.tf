@@ -0,0 +1,9 @@
+data "github_enterprise_stats" "instance" {}
+
+output "capacity" {
+  value = {
+    repositories  = data.github_enterprise_stats.instance.total_repos
+    organizations = data.github_enterprise_stats.instance.total_orgs
+    users         = data.github_enterprise_stats.instance.total_users - data.github_enterprise_stats.instance.suspended_users
+  }
+}
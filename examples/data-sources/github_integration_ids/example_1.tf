@@ -0,0 +1,26 @@
+data "github_integration_ids" "example" {
+  repository = "example"
+}
+
+resource "github_repository_ruleset" "main" {
+  name        = "main"
+  repository  = "example"
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = []
+    }
+  }
+
+  rules {
+    required_status_checks {
+      required_check {
+        context        = "build"
+        integration_id = data.github_integration_ids.example.integration_ids["build"]
+      }
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "github_organization_branch_protection_rules" "all" {}
+
+output "unenforced_rules" {
+  value = [
+    for rule in data.github_organization_branch_protection_rules.all.rules :
+    "${rule.repository}:${rule.pattern}" if !rule.enforce_admins
+  ]
+}
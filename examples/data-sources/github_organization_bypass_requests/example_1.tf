@@ -0,0 +1,3 @@
+data "github_organization_bypass_requests" "push_rules" {
+  status = "all"
+}
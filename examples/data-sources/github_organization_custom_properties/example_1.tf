@@ -0,0 +1,22 @@
+data "github_organization_custom_properties" "all" {}
+
+locals {
+  allowed_environments = one([
+    for property in data.github_organization_custom_properties.all.property :
+    property.allowed_values if property.property_name == "environment"
+  ])
+}
+
+resource "github_repository_custom_property" "environment" {
+  repository     = "example-repository"
+  property_name  = "environment"
+  property_type  = "single_select"
+  property_value = ["production"]
+
+  lifecycle {
+    precondition {
+      condition     = contains(local.allowed_environments, "production")
+      error_message = "The environment property does not allow the production value."
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "github_organization_external_collaborators" "all" {}
+
+output "outside_collaborator_repositories" {
+  value = {
+    for c in data.github_organization_external_collaborators.all.collaborators : c.login => c.repositories
+  }
+}
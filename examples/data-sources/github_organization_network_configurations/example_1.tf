@@ -0,0 +1,7 @@
+data "github_organization_network_configurations" "all" {}
+
+resource "github_actions_runner_group" "private" {
+  name                     = "private-network"
+  visibility               = "all"
+  network_configuration_id = one([for c in data.github_organization_network_configurations.all.network_configurations : c.id if c.name == "azure-vnet"])
+}
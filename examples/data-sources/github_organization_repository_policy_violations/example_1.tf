@@ -0,0 +1,11 @@
+data "github_organization_repository_policy_violations" "governance" {
+  required_topics            = ["terraform-managed"]
+  required_custom_properties = ["cost-center", "owner-team"]
+}
+
+check "repository_governance" {
+  assert {
+    condition     = data.github_organization_repository_policy_violations.governance.compliant
+    error_message = "Repositories missing required metadata: ${join(", ", data.github_organization_repository_policy_violations.governance.violations[*].repository)}"
+  }
+}
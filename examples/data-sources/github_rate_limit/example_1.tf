@@ -0,0 +1,8 @@
+data "github_rate_limit" "current" {}
+
+check "rate_limit" {
+  assert {
+    condition     = data.github_rate_limit.current.core[0].remaining > 500
+    error_message = "Only ${data.github_rate_limit.current.core[0].remaining} GitHub API requests remain until ${data.github_rate_limit.current.core[0].reset}."
+  }
+}
@@ -0,0 +1,14 @@
+data "github_repositories" "payments" {
+  query = "archived:false"
+
+  custom_properties = {
+    team = "payments"
+  }
+}
+
+module "payments_repository" {
+  source   = "./modules/repository"
+  for_each = toset(data.github_repositories.payments.names)
+
+  name = each.value
+}
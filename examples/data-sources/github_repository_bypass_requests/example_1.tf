@@ -0,0 +1,8 @@
+data "github_repository_bypass_requests" "pending" {
+  repository  = "example-repository"
+  bypass_type = "secret_scanning"
+}
+
+output "pending_bypass_requests" {
+  value = [for r in data.github_repository_bypass_requests.pending.bypass_requests : r.html_url]
+}
@@ -0,0 +1,15 @@
+data "github_repository_effective_permission" "example" {
+  repository = "example-repository"
+  username   = "example-user"
+}
+
+# Flag users granted admin access through anything but a team
+check "no_direct_admins" {
+  assert {
+    condition = alltrue([
+      for s in data.github_repository_effective_permission.example.sources :
+      s.type == "team" || s.permission != "admin"
+    ])
+    error_message = "example-user has admin access outside of a team."
+  }
+}
@@ -0,0 +1,8 @@
+data "github_repository_milestones" "example" {
+  repository = "example-repository"
+}
+
+locals {
+  # The open milestone due first
+  next_milestone = data.github_repository_milestones.example.milestones[0].number
+}
@@ -0,0 +1,12 @@
+data "github_repository_popularity" "example" {
+  repository  = "example"
+  max_results = 10
+}
+
+output "popularity" {
+  value = {
+    stars    = data.github_repository_popularity.example.stargazers_count
+    watchers = data.github_repository_popularity.example.watchers_count
+    forks    = data.github_repository_popularity.example.forks_count
+  }
+}
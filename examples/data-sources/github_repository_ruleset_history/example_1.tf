@@ -0,0 +1,14 @@
+data "github_repository_ruleset_history" "example" {
+  repository = "example-repository"
+  ruleset_id = 42
+}
+
+data "github_repository_ruleset_history" "previous" {
+  repository = "example-repository"
+  ruleset_id = 42
+  version_id = data.github_repository_ruleset_history.example.versions[1].version_id
+}
+
+output "previous_ruleset" {
+  value = jsondecode(data.github_repository_ruleset_history.previous.state)
+}
@@ -0,0 +1,21 @@
+data "github_server_capabilities" "current" {}
+
+resource "github_repository_ruleset" "main" {
+  count = data.github_server_capabilities.current.features["repository_rulesets"] ? 1 : 0
+
+  name        = "main"
+  repository  = "example"
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = []
+    }
+  }
+
+  rules {
+    deletion = true
+  }
+}
@@ -0,0 +1,16 @@
+data "github_webhook_signature" "ping" {
+  payload = jsonencode({ zen = "Keep it logically awesome." })
+  secret  = var.webhook_secret
+}
+
+data "http" "webhook_smoke_test" {
+  url    = "https://example.com/webhook"
+  method = "POST"
+
+  request_headers = {
+    Content-Type        = "application/json"
+    X-GitHub-Event      = "ping"
+    X-Hub-Signature-256 = data.github_webhook_signature.ping.signature_256
+  }
+  request_body = jsonencode({ zen = "Keep it logically awesome." })
+}
@@ -0,0 +1,18 @@
+resource "github_repository_environment" "production" {
+  repository  = "example_repository"
+  environment = "production"
+}
+
+resource "github_actions_environment_secrets" "production" {
+  repository  = github_repository_environment.production.repository
+  environment = github_repository_environment.production.environment
+
+  plaintext_secrets = {
+    DATABASE_PASSWORD = var.database_password
+    API_TOKEN         = var.api_token
+  }
+
+  encrypted_secrets = {
+    DEPLOY_KEY = var.deploy_key_encrypted
+  }
+}
@@ -0,0 +1,18 @@
+data "github_repository" "repo" {
+  full_name = "my-org/repo"
+}
+
+resource "github_repository_environment" "repo_environment" {
+  repository  = data.github_repository.repo.name
+  environment = "example_environment"
+}
+
+resource "github_actions_environment_variables" "example_variables" {
+  repository  = data.github_repository.repo.name
+  environment = github_repository_environment.repo_environment.environment
+
+  variables = {
+    DEPLOY_REGION = "eu-west-1"
+    LOG_LEVEL     = "info"
+  }
+}
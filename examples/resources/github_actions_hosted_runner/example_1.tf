@@ -0,0 +1,18 @@
+resource "github_actions_runner_group" "large" {
+  name       = "large-runners"
+  visibility = "all"
+}
+
+resource "github_actions_hosted_runner" "ubuntu_8_core" {
+  name            = "ubuntu-8-core"
+  size            = "8-core"
+  runner_group_id = github_actions_runner_group.large.id
+  maximum_runners = 10
+
+  enable_static_ip = true
+
+  image {
+    id     = "ubuntu-latest"
+    source = "github"
+  }
+}
@@ -0,0 +1,21 @@
+resource "github_organization_network_configuration" "private" {
+  name                 = "azure-private-network"
+  network_settings_ids = [var.azure_network_settings_id]
+}
+
+resource "github_actions_runner_group" "private" {
+  name                     = "private-network-runners"
+  visibility               = "all"
+  network_configuration_id = github_organization_network_configuration.private.id
+}
+
+resource "github_actions_hosted_runner" "ubuntu_4_core" {
+  name            = "ubuntu-4-core-private"
+  size            = "4-core"
+  runner_group_id = github_actions_runner_group.private.id
+  maximum_runners = 5
+
+  image {
+    id = "ubuntu-latest"
+  }
+}
@@ -0,0 +1,20 @@
+# The default template of the organization, used by all its repositories
+# unless they override it.
+resource "github_actions_organization_oidc_subject_claim_customization_template" "default" {
+  include_claim_keys = ["repository_owner_id", "repository_id", "context"]
+}
+
+# A repository using its own template.
+resource "github_actions_repository_oidc_subject_claim_customization_template" "deployments" {
+  repository         = "deployments"
+  use_default        = false
+  include_claim_keys = ["repository_owner_id", "repository_id", "environment", "job_workflow_ref"]
+}
+
+# A repository using the template of the organization.
+resource "github_actions_repository_oidc_subject_claim_customization_template" "service" {
+  repository  = "service"
+  use_default = false
+
+  depends_on = [github_actions_organization_oidc_subject_claim_customization_template.default]
+}
@@ -0,0 +1,9 @@
+resource "github_actions_runner_group" "deployment" {
+  name       = "deployment"
+  visibility = "all"
+
+  restricted_to_workflows = true
+  selected_workflows = [
+    "my-org/my-repository/.github/workflows/deploy.yml@refs/heads/main",
+  ]
+}
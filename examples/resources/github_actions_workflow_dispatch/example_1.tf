@@ -0,0 +1,25 @@
+resource "github_repository" "service" {
+  name = "my-service"
+
+  template {
+    owner      = "my-org"
+    repository = "service-template"
+  }
+}
+
+resource "github_actions_workflow_dispatch" "seed" {
+  repository = github_repository.service.name
+  workflow   = "seed.yml"
+  ref        = github_repository.service.default_branch
+
+  inputs = {
+    environment = "production"
+  }
+
+  wait_for_completion = true
+  timeout             = 900
+}
+
+output "seed_run_url" {
+  value = github_actions_workflow_dispatch.seed.run_url
+}
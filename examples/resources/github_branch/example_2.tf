@@ -0,0 +1,5 @@
+resource "github_branch" "hotfix" {
+  repository = "example"
+  branch     = "hotfix/v1.2.3"
+  source_tag = "v1.2.3"
+}
@@ -22,6 +22,7 @@ resource "github_branch_protection" "example" {
     dismissal_restrictions = [
       data.github_user.example.node_id,
       github_team.example.node_id,
+      data.github_app.example.node_id,
       "/exampleuser",
       "exampleorganization/exampleteam",
     ]
@@ -58,6 +59,10 @@ data "github_user" "example" {
   username = "example"
 }
 
+data "github_app" "example" {
+  slug = "example-app"
+}
+
 resource "github_team" "example" {
   name = "Example Name"
 }
@@ -0,0 +1,20 @@
+# Require the "ci/build" check from a specific GitHub App and the "ci/lint"
+# check from any source.
+
+resource "github_branch_protection_v3" "example" {
+  repository = github_repository.example.name
+  branch     = "main"
+
+  required_status_checks {
+    strict = true
+
+    check {
+      context = "ci/build"
+      app_id  = 15368
+    }
+
+    check {
+      context = "ci/lint"
+    }
+  }
+}
@@ -0,0 +1,34 @@
+resource "github_repository" "example" {
+  name = "test"
+}
+
+resource "github_branch_protections" "example" {
+  repository_id = github_repository.example.node_id
+
+  rule {
+    pattern        = "main"
+    enforce_admins = true
+
+    required_pull_request_reviews {
+      dismiss_stale_reviews           = true
+      required_approving_review_count = 2
+    }
+
+    required_status_checks {
+      strict   = true
+      contexts = ["ci/build"]
+    }
+  }
+
+  dynamic "rule" {
+    for_each = toset(["release/*", "hotfix/*"])
+
+    content {
+      pattern = rule.value
+
+      required_status_checks {
+        contexts = ["ci/build"]
+      }
+    }
+  }
+}
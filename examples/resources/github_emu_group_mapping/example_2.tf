@@ -0,0 +1,4 @@
+resource "github_emu_group_mapping" "platform" {
+  team_slug  = "platform"
+  group_name = "Platform Engineers" # The name of the external group to link
+}
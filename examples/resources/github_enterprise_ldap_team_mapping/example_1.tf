@@ -0,0 +1,8 @@
+resource "github_team" "engineering" {
+  name = "engineering"
+}
+
+resource "github_enterprise_ldap_team_mapping" "engineering" {
+  team_id = github_team.engineering.id
+  ldap_dn = "cn=engineering,ou=groups,dc=example,dc=com"
+}
@@ -0,0 +1,8 @@
+resource "github_repository" "some_repo" {
+  name = "some-repo"
+}
+
+resource "github_organization_app_installation" "ci" {
+  app_slug              = "some-ci-app"
+  selected_repositories = [github_repository.some_repo.name]
+}
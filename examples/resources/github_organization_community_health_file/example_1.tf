@@ -0,0 +1,12 @@
+resource "github_organization_community_health_file" "security" {
+  file = "SECURITY.md"
+  content = templatefile("${path.module}/SECURITY.md.tftpl", {
+    contact = "security@example.com"
+  })
+}
+
+resource "github_organization_community_health_file" "profile" {
+  file           = "profile/README.md"
+  content        = file("${path.module}/profile.md")
+  commit_message = "Update organization profile"
+}
@@ -0,0 +1,11 @@
+data "github_repositories" "platform" {
+  query = "org:my-org topic:platform"
+}
+
+resource "github_organization_custom_property_values" "platform" {
+  property_name  = "team"
+  property_type  = "single_select"
+  property_value = ["platform"]
+  repositories   = data.github_repositories.platform.names
+  authoritative  = true
+}
@@ -0,0 +1,24 @@
+data "github_repositories" "services" {
+  query = "org:my-org topic:service"
+}
+
+resource "github_organization_default_labels" "default" {
+  repositories = data.github_repositories.services.names
+  prune        = true
+
+  label {
+    name        = "bug"
+    color       = "d73a4a"
+    description = "Something isn't working"
+  }
+
+  label {
+    name        = "release"
+    color       = "0e8a16"
+    description = "Part of the next release"
+  }
+}
+
+output "label_drift" {
+  value = github_organization_default_labels.default.drift
+}
@@ -0,0 +1,25 @@
+variable "allowed_outside_collaborators" {
+  type    = set(string)
+  default = []
+}
+
+data "github_organization_external_collaborators" "all" {
+  include_repositories = false
+}
+
+# Remove every outside collaborator not explicitly allowed
+resource "github_organization_external_collaborator" "cleanup" {
+  for_each = setsubtract(
+    toset([for c in data.github_organization_external_collaborators.all.collaborators : c.login]),
+    var.allowed_outside_collaborators,
+  )
+
+  username = each.value
+  action   = "remove"
+}
+
+# Invite a contractor who was hired to the organization
+resource "github_organization_external_collaborator" "hired" {
+  username = "SomeUser"
+  action   = "convert_to_member"
+}
@@ -0,0 +1,10 @@
+resource "github_organization_ip_allowlist_entry" "office" {
+  name             = "Office"
+  allow_list_value = "192.0.2.0/24"
+}
+
+resource "github_organization_ip_allowlist_entry" "ci" {
+  name             = "CI runner"
+  allow_list_value = "198.51.100.7"
+  is_active        = false
+}
@@ -0,0 +1,13 @@
+resource "github_organization_ip_allowlist_entry" "office" {
+  name             = "Office"
+  allow_list_value = "192.0.2.0/24"
+}
+
+# Enable the allow list once its entries exist, so as not to lock out the
+# members of the organization.
+resource "github_organization_ip_allowlist_settings" "example" {
+  enabled                    = true
+  for_installed_apps_enabled = true
+
+  depends_on = [github_organization_ip_allowlist_entry.office]
+}
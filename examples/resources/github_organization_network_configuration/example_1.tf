@@ -0,0 +1,5 @@
+resource "github_organization_network_configuration" "private" {
+  name                 = "azure-private-network"
+  compute_service      = "actions"
+  network_settings_ids = [var.azure_network_settings_id]
+}
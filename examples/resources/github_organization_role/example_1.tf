@@ -0,0 +1,10 @@
+resource "github_organization_role" "example" {
+  name        = "auditor"
+  description = "Reads the audit log and the security alerts of the organization"
+  base_role   = "read"
+
+  permissions = [
+    "read_audit_logs",
+    "read_organization_custom_org_role",
+  ]
+}
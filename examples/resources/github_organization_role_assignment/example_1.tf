@@ -0,0 +1,18 @@
+resource "github_team" "security" {
+  name = "security"
+}
+
+resource "github_organization_role" "auditor" {
+  name        = "auditor"
+  permissions = ["read_audit_logs"]
+}
+
+resource "github_organization_role_assignment" "team" {
+  role_id = github_organization_role.auditor.role_id
+  team    = github_team.security.slug
+}
+
+resource "github_organization_role_assignment" "user" {
+  role_id = github_organization_role.auditor.role_id
+  user    = "octocat"
+}
@@ -0,0 +1,31 @@
+# Protect the default branch of every production repository, based on the
+# value of the `environment` custom property
+resource "github_organization_ruleset" "production" {
+  name        = "production"
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = []
+    }
+
+    repository_property {
+      include {
+        name            = "environment"
+        property_values = ["production"]
+      }
+
+      exclude {
+        name            = "lifecycle"
+        property_values = ["deprecated"]
+      }
+    }
+  }
+
+  rules {
+    deletion         = true
+    non_fast_forward = true
+  }
+}
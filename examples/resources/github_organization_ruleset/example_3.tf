@@ -0,0 +1,27 @@
+# Block pushes of large files, binaries and secrets to every repository
+resource "github_organization_ruleset" "push" {
+  name        = "push"
+  target      = "push"
+  enforcement = "active"
+
+  conditions {
+    repository_name {
+      include = ["~ALL"]
+      exclude = []
+    }
+  }
+
+  rules {
+    file_path_restriction {
+      restricted_file_paths = [".env", "secrets/"]
+    }
+
+    max_file_size {
+      max_file_size = 10
+    }
+
+    file_extension_restriction {
+      restricted_file_extensions = ["*.exe", "*.jar"]
+    }
+  }
+}
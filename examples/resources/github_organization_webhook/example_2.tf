@@ -0,0 +1,12 @@
+resource "github_organization_webhook" "ci" {
+  configuration {
+    url          = "https://ci.example.com/github/webhook"
+    content_type = "form"
+    secret       = var.webhook_secret
+  }
+
+  events = ["push", "pull_request"]
+
+  test_on_create = true
+  test_timeout   = 60
+}
@@ -0,0 +1,7 @@
+resource "github_release" "tool" {
+  repository           = "tool"
+  tag_name             = "v1.4.0"
+  draft                = false
+  prerelease           = false
+  checksums_asset_name = "SHA256SUMS"
+}
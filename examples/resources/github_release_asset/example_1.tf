@@ -0,0 +1,25 @@
+resource "github_release" "example" {
+  repository = "my-repository"
+  tag_name   = "v1.0.0"
+}
+
+resource "github_release_asset" "binary" {
+  repository   = github_release.example.repository
+  release_id   = github_release.example.release_id
+  name         = "app-linux-amd64.tar.gz"
+  source       = "${path.module}/dist/app-linux-amd64.tar.gz"
+  content_type = "application/gzip"
+}
+
+resource "github_release_asset" "notes" {
+  repository     = github_release.example.repository
+  release_id     = github_release.example.release_id
+  name           = "NOTES.txt"
+  label          = "Release notes"
+  content_base64 = base64encode("Built by Terraform\n")
+  content_type   = "text/plain"
+}
+
+output "download_url" {
+  value = github_release_asset.binary.browser_download_url
+}
@@ -0,0 +1,18 @@
+data "github_repository_bypass_requests" "pending" {
+  repository  = "example-repository"
+  bypass_type = "secret_scanning"
+}
+
+# Approve the bypass requests of an emergency change, the reason is recorded
+# with the review.
+resource "github_repository_bypass_request_review" "emergency" {
+  for_each = {
+    for r in data.github_repository_bypass_requests.pending.bypass_requests : r.number => r
+    if r.requester_login == "release-bot"
+  }
+
+  repository            = "example-repository"
+  bypass_request_number = each.value.number
+  status                = "approve"
+  message               = "Emergency change INC-1234"
+}
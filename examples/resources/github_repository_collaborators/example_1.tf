@@ -20,4 +20,8 @@ resource "github_repository_collaborators" "some_repo_collaborators" {
     permission = "pull"
     team_id    = github_team.some_team.slug
   }
+
+  app {
+    slug = "some-app"
+  }
 }
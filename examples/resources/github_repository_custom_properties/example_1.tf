@@ -0,0 +1,23 @@
+resource "github_repository" "example" {
+  name        = "example"
+  description = "My awesome codebase"
+}
+
+resource "github_repository_custom_properties" "example" {
+  repository = github_repository.example.name
+
+  property {
+    property_name  = "team"
+    property_value = ["platform"]
+  }
+
+  property {
+    property_name  = "environments"
+    property_value = ["staging", "production"]
+  }
+
+  property {
+    property_name  = "pci"
+    property_value = ["true"]
+  }
+}
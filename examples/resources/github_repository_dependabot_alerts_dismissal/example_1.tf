@@ -0,0 +1,9 @@
+resource "github_repository_dependabot_alerts_dismissal" "legacy_services" {
+  repository        = "example"
+  ecosystem         = "npm"
+  severities        = ["low", "medium"]
+  manifest_paths    = ["services/legacy-*/package.json"]
+  dismissed_reason  = "tolerable_risk"
+  dismissed_comment = "Accepted until the legacy services are retired, see SEC-123"
+  expires_at        = "2026-12-31T23:59:59Z"
+}
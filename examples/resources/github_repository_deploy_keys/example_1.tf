@@ -0,0 +1,20 @@
+# Generate ssh keys using provider "hashicorp/tls"
+resource "tls_private_key" "example" {
+  for_each  = toset(["ci-1", "ci-2"])
+  algorithm = "ED25519"
+}
+
+# Add the ssh keys as deploy keys, deleting any other deploy key
+resource "github_repository_deploy_keys" "example" {
+  repository = "test-repo"
+  prune      = true
+
+  dynamic "deploy_key" {
+    for_each = tls_private_key.example
+    content {
+      title     = deploy_key.key
+      key       = deploy_key.value.public_key_openssh
+      read_only = true
+    }
+  }
+}
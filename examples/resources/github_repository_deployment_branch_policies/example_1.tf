@@ -0,0 +1,22 @@
+resource "github_repository_environment" "env" {
+  repository  = "my_repo"
+  environment = "production"
+
+  deployment_branch_policy {
+    protected_branches     = false
+    custom_branch_policies = true
+  }
+}
+
+resource "github_repository_deployment_branch_policies" "production" {
+  repository  = github_repository_environment.env.repository
+  environment = github_repository_environment.env.environment
+
+  branch_patterns = [
+    "main",
+    "release/*",
+    "hotfix/*",
+  ]
+
+  tag_patterns = ["v*"]
+}
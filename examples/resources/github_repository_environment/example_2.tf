@@ -0,0 +1,10 @@
+# Require an approval from a reviewer and from a deployment gate app
+resource "github_repository_environment" "production" {
+  environment = "production"
+  repository  = github_repository.example.name
+
+  reviewers {
+    teams = [github_team.release_managers.id]
+    apps  = [123456]
+  }
+}
@@ -0,0 +1,16 @@
+resource "github_repository" "foo" {
+  name      = "tf-acc-test-%s"
+  auto_init = true
+}
+
+resource "github_repository_files" "foo" {
+  repository = github_repository.foo.name
+  branch     = "main"
+  files = {
+    ".gitignore"         = "*.tfstate"
+    ".github/CODEOWNERS" = "* @octo-org/maintainers"
+  }
+  commit_message = "Managed by Terraform"
+  commit_author  = "Terraform User"
+  commit_email   = "terraform@example.com"
+}
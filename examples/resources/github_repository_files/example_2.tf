@@ -0,0 +1,6 @@
+resource "github_repository_files" "config" {
+  repository       = "example"
+  source_directory = "${path.module}/config"
+  target_directory = "config"
+  commit_message   = "Sync configuration"
+}
@@ -0,0 +1,47 @@
+resource "github_repository_issue_form" "bug_report" {
+  repository  = "example"
+  file_name   = "bug_report"
+  name        = "Bug report"
+  description = "File a bug report"
+  title       = "[Bug]: "
+  labels      = ["bug", "triage"]
+
+  body {
+    type  = "markdown"
+    value = "Thanks for taking the time to fill out this bug report!"
+  }
+
+  body {
+    type        = "textarea"
+    id          = "what-happened"
+    label       = "What happened?"
+    description = "Also tell us, what did you expect to happen?"
+    required    = true
+  }
+
+  body {
+    type     = "dropdown"
+    id       = "version"
+    label    = "Version"
+    options  = ["1.0.2 (Default)", "1.0.3 (Edge)"]
+    required = true
+  }
+
+  body {
+    type   = "textarea"
+    id     = "logs"
+    label  = "Relevant log output"
+    render = "shell"
+  }
+
+  body {
+    type  = "checkboxes"
+    id    = "terms"
+    label = "Code of Conduct"
+
+    checkbox {
+      label    = "I agree to follow this project's Code of Conduct"
+      required = true
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "github_repository_pages" "docs" {
+  repository     = "docs"
+  cname          = "docs.example.com"
+  https_enforced = true
+
+  source {
+    branch = "gh-pages"
+    path   = "/"
+  }
+}
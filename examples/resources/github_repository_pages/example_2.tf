@@ -0,0 +1,14 @@
+resource "github_repository" "site" {
+  name       = "site"
+  visibility = "public"
+
+  # The GitHub Pages of the repository are managed by github_repository_pages.
+  lifecycle {
+    ignore_changes = [pages]
+  }
+}
+
+resource "github_repository_pages" "site" {
+  repository = github_repository.site.name
+  build_type = "workflow"
+}
@@ -0,0 +1,11 @@
+resource "github_repository_pull_request" "example" {
+  base_repository = "example-repository"
+  base_ref        = "main"
+  head_ref        = "feature-branch"
+  title           = "My newest feature"
+  body            = "This will change everything"
+
+  wait_for_checks {
+    timeout_minutes = 15
+  }
+}
@@ -0,0 +1,18 @@
+resource "github_repository_pull_request" "release" {
+  base_repository = "example-repository"
+  base_ref        = "main"
+  head_ref        = "release-1.2.0"
+  title           = "Release 1.2.0"
+  body            = "Bumps the version to 1.2.0"
+  draft           = false
+
+  labels         = ["release"]
+  milestone      = 4
+  reviewers      = ["octocat"]
+  team_reviewers = ["release-managers"]
+
+  auto_merge {
+    merge_method    = "squash"
+    commit_headline = "Release 1.2.0"
+  }
+}
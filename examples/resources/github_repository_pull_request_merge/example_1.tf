@@ -0,0 +1,21 @@
+resource "github_repository_pull_request" "bootstrap" {
+  base_repository = "example-repository"
+  base_ref        = "main"
+  head_ref        = "bootstrap"
+  title           = "Bootstrap the repository"
+
+  wait_for_checks {}
+}
+
+resource "github_repository_pull_request_merge" "bootstrap" {
+  repository     = github_repository_pull_request.bootstrap.base_repository
+  number         = github_repository_pull_request.bootstrap.number
+  merge_method   = "squash"
+  commit_title   = "Bootstrap the repository"
+  commit_message = "Merged by Terraform"
+  sha            = github_repository_pull_request.bootstrap.head_sha
+
+  triggers = {
+    head_sha = github_repository_pull_request.bootstrap.head_sha
+  }
+}
@@ -0,0 +1,21 @@
+# Block pushes of large files, binaries and secrets
+resource "github_repository_ruleset" "push" {
+  name        = "push"
+  repository  = github_repository.example.name
+  target      = "push"
+  enforcement = "active"
+
+  rules {
+    file_path_restriction {
+      restricted_file_paths = [".env", "secrets/"]
+    }
+
+    max_file_size {
+      max_file_size = 10
+    }
+
+    file_extension_restriction {
+      restricted_file_extensions = ["*.exe", "*.jar"]
+    }
+  }
+}
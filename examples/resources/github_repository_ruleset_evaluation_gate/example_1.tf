@@ -0,0 +1,10 @@
+resource "github_repository_ruleset_evaluation_gate" "signed_commits" {
+  repository   = "example"
+  ruleset_id   = 42
+  days         = 14
+  max_failures = 0
+
+  triggers = {
+    enforcement = var.signed_commits_enforcement
+  }
+}
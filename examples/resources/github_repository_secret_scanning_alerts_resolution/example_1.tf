@@ -0,0 +1,7 @@
+resource "github_repository_secret_scanning_alerts_resolution" "leaked_tokens" {
+  repository         = "example"
+  secret_types       = ["github_personal_access_token", "aws_access_key_id"]
+  validities         = ["inactive"]
+  resolution         = "revoked"
+  resolution_comment = "Rotated during the incident cleanup, see SEC-456"
+}
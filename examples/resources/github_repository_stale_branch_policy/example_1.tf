@@ -0,0 +1,8 @@
+resource "github_repository_stale_branch_policy" "example" {
+  repository      = "example"
+  pattern         = "feature/**"
+  older_than_days = 90
+
+  # Review the stale_branches attribute before turning this off.
+  dry_run = false
+}
@@ -0,0 +1,18 @@
+resource "github_repository" "service" {
+  name = "service"
+
+  template {
+    owner      = "example-org"
+    repository = "service-template"
+  }
+}
+
+# Keep the CI workflows and code owners of the service in line with the template
+resource "github_repository_template_sync" "service" {
+  repository = github_repository.service.name
+  files = [
+    ".github/CODEOWNERS",
+    ".github/workflows/ci.yml",
+  ]
+  commit_message = "Update from service-template"
+}
@@ -0,0 +1,12 @@
+data "github_external_groups" "example" {}
+
+resource "github_team_sync_group_mapping" "example_external_group" {
+  team_slug     = "example"
+  provider_type = "external_group"
+
+  group {
+    group_id          = data.github_external_groups.example.external_groups[0].group_id
+    group_name        = data.github_external_groups.example.external_groups[0].group_name
+    group_description = "Engineering"
+  }
+}
@@ -0,0 +1,12 @@
+provider "github" {
+  alias = "machine_user"
+  token = var.machine_user_token
+}
+
+resource "github_user_invitations_accepter" "example" {
+  provider = github.machine_user
+
+  owners                          = ["octo-org", "octo-partner"]
+  repository_name_regex           = "^infra-"
+  accept_organization_invitations = true
+}
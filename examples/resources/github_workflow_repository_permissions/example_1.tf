@@ -0,0 +1,16 @@
+resource "github_repository" "example" {
+  name = "my-repository"
+}
+
+resource "github_workflow_repository_permissions" "example" {
+  repository                       = github_repository.example.name
+  default_workflow_permissions     = "read"
+  can_approve_pull_request_reviews = false
+}
+
+# The actions allowed to run in the repository are managed by
+# github_actions_repository_permissions.
+resource "github_actions_repository_permissions" "example" {
+  repository      = github_repository.example.name
+  allowed_actions = "local_only"
+}
@@ -7,11 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
+	"math/rand/v2"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/oauth2"
 )
 
 // GenerateOAuthTokenFromApp generates a GitHub OAuth access token from a set of valid GitHub App credentials.
@@ -31,6 +35,17 @@ func GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, pemData string
 }
 
 func getInstallationAccessToken(baseURL string, jwt string, installationID string) (string, error) {
+	token, err := requestInstallationAccessToken(baseURL, jwt, installationID)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+// requestInstallationAccessToken creates an installation access token, along
+// with its expiry.
+func requestInstallationAccessToken(baseURL string, jwt string, installationID string) (*oauth2.Token, error) {
 	if baseURL != "https://api.github.com/" && !GHECDataResidencyMatch.MatchString(baseURL) {
 		baseURL += "api/v3/"
 	}
@@ -39,7 +54,7 @@ func getInstallationAccessToken(baseURL string, jwt string, installationID strin
 
 	req, err := http.NewRequest(http.MethodPost, url, nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	req.Header.Add("Accept", "application/vnd.github.v3+json")
@@ -47,29 +62,111 @@ func getInstallationAccessToken(baseURL string, jwt string, installationID strin
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	resBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if res.StatusCode != http.StatusCreated {
-		return "", fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
+		return nil, fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
 	}
 
 	resData := struct {
-		Token string `json:"token"`
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
 	}{}
 
 	err = json.Unmarshal(resBytes, &resData)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return &oauth2.Token{AccessToken: resData.Token, Expiry: resData.ExpiresAt}, nil
+}
+
+const (
+	// appInstallationTokenLifetime is the lifetime of installation access
+	// tokens assumed when GitHub does not return their expiry.
+	appInstallationTokenLifetime = time.Hour
+	// appInstallationTokenRefreshMargin is how long before its expiry an
+	// installation access token is refreshed.
+	appInstallationTokenRefreshMargin = 5 * time.Minute
+	// appInstallationTokenRefreshJitter is the maximum random delay added to
+	// the refresh margin, so that concurrent providers do not all refresh
+	// their tokens at once.
+	appInstallationTokenRefreshJitter = time.Minute
+)
+
+// appInstallationTokenSource is an oauth2.TokenSource that caches the access
+// token of a GitHub App installation and transparently refreshes it before it
+// expires, so that applies lasting longer than the token lifetime succeed.
+// It is safe for concurrent use, a single refresh happens at a time.
+type appInstallationTokenSource struct {
+	baseURL        string
+	appID          string
+	installationID string
+	pemData        []byte
+
+	mu        sync.Mutex
+	token     *oauth2.Token
+	refreshAt time.Time
+	now       func() time.Time
+}
+
+// NewAppInstallationTokenSource returns an oauth2.TokenSource of access tokens
+// for a GitHub App installation.
+func NewAppInstallationTokenSource(baseURL, appID, appInstallationID, pemData string) oauth2.TokenSource {
+	return &appInstallationTokenSource{
+		baseURL:        baseURL,
+		appID:          appID,
+		installationID: appInstallationID,
+		pemData:        []byte(pemData),
+		now:            time.Now,
+	}
+}
+
+func (s *appInstallationTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != nil && now.Before(s.refreshAt) {
+		return s.token, nil
+	}
+
+	log.Printf("[DEBUG] Refreshing the access token of GitHub App installation %s", s.installationID)
+	token, err := s.refresh(now)
+	if err != nil {
+		// Keep using the current token while it is still valid, the refresh
+		// is attempted again on the next request.
+		if s.token != nil && now.Before(s.token.Expiry) {
+			log.Printf("[WARN] Failed to refresh the access token of GitHub App installation %s: %s", s.installationID, err)
+			return s.token, nil
+		}
+		return nil, err
+	}
+
+	if token.Expiry.IsZero() {
+		token.Expiry = now.Add(appInstallationTokenLifetime)
+	}
+	jitter := time.Duration(rand.Int64N(int64(appInstallationTokenRefreshJitter)))
+	s.token = token
+	s.refreshAt = token.Expiry.Add(-appInstallationTokenRefreshMargin - jitter)
+
+	return s.token, nil
+}
+
+func (s *appInstallationTokenSource) refresh(now time.Time) (*oauth2.Token, error) {
+	appJWT, err := generateAppJWT(s.appID, now, s.pemData)
+	if err != nil {
+		return nil, err
 	}
 
-	return resData.Token, nil
+	return requestInstallationAccessToken(s.baseURL, appJWT, s.installationID)
 }
 
 func generateAppJWT(appID string, now time.Time, pemData []byte) (string, error) {
@@ -5,7 +5,10 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -169,3 +172,119 @@ func TestGetInstallationAccessToken(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestAppInstallationTokenSource(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour).UTC().Truncate(time.Second)
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: fmt.Sprintf("/api/v3/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ExpectedHeaders: map[string]string{
+				"Accept": "application/vnd.github.v3+json",
+			},
+
+			ResponseBody: fmt.Sprintf(`{"token": "first", "expires_at": "%s"}`, expiresAt.Format(time.RFC3339)),
+			StatusCode:   201,
+		},
+		{
+			ExpectedUri: fmt.Sprintf("/api/v3/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ExpectedHeaders: map[string]string{
+				"Accept": "application/vnd.github.v3+json",
+			},
+
+			ResponseBody: `{"token": "second"}`,
+			StatusCode:   201,
+		},
+	})
+	defer ts.Close()
+
+	source := NewAppInstallationTokenSource(ts.URL+"/", testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData)).(*appInstallationTokenSource)
+	source.now = func() time.Time { return now }
+
+	t.Run("caches the token until it is about to expire", func(t *testing.T) {
+		for range 2 {
+			token, err := source.Token()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if token.AccessToken != "first" {
+				t.Fatalf("Unexpected access token - Found: %s - Expected: first", token.AccessToken)
+			}
+			if !token.Expiry.Equal(expiresAt) {
+				t.Fatalf("Unexpected expiry - Found: %s - Expected: %s", token.Expiry, expiresAt)
+			}
+		}
+	})
+
+	t.Run("refreshes the token before it expires", func(t *testing.T) {
+		source.now = func() time.Time { return expiresAt.Add(-appInstallationTokenRefreshMargin) }
+
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if token.AccessToken != "second" {
+			t.Fatalf("Unexpected access token - Found: %s - Expected: second", token.AccessToken)
+		}
+		if !token.Expiry.Equal(expiresAt.Add(-appInstallationTokenRefreshMargin + appInstallationTokenLifetime)) {
+			t.Fatalf("Unexpected expiry of a token without expires_at: %s", token.Expiry)
+		}
+	})
+
+	t.Run("keeps a valid token if the refresh fails", func(t *testing.T) {
+		previous := source.token
+		source.now = func() time.Time { return previous.Expiry.Add(-time.Second) }
+
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if token != previous {
+			t.Fatalf("Expected the previous token to be kept")
+		}
+	})
+}
+
+func TestAppInstallationTokenSourceHTTPClient(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour).UTC().Truncate(time.Second)
+
+	tokens := []string{"first", "second"}
+	var authorizations []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/app/installations/{id}/access_tokens", func(w http.ResponseWriter, req *http.Request) {
+		token := tokens[0]
+		tokens = tokens[1:]
+		w.WriteHeader(http.StatusCreated)
+		mustWrite(w, fmt.Sprintf(`{"token": "%s", "expires_at": "%s"}`, token, expiresAt.Format(time.RFC3339)))
+	})
+	mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, req *http.Request) {
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		mustWrite(w, `{}`)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	source := NewAppInstallationTokenSource(ts.URL+"/", testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData)).(*appInstallationTokenSource)
+	source.now = func() time.Time { return now }
+	client := (&Config{TokenSource: source}).AuthenticatedHTTPClient()
+
+	get := func() {
+		resp, err := client.Get(ts.URL + "/api/v3/user")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	get()
+	// Within the refresh margin, the token is still valid but is refreshed.
+	source.now = func() time.Time { return expiresAt.Add(-appInstallationTokenRefreshMargin) }
+	get()
+
+	expected := []string{"Bearer first", "Bearer second"}
+	if !slices.Equal(authorizations, expected) {
+		t.Fatalf("Unexpected authorizations - Found: %v - Expected: %v", authorizations, expected)
+	}
+}
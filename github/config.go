@@ -2,11 +2,13 @@ package github
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"net/url"
 	"path"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	github_ratelimit "github.com/gofri/go-github-ratelimit/v2/github_ratelimit"
@@ -18,8 +20,10 @@ import (
 
 type Config struct {
 	Token            string
+	TokenSource      oauth2.TokenSource // refreshes Token, e.g. for GitHub App installations
 	Owner            string
 	BaseURL          string
+	GraphQLURL       string // overrides the GraphQL API endpoint derived from BaseURL
 	Insecure         bool
 	WriteDelay       time.Duration
 	ReadDelay        time.Duration
@@ -28,6 +32,26 @@ type Config struct {
 	MaxRetries       int
 	ParallelRequests bool
 	RateLimiter      string // "modern" or "legacy"
+	MetricsFile      string // path of the API metrics summary, disabled if empty
+	EtagCache        bool   // share GET responses across resources, revalidated with their etag
+	CorrelationID    string // tags every request for the audit log, disabled if empty
+
+	// MaxConcurrentRequests bounds the API calls in flight, unbounded if 0.
+	MaxConcurrentRequests int
+
+	// SkipRefreshResources are the patterns of the resource types whose Read
+	// keeps their state as is.
+	SkipRefreshResources []string
+
+	// BatchRepositoryReads reads the repositories being refreshed through
+	// batched GraphQL queries.
+	BatchRepositoryReads bool
+
+	// RepositoryVisibilityTimeout bounds the wait for freshly created
+	// repositories to be visible before creating resources in them.
+	RepositoryVisibilityTimeout time.Duration
+
+	metrics *MetricsRecorder
 }
 
 type Owner struct {
@@ -37,6 +61,40 @@ type Owner struct {
 	v4client       *githubv4.Client
 	StopContext    context.Context
 	IsOrganization bool
+
+	repositoryVisibilityTimeout time.Duration
+	createdRepositories         sync.Map // repository name and node ID to the time this provider created it
+
+	enterpriseVersionOnce sync.Once
+	enterpriseVersion     string
+	enterpriseVersionErr  error
+
+	teamCache teamCache
+
+	repositoryEnvironments      sync.Map // repository name to *repositoryEnvironmentsEntry
+	repositoryCodeScanningTools sync.Map // repository name to *repositoryCodeScanningToolsEntry
+	releaseChecksums            sync.Map // release ID to *releaseChecksumsEntry
+
+	skipRefreshResources []string
+	importedResources    sync.Map // "<resource type>:<id>" of the resources imported by this run
+
+	repositoryBatcher *repositoryBatcher // nil unless the repository reads are batched
+}
+
+// EnterpriseVersion returns the version of the GitHub Enterprise Server
+// instance the provider is connected to, or an empty string for GitHub.com
+// and GitHub Enterprise Cloud. It is only looked up once.
+func (o *Owner) EnterpriseVersion(ctx context.Context) (string, error) {
+	o.enterpriseVersionOnce.Do(func() {
+		_, resp, err := o.v3client.Meta.Get(ctx)
+		if err != nil {
+			o.enterpriseVersionErr = err
+			return
+		}
+		o.enterpriseVersion = resp.Header.Get("X-GitHub-Enterprise-Version")
+	})
+
+	return o.enterpriseVersion, o.enterpriseVersionErr
 }
 
 // GHECDataResidencyMatch is a regex to match a GitHub Enterprise Cloud data residency URL:
@@ -73,17 +131,18 @@ func ModernRateLimitedHTTPClient(client *http.Client, retryDelay time.Duration,
 }
 
 func (c *Config) AuthenticatedHTTPClient() *http.Client {
-
-	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: c.Token},
-	)
-	client := oauth2.NewClient(ctx, ts)
-
-	if c.RateLimiter == "modern" {
-		return ModernRateLimitedHTTPClient(client, c.RetryDelay, c.RetryableErrors, c.MaxRetries)
+	ts := c.TokenSource
+	if ts == nil {
+		ts = oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: c.Token},
+		)
 	}
-	return LegacyRateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+	// oauth2.NewClient would wrap the token source in an
+	// oauth2.ReuseTokenSource, which only asks for a new token once the
+	// current one expired, bypassing the refresh of app installation tokens.
+	client := &http.Client{Transport: &oauth2.Transport{Source: ts, Base: http.DefaultTransport}}
+
+	return c.rateLimitedHTTPClient(client)
 }
 
 func (c *Config) Anonymous() bool {
@@ -92,17 +151,55 @@ func (c *Config) Anonymous() bool {
 
 func (c *Config) AnonymousHTTPClient() *http.Client {
 	client := &http.Client{Transport: &http.Transport{}}
+	return c.rateLimitedHTTPClient(client)
+}
+
+// rateLimitedHTTPClient wraps client with the configured rate limiter, the
+// etag cache if enabled, the correlation ID if set, the bound of concurrent
+// requests if set and, if a metrics file is set, with the transports
+// recording API metrics.
+func (c *Config) rateLimitedHTTPClient(client *http.Client) *http.Client {
+	if c.CorrelationID != "" {
+		client.Transport = NewCorrelationIDTransport(client.Transport, c.CorrelationID)
+	}
+	if c.MetricsFile != "" && c.metrics == nil {
+		c.metrics = NewMetricsRecorder(c.MetricsFile)
+	}
+	if c.metrics != nil {
+		client.Transport = c.metrics.AttemptTransport(client.Transport)
+	}
+	if c.EtagCache {
+		client.Transport = NewEtagCacheTransport(client.Transport, defaultEtagCacheSize)
+	}
+
 	if c.RateLimiter == "modern" {
-		return ModernRateLimitedHTTPClient(client, c.RetryDelay, c.RetryableErrors, c.MaxRetries)
+		client = ModernRateLimitedHTTPClient(client, c.RetryDelay, c.RetryableErrors, c.MaxRetries)
+	} else {
+		client = LegacyRateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
 	}
-	return LegacyRateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+
+	// The bound applies around the rate limiter and retries, so that API
+	// calls waiting on them keep their slot.
+	if c.MaxConcurrentRequests > 0 {
+		client.Transport = NewConcurrencyLimitTransport(client.Transport, c.MaxConcurrentRequests)
+	}
+
+	if c.metrics != nil {
+		client.Transport = c.metrics.CallTransport(client.Transport)
+	}
+	return client
 }
 
-func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
+// GraphQLEndpoint returns the GraphQL API endpoint, GraphQLURL if set or else
+// derived from BaseURL.
+func (c *Config) GraphQLEndpoint() (string, error) {
+	if c.GraphQLURL != "" {
+		return c.GraphQLURL, nil
+	}
 
 	uv4, err := url.Parse(c.BaseURL)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	if uv4.String() != "https://api.github.com/" && !GHECDataResidencyMatch.MatchString(uv4.String()) {
@@ -111,7 +208,17 @@ func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error)
 		uv4.Path = path.Join(uv4.Path, "graphql")
 	}
 
-	return githubv4.NewEnterpriseClient(uv4.String(), client), nil
+	return uv4.String(), nil
+}
+
+func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
+
+	endpoint, err := c.GraphQLEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	return githubv4.NewEnterpriseClient(endpoint, client), nil
 }
 
 func (c *Config) NewRESTClient(client *http.Client) (*github.Client, error) {
@@ -182,10 +289,21 @@ func (c *Config) Meta() (any, error) {
 		return nil, err
 	}
 
+	graphQLEndpoint, err := c.GraphQLEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] Using the GitHub REST API at %s and the GraphQL API at %s", v3client.BaseURL, graphQLEndpoint)
+
 	var owner Owner
 	owner.v4client = v4client
 	owner.v3client = v3client
 	owner.StopContext = context.Background()
+	owner.repositoryVisibilityTimeout = c.RepositoryVisibilityTimeout
+	owner.skipRefreshResources = c.SkipRefreshResources
+	if c.BatchRepositoryReads {
+		owner.repositoryBatcher = newRepositoryBatcher(v3client, graphQLEndpoint)
+	}
 
 	_, err = c.ConfigureOwner(&owner)
 	if err != nil {
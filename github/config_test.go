@@ -65,6 +65,50 @@ func TestGHECDataResidencyMatch(t *testing.T) {
 	}
 }
 
+func TestConfigGraphQLEndpoint(t *testing.T) {
+	testCases := []struct {
+		baseURL     string
+		graphQLURL  string
+		expected    string
+		description string
+	}{
+		{
+			baseURL:     "https://api.github.com/",
+			expected:    "https://api.github.com/graphql",
+			description: "GitHub.com",
+		},
+		{
+			baseURL:     "https://customer.ghe.com",
+			expected:    "https://customer.ghe.com/graphql",
+			description: "GHEC data residency",
+		},
+		{
+			baseURL:     "https://github.example.com/",
+			expected:    "https://github.example.com/api/graphql",
+			description: "GitHub Enterprise Server",
+		},
+		{
+			baseURL:     "https://github.example.com/",
+			graphQLURL:  "https://graphql.example.com/proxy/graphql",
+			expected:    "https://graphql.example.com/proxy/graphql",
+			description: "GraphQL URL override",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			config := Config{BaseURL: tc.baseURL, GraphQLURL: tc.graphQLURL}
+			endpoint, err := config.GraphQLEndpoint()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if endpoint != tc.expected {
+				t.Errorf("base URL %q and GraphQL URL %q: expected %q, got %q", tc.baseURL, tc.graphQLURL, tc.expected, endpoint)
+			}
+		})
+	}
+}
+
 func TestAccConfigMeta(t *testing.T) {
 
 	// FIXME: Skip test runs during travis lint checking
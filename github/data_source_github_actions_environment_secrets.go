@@ -32,6 +32,12 @@ func dataSourceGithubActionsEnvironmentSecrets() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"secret_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the secrets of the environment, e.g. to check that the secrets a deployment requires exist.",
+			},
 			"secrets": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -90,6 +96,7 @@ func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta
 	}
 
 	var all_secrets []map[string]string
+	secretNames := make([]string, 0)
 	for {
 		secrets, resp, err := client.Actions.ListEnvSecrets(context.TODO(), int(repo.GetID()), escapedEnvName, &options)
 		if err != nil {
@@ -102,6 +109,7 @@ func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta
 				"updated_at": secret.UpdatedAt.String(),
 			}
 			all_secrets = append(all_secrets, new_secret)
+			secretNames = append(secretNames, secret.Name)
 		}
 		if resp.NextPage == 0 {
 			break
@@ -111,6 +119,7 @@ func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta
 
 	d.SetId(buildTwoPartID(repoName, envName))
 	_ = d.Set("secrets", all_secrets)
+	_ = d.Set("secret_names", secretNames)
 
 	return nil
 }
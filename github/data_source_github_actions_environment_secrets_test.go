@@ -42,6 +42,7 @@ func TestAccGithubActionsEnvironmentSecretsDataSource(t *testing.T) {
 		check := resource.ComposeTestCheckFunc(
 			resource.TestCheckResourceAttr("data.github_actions_environment_secrets.test", "name", fmt.Sprintf("tf-acc-test-%s", randomID)),
 			resource.TestCheckResourceAttr("data.github_actions_environment_secrets.test", "secrets.#", "1"),
+			resource.TestCheckResourceAttr("data.github_actions_environment_secrets.test", "secret_names.#", "1"),
 			resource.TestCheckResourceAttr("data.github_actions_environment_secrets.test", "secrets.0.name", "SECRET_1"),
 			resource.TestCheckResourceAttrSet("data.github_actions_environment_secrets.test", "secrets.0.created_at"),
 			resource.TestCheckResourceAttrSet("data.github_actions_environment_secrets.test", "secrets.0.updated_at"),
@@ -32,6 +32,12 @@ func dataSourceGithubActionsEnvironmentVariables() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"variable_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the variables of the environment, e.g. to check that the variables a deployment requires exist.",
+			},
 			"variables": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -89,6 +95,7 @@ func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, met
 	}
 
 	var all_variables []map[string]string
+	variableNames := make([]string, 0)
 	for {
 		variables, resp, err := client.Actions.ListEnvVariables(context.TODO(), owner, repoName, escapedEnvName, &options)
 		if err != nil {
@@ -102,6 +109,7 @@ func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, met
 				"updated_at": variable.UpdatedAt.String(),
 			}
 			all_variables = append(all_variables, new_variable)
+			variableNames = append(variableNames, variable.Name)
 		}
 		if resp.NextPage == 0 {
 			break
@@ -111,6 +119,7 @@ func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, met
 
 	d.SetId(buildTwoPartID(repoName, envName))
 	_ = d.Set("variables", all_variables)
+	_ = d.Set("variable_names", variableNames)
 
 	return nil
 }
@@ -41,6 +41,7 @@ func TestAccGithubActionsEnvironmentVariablesDataSource(t *testing.T) {
 
 		check := resource.ComposeTestCheckFunc(
 			resource.TestCheckResourceAttr("data.github_actions_environment_variables.test", "variables.#", "1"),
+			resource.TestCheckResourceAttr("data.github_actions_environment_variables.test", "variable_names.#", "1"),
 			resource.TestCheckResourceAttr("data.github_actions_environment_variables.test", "variables.0.name", strings.ToUpper("test_variable")),
 			resource.TestCheckResourceAttr("data.github_actions_environment_variables.test", "variables.0.value", "foo"),
 			resource.TestCheckResourceAttrSet("data.github_actions_environment_variables.test", "variables.0.created_at"),
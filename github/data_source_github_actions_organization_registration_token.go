@@ -27,6 +27,10 @@ func dataSourceGithubActionsOrganizationRegistrationToken() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationRegistrationTokenRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
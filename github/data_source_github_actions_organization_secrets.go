@@ -43,6 +43,10 @@ func dataSourceGithubActionsOrganizationSecrets() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationSecretsRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
@@ -0,0 +1,214 @@
+package github
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubActionsOrganizationSecretsUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the repositories with access to each actions secret and variable of the organization",
+		Read:        dataSourceGithubActionsOrganizationSecretsUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether archived repositories are reported. Default: 'false'.",
+			},
+			"secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The actions secrets of the organization and the repositories with access to them.",
+				Elem:        actionsOrganizationSecretUsageResource(),
+			},
+			"variables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The actions variables of the organization and the repositories with access to them.",
+				Elem:        actionsOrganizationSecretUsageResource(),
+			},
+		},
+	}
+}
+
+func actionsOrganizationSecretUsageResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the secret or variable.",
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Visibility of the secret or variable, 'all', 'private' or 'selected'.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the repositories with access to the secret or variable.",
+			},
+		},
+	}
+}
+
+// selectedReposLister lists the repositories selected for an organization
+// secret or variable.
+type selectedReposLister func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+
+func listGithubSelectedRepos(ctx context.Context, list selectedReposLister, owner, name string) ([]string, error) {
+	options := &github.ListOptions{PerPage: maxPerPage}
+
+	var names []string
+	for {
+		repos, resp, err := list(ctx, owner, name, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos.Repositories {
+			names = append(names, repo.GetName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// expandSecretVisibilityRepositories returns the sorted names of the
+// repositories with access to a secret or variable of the given visibility.
+// Secrets of 'private' visibility are available to private and internal
+// repositories, selected repositories are limited to the known ones.
+func expandSecretVisibilityRepositories(visibility string, selected []string, repositories []*github.Repository) []string {
+	names := make([]string, 0)
+	for _, repo := range repositories {
+		switch visibility {
+		case "all":
+			names = append(names, repo.GetName())
+		case "private":
+			if repo.GetVisibility() != "public" {
+				names = append(names, repo.GetName())
+			}
+		case "selected":
+			for _, name := range selected {
+				if name == repo.GetName() {
+					names = append(names, name)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func dataSourceGithubActionsOrganizationSecretsUsageRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	includeArchived := d.Get("include_archived").(bool)
+
+	repoOpts := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	repositories := make([]*github.Repository, 0)
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, owner, repoOpts)
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			if repo.GetArchived() && !includeArchived {
+				continue
+			}
+			repositories = append(repositories, repo)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		repoOpts.Page = resp.NextPage
+	}
+
+	usage := func(name, visibility string, list selectedReposLister) (map[string]any, error) {
+		var selected []string
+		if visibility == "selected" {
+			var err error
+			if selected, err = listGithubSelectedRepos(ctx, list, owner, name); err != nil {
+				return nil, err
+			}
+		}
+		return map[string]any{
+			"name":         name,
+			"visibility":   visibility,
+			"repositories": expandSecretVisibilityRepositories(visibility, selected, repositories),
+		}, nil
+	}
+
+	secrets := make([]any, 0)
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		result, resp, err := client.Actions.ListOrgSecrets(ctx, owner, options)
+		if err != nil {
+			return err
+		}
+		for _, secret := range result.Secrets {
+			secretUsage, err := usage(secret.Name, secret.Visibility, client.Actions.ListSelectedReposForOrgSecret)
+			if err != nil {
+				return err
+			}
+			secrets = append(secrets, secretUsage)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	variables := make([]any, 0)
+	options = &github.ListOptions{PerPage: maxPerPage}
+	for {
+		result, resp, err := client.Actions.ListOrgVariables(ctx, owner, options)
+		if err != nil {
+			return err
+		}
+		for _, variable := range result.Variables {
+			variableUsage, err := usage(variable.Name, variable.GetVisibility(), client.Actions.ListSelectedReposForOrgVariable)
+			if err != nil {
+				return err
+			}
+			variables = append(variables, variableUsage)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(owner)
+	if err := d.Set("secrets", secrets); err != nil {
+		return err
+	}
+	if err := d.Set("variables", variables); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExpandSecretVisibilityRepositories(t *testing.T) {
+	repositories := []*github.Repository{
+		{Name: github.Ptr("web"), Visibility: github.Ptr("public")},
+		{Name: github.Ptr("api"), Visibility: github.Ptr("private")},
+		{Name: github.Ptr("tools"), Visibility: github.Ptr("internal")},
+	}
+
+	testCases := []struct {
+		visibility string
+		selected   []string
+		expected   []string
+	}{
+		{visibility: "all", expected: []string{"api", "tools", "web"}},
+		{visibility: "private", expected: []string{"api", "tools"}},
+		{visibility: "selected", selected: []string{"web", "archived"}, expected: []string{"web"}},
+		{visibility: "selected", expected: []string{}},
+	}
+
+	for _, tc := range testCases {
+		if got := expandSecretVisibilityRepositories(tc.visibility, tc.selected, repositories); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("visibility %s and selected %v: expected %v, got %v", tc.visibility, tc.selected, tc.expected, got)
+		}
+	}
+}
+
+func TestAccGithubActionsOrganizationSecretsUsageDataSource(t *testing.T) {
+
+	t.Run("reports the repositories with access to a secret", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_actions_organization_secret" "test" {
+				secret_name             = "TF_ACC_TEST_%[1]s"
+				plaintext_value         = "secret"
+				visibility              = "selected"
+				selected_repository_ids = [github_repository.test.repo_id]
+			}
+
+			data "github_actions_organization_secrets_usage" "test" {
+				depends_on = [github_actions_organization_secret.test]
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckTypeSetElemNestedAttrs("data.github_actions_organization_secrets_usage.test", "secrets.*", map[string]string{
+				"name":           fmt.Sprintf("TF_ACC_TEST_%s", randomID),
+				"visibility":     "selected",
+				"repositories.#": "1",
+				"repositories.0": fmt.Sprintf("tf-acc-test-%s", randomID),
+			}),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -47,6 +47,10 @@ func dataSourceGithubActionsOrganizationVariables() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationVariablesRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
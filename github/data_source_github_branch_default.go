@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubBranchDefault() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about the default branch of a repository.",
+		Read:        dataSourceGithubBranchDefaultRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the default branch of the repository.",
+			},
+			"sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit the default branch points to.",
+			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the default branch is protected by a branch protection rule or a ruleset.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubBranchDefaultRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	branch, _, err := client.Repositories.GetBranch(ctx, owner, repoName, repo.GetDefaultBranch(), 1)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+	if err = d.Set("branch", branch.GetName()); err != nil {
+		return err
+	}
+	if err = d.Set("sha", branch.GetCommit().GetSHA()); err != nil {
+		return err
+	}
+	if err = d.Set("protected", branch.GetProtected()); err != nil {
+		return err
+	}
+
+	return nil
+}
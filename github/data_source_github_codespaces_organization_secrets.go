@@ -43,6 +43,10 @@ func dataSourceGithubCodespacesOrganizationSecrets() *schema.Resource {
 }
 
 func dataSourceGithubCodespacesOrganizationSecretsRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
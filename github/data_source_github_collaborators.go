@@ -114,6 +114,10 @@ func dataSourceGithubCollaborators() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -214,7 +218,13 @@ func flattenGitHubCollaborators(collaborators []*github.User) ([]any, error) {
 		result["received_events_url"] = c.GetReceivedEventsURL()
 		result["type"] = c.GetType()
 		result["site_admin"] = c.GetSiteAdmin()
-		result["permission"] = getPermission(c.GetRoleName())
+		// The permission is the base permission of the role, which may be a
+		// custom role
+		result["permission"] = getBasePermission(c.Permissions)
+		if result["permission"] == "" {
+			result["permission"] = getPermission(c.GetRoleName())
+		}
+		result["role_name"] = c.GetRoleName()
 
 		results = append(results, result)
 	}
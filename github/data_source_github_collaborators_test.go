@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -84,3 +85,27 @@ data "github_collaborators" "test" {
 }
 `, repo, testOwner)
 }
+
+func TestFlattenGitHubCollaboratorsPermission(t *testing.T) {
+	collaborators := []*github.User{
+		{Login: github.Ptr("maintainer"), RoleName: github.Ptr("maintain"),
+			Permissions: map[string]bool{"maintain": true, "push": true, "triage": true, "pull": true}},
+		{Login: github.Ptr("auditor"), RoleName: github.Ptr("security-auditor"),
+			Permissions: map[string]bool{"triage": true, "pull": true}},
+		{Login: github.Ptr("reader"), RoleName: github.Ptr("read")},
+	}
+
+	results, err := flattenGitHubCollaborators(collaborators)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][2]string{{"maintain", "maintain"}, {"triage", "security-auditor"}, {"pull", "read"}}
+	for i, result := range results {
+		permission, roleName := result.(map[string]any)["permission"], result.(map[string]any)["role_name"]
+		if permission != expected[i][0] || roleName != expected[i][1] {
+			t.Errorf("expected permission %s and role %s for %s, got %s and %s",
+				expected[i][0], expected[i][1], collaborators[i].GetLogin(), permission, roleName)
+		}
+	}
+}
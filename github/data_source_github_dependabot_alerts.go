@@ -0,0 +1,182 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubDependabotAlerts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the Dependabot alerts of a repository",
+		Read:        dataSourceGithubDependabotAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "open",
+				ValidateDiagFunc: validateValueFunc([]string{"auto_dismissed", "dismissed", "fixed", "open"}),
+				Description:      "The state of the alerts, 'auto_dismissed', 'dismissed', 'fixed' or 'open'. Defaults to 'open'.",
+			},
+			"severities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateValueFunc(dependabotAlertSeverities)},
+				Set:         schema.HashString,
+				Description: "The severities of the alerts, 'low', 'medium', 'high' or 'critical'. Alerts of any severity are returned if not set.",
+			},
+			"ecosystem": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateValueFunc(dependabotAlertEcosystems),
+				Description:      "The ecosystem of the alerts, e.g. 'npm'. Alerts of any ecosystem are returned if not set.",
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alerts matching the criteria, sorted by number.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the alert.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the alert.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The severity of the vulnerability.",
+						},
+						"package": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the vulnerable package.",
+						},
+						"ecosystem": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ecosystem of the vulnerable package.",
+						},
+						"manifest_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The path of the manifest declaring the vulnerable package.",
+						},
+						"vulnerable_version_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The range of the vulnerable versions of the package, e.g. '< 1.2.3'.",
+						},
+						"first_patched_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The first version of the package fixing the vulnerability, empty if there is none.",
+						},
+						"ghsa_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GitHub Security Advisory ID of the vulnerability.",
+						},
+						"cve_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CVE ID of the vulnerability, if any.",
+						},
+						"summary": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The summary of the security advisory.",
+						},
+						"html_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the alert.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenDependabotAlerts returns the alerts sorted by number.
+func flattenDependabotAlerts(alerts []*github.DependabotAlert) []any {
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].GetNumber() < alerts[j].GetNumber()
+	})
+
+	result := make([]any, 0, len(alerts))
+	for _, alert := range alerts {
+		vulnerability := alert.GetSecurityVulnerability()
+		result = append(result, map[string]any{
+			"number":                   alert.GetNumber(),
+			"state":                    alert.GetState(),
+			"severity":                 vulnerability.GetSeverity(),
+			"package":                  alert.GetDependency().GetPackage().GetName(),
+			"ecosystem":                alert.GetDependency().GetPackage().GetEcosystem(),
+			"manifest_path":            alert.GetDependency().GetManifestPath(),
+			"vulnerable_version_range": vulnerability.GetVulnerableVersionRange(),
+			"first_patched_version":    vulnerability.GetFirstPatchedVersion().GetIdentifier(),
+			"ghsa_id":                  alert.GetSecurityAdvisory().GetGHSAID(),
+			"cve_id":                   alert.GetSecurityAdvisory().GetCVEID(),
+			"summary":                  alert.GetSecurityAdvisory().GetSummary(),
+			"html_url":                 alert.GetHTMLURL(),
+		})
+	}
+	return result
+}
+
+func dataSourceGithubDependabotAlertsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	options := &github.ListAlertsOptions{
+		State:       github.Ptr(d.Get("state").(string)),
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	if ecosystem, ok := d.GetOk("ecosystem"); ok {
+		options.Ecosystem = github.Ptr(ecosystem.(string))
+	}
+	severities := expandStringList(d.Get("severities").(*schema.Set).List())
+	sort.Strings(severities)
+	if len(severities) > 0 {
+		options.Severity = github.Ptr(strings.Join(severities, ","))
+	}
+
+	var alerts []*github.DependabotAlert
+	for {
+		page, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repoName, options)
+		if err != nil {
+			return err
+		}
+		alerts = append(alerts, page...)
+		if resp.After == "" {
+			break
+		}
+		options.ListCursorOptions.After = resp.After
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	if err := d.Set("alerts", flattenDependabotAlerts(alerts)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubDependabotAlertsRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/dependabot/alerts", func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		if query.Get("state") != "open" || query.Get("severity") != "critical,high" || query.Get("ecosystem") != "npm" {
+			t.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("after") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/acme/app/dependabot/alerts?after=cursor>; rel="next"`)
+			mustWrite(w, `[{"number": 7, "state": "open",
+				"dependency": {"package": {"ecosystem": "npm", "name": "lodash"}, "manifest_path": "package-lock.json"},
+				"security_advisory": {"ghsa_id": "GHSA-xxxx", "cve_id": "CVE-2021-23337", "summary": "Command injection"},
+				"security_vulnerability": {"severity": "high", "vulnerable_version_range": "< 4.17.21", "first_patched_version": {"identifier": "4.17.21"}}}]`)
+			return
+		}
+		mustWrite(w, `[{"number": 3, "state": "open",
+			"dependency": {"package": {"ecosystem": "npm", "name": "minimist"}, "manifest_path": "package-lock.json"},
+			"security_advisory": {"ghsa_id": "GHSA-yyyy"},
+			"security_vulnerability": {"severity": "critical", "vulnerable_version_range": "< 1.2.6"}}]`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubDependabotAlerts().Schema, map[string]any{
+		"repository": "app",
+		"ecosystem":  "npm",
+		"severities": []any{"high", "critical"},
+	})
+	if err := dataSourceGithubDependabotAlertsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := d.Get("alerts.#").(int); n != 2 {
+		t.Fatalf("expected 2 alerts, got %d", n)
+	}
+	for key, expected := range map[string]any{
+		"alerts.0.number":                   3,
+		"alerts.0.package":                  "minimist",
+		"alerts.0.first_patched_version":    "",
+		"alerts.1.number":                   7,
+		"alerts.1.severity":                 "high",
+		"alerts.1.vulnerable_version_range": "< 4.17.21",
+		"alerts.1.first_patched_version":    "4.17.21",
+		"alerts.1.cve_id":                   "CVE-2021-23337",
+	} {
+		if got := d.Get(key); got != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, got)
+		}
+	}
+}
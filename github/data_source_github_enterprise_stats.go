@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enterpriseStats are the statistics of a GitHub Enterprise Server instance,
+// named as in the response of the API, by category.
+var enterpriseStats = map[string]map[string]string{
+	"repos": {
+		"total_repos":  "The number of repositories.",
+		"root_repos":   "The number of repositories which are not forks.",
+		"fork_repos":   "The number of forks.",
+		"org_repos":    "The number of repositories owned by organizations.",
+		"total_pushes": "The number of pushes.",
+		"total_wikis":  "The number of wikis.",
+	},
+	"orgs": {
+		"total_orgs":         "The number of organizations.",
+		"disabled_orgs":      "The number of disabled organizations.",
+		"total_teams":        "The number of teams.",
+		"total_team_members": "The number of team memberships.",
+	},
+	"users": {
+		"total_users":     "The number of users.",
+		"admin_users":     "The number of site administrators.",
+		"suspended_users": "The number of suspended users.",
+	},
+	"hooks": {
+		"total_hooks":    "The number of webhooks.",
+		"active_hooks":   "The number of active webhooks.",
+		"inactive_hooks": "The number of inactive webhooks.",
+	},
+	"pages": {
+		"total_pages": "The number of GitHub Pages sites.",
+	},
+	"pulls": {
+		"total_pulls":       "The number of pull requests.",
+		"merged_pulls":      "The number of merged pull requests.",
+		"mergeable_pulls":   "The number of mergeable pull requests.",
+		"unmergeable_pulls": "The number of pull requests which cannot be merged.",
+	},
+	"issues": {
+		"total_issues":  "The number of issues.",
+		"open_issues":   "The number of open issues.",
+		"closed_issues": "The number of closed issues.",
+	},
+	"milestones": {
+		"total_milestones":  "The number of milestones.",
+		"open_milestones":   "The number of open milestones.",
+		"closed_milestones": "The number of closed milestones.",
+	},
+	"gists": {
+		"total_gists":   "The number of gists.",
+		"private_gists": "The number of secret gists.",
+		"public_gists":  "The number of public gists.",
+	},
+	"comments": {
+		"total_commit_comments":       "The number of commit comments.",
+		"total_gist_comments":         "The number of gist comments.",
+		"total_issue_comments":        "The number of issue comments.",
+		"total_pull_request_comments": "The number of pull request comments.",
+	},
+}
+
+func dataSourceGithubEnterpriseStats() *schema.Resource {
+	s := make(map[string]*schema.Schema)
+	for _, stats := range enterpriseStats {
+		for name, description := range stats {
+			s[name] = &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: description,
+			}
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Get the statistics of a GitHub Enterprise Server instance, such as its numbers of repositories, organizations and users.",
+		Read:        dataSourceGithubEnterpriseStatsRead,
+		Schema:      s,
+	}
+}
+
+// flattenEnterpriseStats returns the statistics of the instance by name.
+func flattenEnterpriseStats(adminStats *github.AdminStats) (map[string]int, error) {
+	// The statistics are grouped by category as in the response of the API.
+	data, err := json.Marshal(adminStats)
+	if err != nil {
+		return nil, err
+	}
+	categories := make(map[string]map[string]int)
+	if err = json.Unmarshal(data, &categories); err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]int)
+	for category, names := range enterpriseStats {
+		for name := range names {
+			stats[name] = categories[category][name]
+		}
+	}
+	return stats, nil
+}
+
+func dataSourceGithubEnterpriseStatsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	enterpriseVersion, err := meta.(*Owner).EnterpriseVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if enterpriseVersion == "" {
+		return fmt.Errorf("the statistics are only available on GitHub Enterprise Server")
+	}
+
+	adminStats, _, err := client.Admin.GetAdminStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	stats, err := flattenEnterpriseStats(adminStats)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(client.BaseURL.Host)
+	for name, value := range stats {
+		if err = d.Set(name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
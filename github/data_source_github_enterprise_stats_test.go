@@ -0,0 +1,27 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestFlattenEnterpriseStats(t *testing.T) {
+	stats, err := flattenEnterpriseStats(&github.AdminStats{
+		Repos: &github.RepoStats{TotalRepos: github.Ptr(212), ForkRepos: github.Ptr(13)},
+		Users: &github.UserStats{TotalUsers: github.Ptr(254), SuspendedUsers: github.Ptr(2)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats["total_repos"] != 212 || stats["fork_repos"] != 13 || stats["total_users"] != 254 || stats["suspended_users"] != 2 {
+		t.Errorf("unexpected statistics %v", stats)
+	}
+	if value, ok := stats["total_orgs"]; !ok || value != 0 {
+		t.Errorf("expected the missing statistics to be 0, got %v", stats)
+	}
+	if len(stats) != len(dataSourceGithubEnterpriseStats().Schema) {
+		t.Errorf("expected a value for every attribute, got %d", len(stats))
+	}
+}
@@ -0,0 +1,152 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubIntegrationIDs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the IDs of the GitHub Apps reporting the checks of the recent commits of a repository",
+		Read:        dataSourceGithubIntegrationIDsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The branch whose recent commits are inspected. Defaults to the default branch of the repository.",
+			},
+			"commits": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 100), "commits"),
+				Description:      "The number of recent commits whose checks are inspected, up to 100.",
+			},
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The checks observed on the recent commits, sorted by context.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the check, the context of required status checks.",
+						},
+						"integration_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the GitHub App reporting the check.",
+						},
+						"app_slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The slug of the GitHub App reporting the check.",
+						},
+					},
+				},
+			},
+			"integration_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the GitHub Apps reporting the checks by context.",
+			},
+		},
+	}
+}
+
+// flattenIntegrationIDs returns the checks of the check runs of commits, from
+// the most recent, reported by the GitHub App which last reported each
+// context.
+func flattenIntegrationIDs(checkRuns []*github.CheckRun) ([]any, map[string]any) {
+	apps := make(map[string]*github.App)
+	for _, checkRun := range checkRuns {
+		if _, ok := apps[checkRun.GetName()]; ok || checkRun.App == nil {
+			continue
+		}
+		apps[checkRun.GetName()] = checkRun.App
+	}
+
+	contexts := make([]string, 0, len(apps))
+	for name := range apps {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+
+	checks := make([]any, 0, len(contexts))
+	integrationIDs := make(map[string]any, len(contexts))
+	for _, name := range contexts {
+		checks = append(checks, map[string]any{
+			"context":        name,
+			"integration_id": apps[name].GetID(),
+			"app_slug":       apps[name].GetSlug(),
+		})
+		integrationIDs[name] = apps[name].GetID()
+	}
+	return checks, integrationIDs
+}
+
+func dataSourceGithubIntegrationIDsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	ref := d.Get("ref").(string)
+	if ref == "" {
+		repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			return err
+		}
+		ref = repo.GetDefaultBranch()
+	}
+
+	commits, _, err := client.Repositories.ListCommits(ctx, owner, repoName, &github.CommitsListOptions{
+		SHA:         ref,
+		ListOptions: github.ListOptions{PerPage: d.Get("commits").(int)},
+	})
+	if err != nil {
+		return err
+	}
+
+	checkRuns := make([]*github.CheckRun, 0)
+	for _, commit := range commits {
+		opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+		for {
+			results, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repoName, commit.GetSHA(), opts)
+			if err != nil {
+				return err
+			}
+			checkRuns = append(checkRuns, results.CheckRuns...)
+
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	checks, integrationIDs := flattenIntegrationIDs(checkRuns)
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	if err = d.Set("checks", checks); err != nil {
+		return err
+	}
+	if err = d.Set("integration_ids", integrationIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
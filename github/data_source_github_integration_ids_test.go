@@ -0,0 +1,28 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestFlattenIntegrationIDs(t *testing.T) {
+	checkRuns := []*github.CheckRun{
+		{Name: github.Ptr("lint"), App: &github.App{ID: github.Ptr(int64(15368)), Slug: github.Ptr("github-actions")}},
+		{Name: github.Ptr("ci/circleci"), App: &github.App{ID: github.Ptr(int64(18001)), Slug: github.Ptr("circleci-checks")}},
+		{Name: github.Ptr("lint"), App: &github.App{ID: github.Ptr(int64(42)), Slug: github.Ptr("other")}},
+		{Name: github.Ptr("no-app")},
+	}
+
+	checks, integrationIDs := flattenIntegrationIDs(checkRuns)
+
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 checks, got %v", checks)
+	}
+	if first := checks[0].(map[string]any); first["context"] != "ci/circleci" || first["app_slug"] != "circleci-checks" {
+		t.Errorf("expected the checks to be sorted by context, got %v", checks)
+	}
+	if integrationIDs["lint"] != int64(15368) {
+		t.Errorf("expected the most recent app reporting lint, got %v", integrationIDs["lint"])
+	}
+}
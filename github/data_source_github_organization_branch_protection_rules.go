@@ -0,0 +1,232 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+type organizationBranchProtectionRule struct {
+	Pattern                      githubv4.String
+	AllowsDeletions              githubv4.Boolean
+	AllowsForcePushes            githubv4.Boolean
+	IsAdminEnforced              githubv4.Boolean
+	RequiredApprovingReviewCount githubv4.Int
+	RequiresApprovingReviews     githubv4.Boolean
+	RequiresCodeOwnerReviews     githubv4.Boolean
+	RequiresCommitSignatures     githubv4.Boolean
+	RequiresLinearHistory        githubv4.Boolean
+	RequiresStatusChecks         githubv4.Boolean
+	RequiresStrictStatusChecks   githubv4.Boolean
+}
+
+func dataSourceGithubOrganizationBranchProtectionRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about the branch protection rules of all repositories of an organization.",
+		Read:        dataSourceGithubOrganizationBranchProtectionRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the rules of archived repositories are included. Default: 'false'.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The branch protection rules of the repositories of the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the repository the rule belongs to.",
+						},
+						"pattern": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The branch name pattern protected by the rule.",
+						},
+						"allows_deletions": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether protected branches can be deleted.",
+						},
+						"allows_force_pushes": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether force pushes are allowed on protected branches.",
+						},
+						"enforce_admins": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule is enforced for administrators.",
+						},
+						"require_signed_commits": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether commits must be signed.",
+						},
+						"required_linear_history": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether merge commits are prohibited on protected branches.",
+						},
+						"requires_approving_reviews": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether approving reviews are required before merging.",
+						},
+						"required_approving_review_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of approving reviews required before merging.",
+						},
+						"require_code_owner_reviews": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether reviews from code owners are required.",
+						},
+						"requires_status_checks": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether status checks must pass before merging.",
+						},
+						"strict": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether branches must be up to date before merging.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationBranchProtectionRulesRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v4client
+	ctx := meta.(*Owner).StopContext
+	orgName := meta.(*Owner).name
+	includeArchived := d.Get("include_archived").(bool)
+
+	var query struct {
+		Organization struct {
+			ID           githubv4.String
+			Repositories struct {
+				Nodes []struct {
+					Name                  githubv4.String
+					IsArchived            githubv4.Boolean
+					BranchProtectionRules struct {
+						Nodes    []organizationBranchProtectionRule
+						PageInfo PageInfo
+					} `graphql:"branchProtectionRules(first: 100)"`
+				}
+				PageInfo PageInfo
+			} `graphql:"repositories(first: $first, after: $cursor)"`
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]any{
+		"first":  githubv4.Int(50),
+		"login":  githubv4.String(orgName),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	rules := make([]any, 0)
+	for {
+		err := client.Query(ctx, &query, variables)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range query.Organization.Repositories.Nodes {
+			if bool(repo.IsArchived) && !includeArchived {
+				continue
+			}
+
+			repoRules := repo.BranchProtectionRules.Nodes
+			// Repositories with more than a page of rules are rare enough to
+			// be fetched separately rather than paginating every repository.
+			if repo.BranchProtectionRules.PageInfo.HasNextPage {
+				moreRules, err := listRemainingBranchProtectionRules(meta, string(repo.Name), repo.BranchProtectionRules.PageInfo.EndCursor)
+				if err != nil {
+					return err
+				}
+				repoRules = append(repoRules, moreRules...)
+			}
+
+			for _, rule := range repoRules {
+				rules = append(rules, flattenOrganizationBranchProtectionRule(string(repo.Name), rule))
+			}
+		}
+
+		if !query.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.Repositories.PageInfo.EndCursor)
+	}
+
+	d.SetId(string(query.Organization.ID))
+	err = d.Set("rules", rules)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func listRemainingBranchProtectionRules(meta any, repoName string, cursor githubv4.String) ([]organizationBranchProtectionRule, error) {
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Repository struct {
+			BranchProtectionRules struct {
+				Nodes    []organizationBranchProtectionRule
+				PageInfo PageInfo
+			} `graphql:"branchProtectionRules(first: 100, after: $cursor)"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	variables := map[string]any{
+		"name":   githubv4.String(repoName),
+		"owner":  githubv4.String(meta.(*Owner).name),
+		"cursor": githubv4.NewString(cursor),
+	}
+
+	var rules []organizationBranchProtectionRule
+	for {
+		err := client.Query(meta.(*Owner).StopContext, &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, query.Repository.BranchProtectionRules.Nodes...)
+
+		if !query.Repository.BranchProtectionRules.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.BranchProtectionRules.PageInfo.EndCursor)
+	}
+
+	return rules, nil
+}
+
+func flattenOrganizationBranchProtectionRule(repoName string, rule organizationBranchProtectionRule) map[string]any {
+	return map[string]any{
+		"repository":                      repoName,
+		"pattern":                         string(rule.Pattern),
+		"allows_deletions":                bool(rule.AllowsDeletions),
+		"allows_force_pushes":             bool(rule.AllowsForcePushes),
+		"enforce_admins":                  bool(rule.IsAdminEnforced),
+		"require_signed_commits":          bool(rule.RequiresCommitSignatures),
+		"required_linear_history":         bool(rule.RequiresLinearHistory),
+		"requires_approving_reviews":      bool(rule.RequiresApprovingReviews),
+		"required_approving_review_count": int(rule.RequiredApprovingReviewCount),
+		"require_code_owner_reviews":      bool(rule.RequiresCodeOwnerReviews),
+		"requires_status_checks":          bool(rule.RequiresStatusChecks),
+		"strict":                          bool(rule.RequiresStrictStatusChecks),
+	}
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationBranchProtectionRulesDataSource(t *testing.T) {
+
+	t.Run("queries organization branch protection rules", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+			  name      = "tf-acc-test-%[1]s"
+			  auto_init = true
+			}
+
+			resource "github_branch_protection" "protection" {
+			  repository_id  = github_repository.test.id
+			  pattern        = "main-%[1]s"
+			  enforce_admins = true
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_organization_branch_protection_rules" "all" {}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckTypeSetElemNestedAttrs("data.github_organization_branch_protection_rules.all", "rules.*", map[string]string{
+				"repository":     fmt.Sprintf("tf-acc-test-%s", randomID),
+				"pattern":        fmt.Sprintf("main-%s", randomID),
+				"enforce_admins": "true",
+			}),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  resource.ComposeTestCheckFunc(),
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationBypassRequests() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the requests to bypass the push rules of rulesets or the secret scanning push protection of the repositories of an organization",
+		Read:        dataSourceGithubOrganizationBypassRequestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bypass_type": bypassRequestTypeSchema(),
+			"status":      bypassRequestStatusSchema(),
+			"bypass_requests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bypass requests, most recent first.",
+				Elem:        bypassRequestResource(),
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationBypassRequestsRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	bypassType := d.Get("bypass_type").(string)
+	status := d.Get("status").(string)
+
+	requests, err := listGithubBypassRequests(ctx, client, fmt.Sprintf("orgs/%s/bypass-requests", orgName), bypassType, status)
+	if err != nil {
+		return err
+	}
+
+	results := make([]any, 0, len(requests))
+	for _, request := range requests {
+		results = append(results, flattenBypassRequest(request))
+	}
+
+	d.SetId(buildThreePartID(orgName, bypassType, status))
+	if err := d.Set("bypass_requests", results); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationCustomProperties() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the custom property definitions of an organization",
+		Read:        dataSourceGithubOrganizationCustomPropertiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"property": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of custom property definitions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the custom property.",
+						},
+						"value_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the value of the custom property. Can be one of 'string', 'single_select', 'multi_select' or 'true_false'.",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the custom property is required.",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Default value of the custom property.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Short description of the custom property.",
+						},
+						"allowed_values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Ordered list of the allowed values of the custom property.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"values_editable_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Who can edit the values of the custom property. Can be one of 'org_actors' or 'org_and_repo_actors'.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationCustomPropertiesRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	owner := meta.(*Owner).name
+
+	customProperties, _, err := client.Organizations.GetAllCustomProperties(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	properties := make([]any, 0, len(customProperties))
+	for _, p := range customProperties {
+		properties = append(properties, map[string]any{
+			"property_name":      p.GetPropertyName(),
+			"value_type":         p.ValueType,
+			"required":           p.GetRequired(),
+			"default_value":      p.GetDefaultValue(),
+			"description":        p.GetDescription(),
+			"allowed_values":     p.AllowedValues,
+			"values_editable_by": p.GetValuesEditableBy(),
+		})
+	}
+
+	d.SetId(owner)
+	if err := d.Set("property", properties); err != nil {
+		return err
+	}
+
+	return nil
+}
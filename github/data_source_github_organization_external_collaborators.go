@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationExternalCollaborators() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the outside collaborators of an organization and the repositories they have access to.",
+		Read:        dataSourceGithubOrganizationExternalCollaboratorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_repositories": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the repositories each collaborator has access to are looked up, which requires an API call per repository of the organization. Default: 'true'.",
+			},
+			"collaborators": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The outside collaborators of the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the collaborator.",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the collaborator.",
+						},
+						"repositories": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the repositories of the organization the collaborator has access to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listGithubOrganizationOutsideCollaborators(ctx context.Context, client *github.Client, orgName string) ([]*github.User, error) {
+	options := &github.ListOutsideCollaboratorsOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var users []*github.User
+	for {
+		us, resp, err := client.Organizations.ListOutsideCollaborators(ctx, orgName, options)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, us...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return users, nil
+}
+
+// listGithubOrganizationOutsideCollaboratorRepositories returns the names of
+// the repositories of an organization each outside collaborator has access
+// to, keyed by lowercase login.
+func listGithubOrganizationOutsideCollaboratorRepositories(ctx context.Context, client *github.Client, orgName string) (map[string][]string, error) {
+	repoOpts := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	repositories := make(map[string][]string)
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, orgName, repoOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range repos {
+			collaboratorOpts := &github.ListCollaboratorsOptions{
+				Affiliation: "outside",
+				ListOptions: github.ListOptions{PerPage: maxPerPage},
+			}
+			for {
+				users, resp, err := client.Repositories.ListCollaborators(ctx, orgName, repo.GetName(), collaboratorOpts)
+				if err != nil {
+					return nil, err
+				}
+				for _, user := range users {
+					login := strings.ToLower(user.GetLogin())
+					repositories[login] = append(repositories[login], repo.GetName())
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				collaboratorOpts.Page = resp.NextPage
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		repoOpts.Page = resp.NextPage
+	}
+
+	return repositories, nil
+}
+
+func dataSourceGithubOrganizationExternalCollaboratorsRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	users, err := listGithubOrganizationOutsideCollaborators(ctx, client, orgName)
+	if err != nil {
+		return err
+	}
+
+	repositories := make(map[string][]string)
+	if d.Get("include_repositories").(bool) {
+		repositories, err = listGithubOrganizationOutsideCollaboratorRepositories(ctx, client, orgName)
+		if err != nil {
+			return err
+		}
+	}
+
+	collaborators := make([]any, 0, len(users))
+	for _, user := range users {
+		repos := repositories[strings.ToLower(user.GetLogin())]
+		if repos == nil {
+			repos = make([]string, 0)
+		}
+		sort.Strings(repos)
+
+		collaborators = append(collaborators, map[string]any{
+			"login":        user.GetLogin(),
+			"id":           user.GetID(),
+			"repositories": repos,
+		})
+	}
+
+	d.SetId(orgName)
+	if err := d.Set("collaborators", collaborators); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -67,6 +67,10 @@ func dataSourceGithubOrganizationExternalIdentities() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationExternalIdentitiesRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	name := meta.(*Owner).name
 
 	client4 := meta.(*Owner).v4client
@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationNetworkConfigurations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information on all hosted compute network configurations of the organization.",
+		Read:        dataSourceGithubOrganizationNetworkConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_configurations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The network configurations of the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the network configuration.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network configuration.",
+						},
+						"compute_service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hosted compute service the network configuration supports, 'none' or 'actions'.",
+						},
+						"network_settings_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the network settings resources of the network configuration.",
+						},
+						"created_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the network configuration was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenNetworkConfiguration(networkConfiguration *github.NetworkConfiguration) map[string]any {
+	computeService := string(github.ComputeServiceNone)
+	if networkConfiguration.ComputeService != nil {
+		computeService = string(*networkConfiguration.ComputeService)
+	}
+
+	return map[string]any{
+		"id":                   networkConfiguration.GetID(),
+		"name":                 networkConfiguration.GetName(),
+		"compute_service":      computeService,
+		"network_settings_ids": networkConfiguration.NetworkSettingsIDs,
+		"created_on":           networkConfiguration.GetCreatedOn().String(),
+	}
+}
+
+func dataSourceGithubOrganizationNetworkConfigurationsRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	results := make([]map[string]any, 0)
+	for {
+		networkConfigurations, resp, err := client.Organizations.ListNetworkConfigurations(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+
+		for _, networkConfiguration := range networkConfigurations.NetworkConfigurations {
+			results = append(results, flattenNetworkConfiguration(networkConfiguration))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(orgName)
+	if err = d.Set("network_configurations", results); err != nil {
+		return err
+	}
+
+	return nil
+}
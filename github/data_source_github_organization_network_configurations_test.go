@@ -0,0 +1,71 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationNetworkConfigurationsDataSource(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("queries the network configurations of an organization", func(t *testing.T) {
+
+		networkSettingsID := os.Getenv("GITHUB_TEST_NETWORK_SETTINGS_ID")
+		if networkSettingsID == "" {
+			t.Skip("Skipping because `GITHUB_TEST_NETWORK_SETTINGS_ID` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_organization_network_configuration" "test" {
+				name                 = "tf-acc-test-%s"
+				network_settings_ids = ["%s"]
+			}
+		`, randomID, networkSettingsID)
+
+		config2 := config + `
+			data "github_organization_network_configurations" "test" {}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckTypeSetElemNestedAttrs("data.github_organization_network_configurations.test", "network_configurations.*", map[string]string{
+				"name":                   fmt.Sprintf("tf-acc-test-%s", randomID),
+				"compute_service":        "actions",
+				"network_settings_ids.0": networkSettingsID,
+			}),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  resource.ComposeTestCheckFunc(),
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationRepositoryPolicyViolations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the repositories of an organization missing required topics or custom properties",
+		Read:        dataSourceGithubOrganizationRepositoryPolicyViolationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"required_topics": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Topics that every repository must have.",
+			},
+			"required_custom_properties": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the custom properties that every repository must have a value for.",
+			},
+			"include_archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether archived repositories are checked. Default: 'false'.",
+			},
+			"compliant": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every checked repository has all required topics and custom properties.",
+			},
+			"violations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Repositories missing at least one required topic or custom property.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the repository.",
+						},
+						"missing_topics": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Required topics the repository does not have.",
+						},
+						"missing_custom_properties": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Required custom properties the repository does not have a value for.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationRepositoryPolicyViolationsRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	requiredTopics := expandStringList(d.Get("required_topics").(*schema.Set).List())
+	requiredProperties := expandStringList(d.Get("required_custom_properties").(*schema.Set).List())
+	includeArchived := d.Get("include_archived").(bool)
+	sort.Strings(requiredTopics)
+	sort.Strings(requiredProperties)
+
+	repoOpts := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	repositories := make([]*github.Repository, 0)
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, owner, repoOpts)
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			if repo.GetArchived() && !includeArchived {
+				continue
+			}
+			repositories = append(repositories, repo)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		repoOpts.Page = resp.NextPage
+	}
+
+	properties := make(map[string][]string)
+	if len(requiredProperties) > 0 {
+		propertyOpts := &github.ListCustomPropertyValuesOptions{
+			ListOptions: github.ListOptions{PerPage: maxPerPage},
+		}
+		for {
+			values, resp, err := client.Organizations.ListCustomPropertyValues(ctx, owner, propertyOpts)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				for _, property := range value.Properties {
+					if property.Value != nil && property.Value != "" {
+						properties[value.RepositoryName] = append(properties[value.RepositoryName], property.PropertyName)
+					}
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+			propertyOpts.Page = resp.NextPage
+		}
+	}
+
+	violations := make([]any, 0)
+	for _, repo := range repositories {
+		missingTopics := make([]string, 0)
+		for _, topic := range requiredTopics {
+			if !slices.Contains(repo.Topics, topic) {
+				missingTopics = append(missingTopics, topic)
+			}
+		}
+
+		missingProperties := make([]string, 0)
+		for _, property := range requiredProperties {
+			if !slices.Contains(properties[repo.GetName()], property) {
+				missingProperties = append(missingProperties, property)
+			}
+		}
+
+		if len(missingTopics) > 0 || len(missingProperties) > 0 {
+			violations = append(violations, map[string]any{
+				"repository":                repo.GetName(),
+				"missing_topics":            missingTopics,
+				"missing_custom_properties": missingProperties,
+			})
+		}
+	}
+
+	d.SetId(owner)
+	if err := d.Set("violations", violations); err != nil {
+		return err
+	}
+	if err := d.Set("compliant", len(violations) == 0); err != nil {
+		return err
+	}
+
+	return nil
+}
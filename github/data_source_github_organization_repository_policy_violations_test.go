@@ -0,0 +1,69 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationRepositoryPolicyViolationsDataSource(t *testing.T) {
+	t.Run("reports repositories missing required topics", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "compliant" {
+			  name   = "tf-acc-test-compliant-%[1]s"
+			  topics = ["tf-acc-test-%[1]s"]
+			}
+
+			resource "github_repository" "non_compliant" {
+			  name = "tf-acc-test-non-compliant-%[1]s"
+			}
+
+			data "github_organization_repository_policy_violations" "test" {
+			  required_topics = ["tf-acc-test-%[1]s"]
+
+			  depends_on = [
+			    github_repository.compliant,
+			    github_repository.non_compliant,
+			  ]
+			}
+		`, randomID)
+
+		const resourceName = "data.github_organization_repository_policy_violations.test"
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(resourceName, "compliant", "false"),
+			resource.TestCheckTypeSetElemNestedAttrs(resourceName, "violations.*", map[string]string{
+				"repository":       fmt.Sprintf("tf-acc-test-non-compliant-%s", randomID),
+				"missing_topics.#": "1",
+			}),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -39,6 +39,10 @@ func dataSourceGithubOrganizationTeamSyncGroups() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationTeamSyncGroupsRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	ctx := context.Background()
 
@@ -38,6 +38,12 @@ func dataSourceGithubOrganizationWebhooks() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"configuration": webhookConfigurationDataSourceSchema(),
 					},
 				},
 			},
@@ -46,6 +52,10 @@ func dataSourceGithubOrganizationWebhooks() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationWebhooksRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	owner := meta.(*Owner).name
 
 	client := meta.(*Owner).v3client
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRateLimit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the current rate limit status of the authenticated client.",
+		Read:        dataSourceGithubRateLimitRead,
+
+		Schema: map[string]*schema.Schema{
+			"core": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit of the REST API, except search.",
+				Elem:        rateLimitResource(),
+			},
+			"search": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit of the search API.",
+				Elem:        rateLimitResource(),
+			},
+			"graphql": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit of the GraphQL API, empty for anonymous clients.",
+				Elem:        rateLimitResource(),
+			},
+		},
+	}
+}
+
+func rateLimitResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of requests in the current window.",
+			},
+			"remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests remaining in the current window.",
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests made in the current window.",
+			},
+			"reset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time, in RFC 3339 format, at which the current window resets.",
+			},
+		},
+	}
+}
+
+func flattenRateLimit(rate *github.Rate) []any {
+	if rate == nil {
+		return []any{}
+	}
+	return []any{
+		map[string]any{
+			"limit":     rate.Limit,
+			"remaining": rate.Remaining,
+			"used":      rate.Used,
+			"reset":     rate.Reset.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+func dataSourceGithubRateLimitRead(d *schema.ResourceData, meta any) error {
+	owner := meta.(*Owner)
+
+	// Requesting the rate limit status does not count against the rate limit.
+	limits, _, err := owner.v3client.RateLimit.Get(owner.StopContext)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("github-rate-limit")
+	if err = d.Set("core", flattenRateLimit(limits.GetCore())); err != nil {
+		return err
+	}
+	if err = d.Set("search", flattenRateLimit(limits.GetSearch())); err != nil {
+		return err
+	}
+	if err = d.Set("graphql", flattenRateLimit(limits.GetGraphQL())); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenRateLimit(t *testing.T) {
+	if flattened := flattenRateLimit(nil); len(flattened) != 0 {
+		t.Errorf("expected no rate limit, got %v", flattened)
+	}
+
+	reset := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	flattened := flattenRateLimit(&github.Rate{Limit: 5000, Remaining: 4000, Used: 1000, Reset: github.Timestamp{Time: reset.In(time.FixedZone("CEST", 2*60*60))}})
+	rate := flattened[0].(map[string]any)
+	if rate["limit"] != 5000 || rate["remaining"] != 4000 || rate["used"] != 1000 {
+		t.Errorf("unexpected rate limit %v", rate)
+	}
+	if rate["reset"] != "2024-06-30T12:00:00Z" {
+		t.Errorf("expected the reset in UTC, got %s", rate["reset"])
+	}
+}
+
+func TestAccGithubRateLimitDataSource(t *testing.T) {
+
+	t.Run("reads the rate limit without error", func(t *testing.T) {
+
+		config := `data "github_rate_limit" "test" {}`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_rate_limit.test", "core.#", "1"),
+			resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.limit"),
+			resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.remaining"),
+			resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.reset"),
+			resource.TestCheckResourceAttr("data.github_rate_limit.test", "search.#", "1"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			testCase(t, anonymous)
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -2,6 +2,10 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,8 +19,16 @@ func dataSourceGithubRepositories() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"query": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"query", "custom_properties"},
+			},
+			"custom_properties": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"query", "custom_properties"},
+				Description:  "The values of the custom properties of the organization the repositories must have, keyed by property name, e.g. '{ team = \"payments\" }'. When set, the repositories of the organization are listed without the limit of 1000 results of the search API, the 'query' then only accepts the qualifiers of repository queries and 'sort' is ignored.",
 			},
 			"sort": {
 				Type:             schema.TypeString,
@@ -67,6 +79,10 @@ func dataSourceGithubRepositoriesRead(d *schema.ResourceData, meta any) error {
 	resultsPerPage := d.Get("results_per_page").(int)
 
 	query := d.Get("query").(string)
+	if properties := d.Get("custom_properties").(map[string]any); len(properties) > 0 {
+		return dataSourceGithubRepositoriesByCustomPropertiesRead(d, meta, customPropertiesRepositoryQuery(query, properties))
+	}
+
 	opt := &github.SearchOptions{
 		Sort: d.Get("sort").(string),
 		ListOptions: github.ListOptions{
@@ -125,3 +141,80 @@ func searchGithubRepositories(client *github.Client, query string, opt *github.S
 
 	return fullNames, names, repoIDs, nil
 }
+
+// customPropertiesRepositoryQuery returns the repository query matching the
+// query and the values of the custom properties, with a 'props.' qualifier
+// per property. Values with whitespace are quoted.
+func customPropertiesRepositoryQuery(query string, properties map[string]any) string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	terms := make([]string, 0, len(properties)+1)
+	if query != "" {
+		terms = append(terms, query)
+	}
+	for _, name := range names {
+		value := properties[name].(string)
+		if strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+			value = fmt.Sprintf("%q", value)
+		}
+		terms = append(terms, fmt.Sprintf("props.%s:%s", name, value))
+	}
+	return strings.Join(terms, " ")
+}
+
+func dataSourceGithubRepositoriesByCustomPropertiesRead(d *schema.ResourceData, meta any, repositoryQuery string) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	opt := &github.ListCustomPropertyValuesOptions{
+		RepositoryQuery: repositoryQuery,
+		ListOptions: github.ListOptions{
+			PerPage: min(d.Get("results_per_page").(int), maxPerPage),
+		},
+	}
+
+	fullNames := make([]string, 0)
+	names := make([]string, 0)
+	repoIDs := make([]int64, 0)
+	for {
+		repos, resp, err := client.Organizations.ListCustomPropertyValues(ctx, owner, opt)
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			fullNames = append(fullNames, repo.RepositoryFullName)
+			names = append(names, repo.RepositoryName)
+			repoIDs = append(repoIDs, repo.RepositoryID)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	d.SetId(repositoryQuery)
+	if err = d.Set("full_names", fullNames); err != nil {
+		return err
+	}
+	if err = d.Set("names", names); err != nil {
+		return err
+	}
+	if d.Get("include_repo_id").(bool) {
+		if err = d.Set("repo_ids", repoIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestCustomPropertiesRepositoryQuery(t *testing.T) {
+	properties := map[string]any{
+		"team":      "payments",
+		"lifecycle": "in production",
+	}
+
+	if query := customPropertiesRepositoryQuery("", properties); query != `props.lifecycle:"in production" props.team:payments` {
+		t.Errorf("unexpected repository query %s", query)
+	}
+	if query := customPropertiesRepositoryQuery("archived:false", map[string]any{"team": "payments"}); query != "archived:false props.team:payments" {
+		t.Errorf("unexpected repository query %s", query)
+	}
+}
+
 func TestAccGithubRepositoriesDataSource(t *testing.T) {
 
 	// FIXME: Find a way to reduce amount of `GET /search/repositories`
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,25 +15,35 @@ func dataSourceGithubRepositoryAutolinkReferences() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the repository to retrieve the autolink references from.",
 			},
 			"autolink_references": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of this repository's autolink references.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the autolink reference, as used to import 'github_repository_autolink_reference'.",
+						},
 						"key_prefix": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "This prefix appended by a number will generate a link any time it is found in an issue, pull request, or commit.",
 						},
 						"target_url_template": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The template of the target URL used for the links.",
 						},
 						"is_alphanumeric": {
-							Type:     schema.TypeBool,
-							Computed: true,
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this autolink reference matches alphanumeric characters.",
 						},
 					},
 				},
@@ -48,7 +59,9 @@ func dataSourceGithubRepositoryAutolinkReferencesRead(d *schema.ResourceData, me
 
 	results := make([]map[string]any, 0)
 
-	var listOptions *github.ListOptions
+	listOptions := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
 	for {
 		autoLinks, resp, err := client.Repositories.ListAutolinks(context.Background(), orgName, repoName, listOptions)
 		if err != nil {
@@ -81,6 +94,7 @@ func flattenAutolinkReferences(autoLinks []*github.Autolink) []map[string]any {
 
 	for _, autolink := range autoLinks {
 		linkMap := make(map[string]any)
+		linkMap["id"] = strconv.FormatInt(autolink.GetID(), 10)
 		linkMap["key_prefix"] = autolink.GetKeyPrefix()
 		linkMap["target_url_template"] = autolink.GetURLTemplate()
 		linkMap["is_alphanumeric"] = autolink.GetIsAlphanumeric()
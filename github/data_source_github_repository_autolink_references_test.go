@@ -35,6 +35,7 @@ func TestAccGithubRepositoryAutolinkReferencesDataSource(t *testing.T) {
 		`
 		check := resource.ComposeTestCheckFunc(
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.#", "1"),
+			resource.TestCheckResourceAttrPair("data.github_repository_autolink_references.all", "autolink_references.0.id", "github_repository_autolink_reference.autolink_default", "id"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.key_prefix", "TEST1-"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.target_url_template", "https://example.com/TEST-<num>"),
 			resource.TestCheckResourceAttr("data.github_repository_autolink_references.all", "autolink_references.0.is_alphanumeric", "true"),
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoryBypassRequests() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the requests to bypass the push rules of rulesets or the secret scanning push protection of a repository",
+		Read:        dataSourceGithubRepositoryBypassRequestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"bypass_type": bypassRequestTypeSchema(),
+			"status":      bypassRequestStatusSchema(),
+			"bypass_requests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bypass requests, most recent first.",
+				Elem:        bypassRequestResource(),
+			},
+		},
+	}
+}
+
+func bypassRequestTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "push_rules",
+		ValidateDiagFunc: validateValueFunc([]string{"push_rules", "secret_scanning"}),
+		Description:      "The type of the bypass requests, 'push_rules' for the push rules of rulesets or 'secret_scanning' for the secret scanning push protection. Defaults to 'push_rules'.",
+	}
+}
+
+func bypassRequestStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "open",
+		ValidateDiagFunc: validateValueFunc([]string{"all", "open", "completed", "cancelled", "expired", "deleted", "denied"}),
+		Description:      "The status of the bypass requests to get, one of 'all', 'open', 'completed', 'cancelled', 'expired', 'deleted' or 'denied'. Defaults to 'open'.",
+	}
+}
+
+func bypassRequestResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the bypass request within the repository.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the repository of the bypass request.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bypass request, e.g. 'pending', 'approved' or 'denied'.",
+			},
+			"requester_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the user who requested the bypass.",
+			},
+			"requester_login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user who requested the bypass.",
+			},
+			"requester_comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason given by the user who requested the bypass.",
+			},
+			"request_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the bypass request, e.g. 'push_ruleset_bypass'.",
+			},
+			"resource_identifier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the bypassed resource, e.g. the SHA of the pushed commit.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the bypass was requested.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the bypass request expires.",
+			},
+			"html_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the bypass request on the web.",
+			},
+		},
+	}
+}
+
+// bypassRequest is a request to bypass push rules or the secret scanning push
+// protection, which are not supported by go-github.
+type bypassRequest struct {
+	ID         int64 `json:"id"`
+	Number     int   `json:"number"`
+	Repository struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Requester          bypassRequestActor `json:"requester"`
+	RequestType        string             `json:"request_type"`
+	ResourceIdentifier string             `json:"resource_identifier"`
+	Status             string             `json:"status"`
+	RequesterComment   string             `json:"requester_comment"`
+	ExpiresAt          github.Timestamp   `json:"expires_at"`
+	CreatedAt          github.Timestamp   `json:"created_at"`
+	Responses          []*bypassResponse  `json:"responses"`
+	HTMLURL            string             `json:"html_url"`
+}
+
+type bypassRequestActor struct {
+	ActorID   int64  `json:"actor_id"`
+	ActorName string `json:"actor_name"`
+}
+
+type bypassResponse struct {
+	ID        int64              `json:"id"`
+	Reviewer  bypassRequestActor `json:"reviewer"`
+	Status    string             `json:"status"`
+	CreatedAt github.Timestamp   `json:"created_at"`
+}
+
+func flattenBypassRequest(request *bypassRequest) map[string]any {
+	return map[string]any{
+		"number":              request.Number,
+		"repository":          request.Repository.Name,
+		"status":              request.Status,
+		"requester_id":        request.Requester.ActorID,
+		"requester_login":     request.Requester.ActorName,
+		"requester_comment":   request.RequesterComment,
+		"request_type":        request.RequestType,
+		"resource_identifier": request.ResourceIdentifier,
+		"created_at":          request.CreatedAt.String(),
+		"expires_at":          request.ExpiresAt.String(),
+		"html_url":            request.HTMLURL,
+	}
+}
+
+// listGithubBypassRequests returns the bypass requests of the bypassType, e.g.
+// 'push_rules', with the status at the bypassRequestsURL endpoint of a
+// repository or an organization.
+func listGithubBypassRequests(ctx context.Context, client *github.Client, bypassRequestsURL, bypassType, status string) ([]*bypassRequest, error) {
+	query := url.Values{}
+	query.Set("status", status)
+	query.Set("per_page", strconv.Itoa(maxPerPage))
+
+	var requests []*bypassRequest
+	page := 1
+	for {
+		query.Set("page", strconv.Itoa(page))
+		u := fmt.Sprintf("%s/%s?%s", bypassRequestsURL, strings.ReplaceAll(bypassType, "_", "-"), query.Encode())
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*bypassRequest
+		resp, err := client.Do(ctx, req, &result)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, result...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return requests, nil
+}
+
+func dataSourceGithubRepositoryBypassRequestsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	bypassType := d.Get("bypass_type").(string)
+	status := d.Get("status").(string)
+
+	requests, err := listGithubBypassRequests(ctx, client, fmt.Sprintf("repos/%s/%s/bypass-requests", owner, repoName), bypassType, status)
+	if err != nil {
+		return err
+	}
+
+	results := make([]any, 0, len(requests))
+	for _, request := range requests {
+		results = append(results, flattenBypassRequest(request))
+	}
+
+	d.SetId(buildThreePartID(repoName, bypassType, status))
+	if err := d.Set("bypass_requests", results); err != nil {
+		return err
+	}
+
+	return nil
+}
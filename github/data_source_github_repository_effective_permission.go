@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoryEffectivePermission() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the effective permission of a user on a repository and the sources it is granted through",
+		Read:        dataSourceGithubRepositoryEffectivePermissionRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The user to get the permission of.",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The effective permission of the user, one of 'admin', 'write', 'read' or 'none'. 'maintain' is reported as 'write' and 'triage' as 'read', see `role_name`.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the effective role of the user, e.g. 'maintain', 'triage' or a custom repository role.",
+			},
+			"sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The sources the user is granted access to the repository through.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the source, one of 'owner', 'organization_admin', 'organization_base', 'direct' or 'team'.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the source: the slug of the team, the login of the user or the organization.",
+						},
+						"permission": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The permission granted by the source, one of 'pull', 'triage', 'push', 'maintain', 'admin' or the name of a custom repository role.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryEffectivePermissionRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	isOrganization := meta.(*Owner).IsOrganization
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	username := d.Get("username").(string)
+
+	level, _, err := client.Repositories.GetPermissionLevel(ctx, owner, repoName, username)
+	if err != nil {
+		return err
+	}
+
+	sources := make([]any, 0)
+
+	if !isOrganization {
+		if strings.EqualFold(owner, username) {
+			sources = append(sources, map[string]any{
+				"type":       "owner",
+				"name":       owner,
+				"permission": "admin",
+			})
+		}
+	} else {
+		membership, _, err := client.Organizations.GetOrgMembership(ctx, username, owner)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+				return err
+			}
+			membership = nil
+		}
+
+		if membership.GetState() == "active" {
+			if membership.GetRole() == "admin" {
+				sources = append(sources, map[string]any{
+					"type":       "organization_admin",
+					"name":       owner,
+					"permission": "admin",
+				})
+			}
+
+			org, _, err := client.Organizations.Get(ctx, owner)
+			if err != nil {
+				return err
+			}
+			if basePermission := org.GetDefaultRepoPermission(); basePermission != "" && basePermission != "none" {
+				sources = append(sources, map[string]any{
+					"type":       "organization_base",
+					"name":       owner,
+					"permission": getPermission(basePermission),
+				})
+			}
+		}
+
+		teamSources, err := listEffectivePermissionTeamSources(ctx, client, owner, repoName, username)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, teamSources...)
+	}
+
+	directSource, err := getEffectivePermissionDirectSource(ctx, client, owner, repoName, username)
+	if err != nil {
+		return err
+	}
+	if directSource != nil {
+		sources = append(sources, directSource)
+	}
+
+	d.SetId(buildTwoPartID(repoName, username))
+	if err = d.Set("permission", level.GetPermission()); err != nil {
+		return err
+	}
+	if err = d.Set("role_name", level.GetRoleName()); err != nil {
+		return err
+	}
+	if err = d.Set("sources", sources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getEffectivePermissionDirectSource returns the source of the permission
+// granted to the user as a direct collaborator of the repository, if any.
+func getEffectivePermissionDirectSource(ctx context.Context, client *github.Client, owner, repoName, username string) (map[string]any, error) {
+	options := &github.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	for {
+		collaborators, resp, err := client.Repositories.ListCollaborators(ctx, owner, repoName, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range collaborators {
+			if strings.EqualFold(c.GetLogin(), username) {
+				return map[string]any{
+					"type":       "direct",
+					"name":       c.GetLogin(),
+					"permission": getPermission(c.GetRoleName()),
+				}, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// listEffectivePermissionTeamSources returns the sources of the permissions
+// granted to the user through the teams of the repository they are an active
+// member of.
+func listEffectivePermissionTeamSources(ctx context.Context, client *github.Client, owner, repoName, username string) ([]any, error) {
+	options := &github.ListOptions{PerPage: maxPerPage}
+
+	sources := make([]any, 0)
+	for {
+		teams, resp, err := client.Repositories.ListTeams(ctx, owner, repoName, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, team := range teams {
+			membership, _, err := client.Teams.GetTeamMembershipBySlug(ctx, owner, team.GetSlug(), username)
+			if err != nil {
+				if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+					continue
+				}
+				return nil, err
+			}
+			if membership.GetState() != "active" {
+				continue
+			}
+
+			sources = append(sources, map[string]any{
+				"type":       "team",
+				"name":       team.GetSlug(),
+				"permission": team.GetPermission(),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return sources, nil
+}
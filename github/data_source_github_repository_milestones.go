@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoryMilestones() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the milestones of a GitHub repository.",
+		Read:        dataSourceGithubRepositoryMilestonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The owner of the repository. Defaults to the owner of the provider.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "open",
+				ValidateDiagFunc: validateValueFunc([]string{"open", "closed", "all"}),
+				Description:      "The state of the milestones, 'open', 'closed' or 'all'. Defaults to 'open'.",
+			},
+			"milestones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The milestones, sorted by due date.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the milestone.",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The title of the milestone.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the milestone.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the milestone, 'open' or 'closed'.",
+						},
+						"due_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The due date of the milestone in yyyy-mm-dd format, empty if it has none.",
+						},
+						"open_issues": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of open issues and pull requests of the milestone.",
+						},
+						"closed_issues": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of closed issues and pull requests of the milestone.",
+						},
+						"progress": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The percentage of closed issues and pull requests of the milestone, rounded down.",
+						},
+						"html_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the milestone.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenMilestones(milestones []*github.Milestone) []any {
+	results := make([]any, 0, len(milestones))
+	for _, milestone := range milestones {
+		dueDate := ""
+		if milestone.DueOn != nil {
+			dueDate = milestone.GetDueOn().Format(layoutISO)
+		}
+		progress := 0
+		if total := milestone.GetOpenIssues() + milestone.GetClosedIssues(); total > 0 {
+			progress = milestone.GetClosedIssues() * 100 / total
+		}
+
+		results = append(results, map[string]any{
+			"number":        milestone.GetNumber(),
+			"title":         milestone.GetTitle(),
+			"description":   milestone.GetDescription(),
+			"state":         milestone.GetState(),
+			"due_date":      dueDate,
+			"open_issues":   milestone.GetOpenIssues(),
+			"closed_issues": milestone.GetClosedIssues(),
+			"progress":      progress,
+			"html_url":      milestone.GetHTMLURL(),
+		})
+	}
+	return results
+}
+
+func dataSourceGithubRepositoryMilestonesRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	owner := meta.(*Owner).name
+	if v, ok := d.GetOk("owner"); ok {
+		owner = v.(string)
+	}
+	repoName := d.Get("repository").(string)
+	state := d.Get("state").(string)
+
+	opts := &github.MilestoneListOptions{
+		State:       state,
+		Sort:        "due_on",
+		Direction:   "asc",
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var milestones []*github.Milestone
+	for {
+		page, resp, err := client.Issues.ListMilestones(ctx, owner, repoName, opts)
+		if err != nil {
+			return err
+		}
+		milestones = append(milestones, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repoName, state))
+	if err := d.Set("milestones", flattenMilestones(milestones)); err != nil {
+		return err
+	}
+
+	return nil
+}
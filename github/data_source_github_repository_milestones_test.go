@@ -0,0 +1,49 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceGithubRepositoryMilestonesRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/milestones", func(w http.ResponseWriter, req *http.Request) {
+		if state := req.URL.Query().Get("state"); state != "all" {
+			t.Errorf("unexpected state %s", state)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"number": 2, "title": "v1.1", "state": "open", "due_on": "2026-11-30T08:00:00Z",
+			"open_issues": 3, "closed_issues": 4, "html_url": "https://github.com/acme/app/milestone/2"},
+			{"number": 1, "title": "v1.0", "state": "closed", "open_issues": 0, "closed_issues": 0}]`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubRepositoryMilestones().Schema, map[string]any{
+		"repository": "app",
+		"state":      "all",
+	})
+	if err := dataSourceGithubRepositoryMilestonesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, expected := range map[string]any{
+		"milestones.#":          2,
+		"milestones.0.number":   2,
+		"milestones.0.due_date": "2026-11-30",
+		"milestones.0.progress": 57,
+		"milestones.1.state":    "closed",
+		"milestones.1.due_date": "",
+		"milestones.1.progress": 0,
+	} {
+		if got := d.Get(key); got != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, got)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubRepositoryPopularity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the stargazers, watchers and forks of a repository",
+		Read:        dataSourceGithubRepositoryPopularityRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(0, 1000), "max_results"),
+				Description:      "The maximum number of stargazers, watchers and forks to list, up to 1000. Only the counts are returned when 0.",
+			},
+			"stargazers_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users who starred the repository.",
+			},
+			"watchers_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users who watch the repository.",
+			},
+			"forks_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of forks of the repository.",
+			},
+			"stargazers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The logins of the first users who starred the repository, oldest first, up to 'max_results'.",
+			},
+			"watchers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The logins of the users who watch the repository, up to 'max_results'.",
+			},
+			"forks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The full names of the forks of the repository, most recent first, up to 'max_results'.",
+			},
+		},
+	}
+}
+
+// listUpTo pages through a list until it has maxResults items, so that the
+// lists of popular repositories do not take thousands of requests.
+func listUpTo(maxResults int, list func(opts *github.ListOptions) ([]string, *github.Response, error)) ([]string, error) {
+	results := make([]string, 0)
+	if maxResults == 0 {
+		return results, nil
+	}
+
+	opts := &github.ListOptions{PerPage: min(maxResults, maxPerPage)}
+	for {
+		items, resp, err := list(opts)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, items...)
+
+		if len(results) >= maxResults {
+			return results[:maxResults], nil
+		}
+		if resp.NextPage == 0 {
+			return results, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func dataSourceGithubRepositoryPopularityRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	maxResults := d.Get("max_results").(int)
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	stargazers, err := listUpTo(maxResults, func(opts *github.ListOptions) ([]string, *github.Response, error) {
+		page, resp, err := client.Activity.ListStargazers(ctx, owner, repoName, opts)
+		logins := make([]string, 0, len(page))
+		for _, stargazer := range page {
+			logins = append(logins, stargazer.GetUser().GetLogin())
+		}
+		return logins, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	watchers, err := listUpTo(maxResults, func(opts *github.ListOptions) ([]string, *github.Response, error) {
+		page, resp, err := client.Activity.ListWatchers(ctx, owner, repoName, opts)
+		logins := make([]string, 0, len(page))
+		for _, watcher := range page {
+			logins = append(logins, watcher.GetLogin())
+		}
+		return logins, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	forks, err := listUpTo(maxResults, func(opts *github.ListOptions) ([]string, *github.Response, error) {
+		page, resp, err := client.Repositories.ListForks(ctx, owner, repoName, &github.RepositoryListForksOptions{
+			Sort:        "newest",
+			ListOptions: *opts,
+		})
+		fullNames := make([]string, 0, len(page))
+		for _, fork := range page {
+			fullNames = append(fullNames, fork.GetFullName())
+		}
+		return fullNames, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	if err = d.Set("stargazers_count", repo.GetStargazersCount()); err != nil {
+		return err
+	}
+	// The watchers of the API are the stargazers, the users watching the
+	// repository are its subscribers.
+	if err = d.Set("watchers_count", repo.GetSubscribersCount()); err != nil {
+		return err
+	}
+	if err = d.Set("forks_count", repo.GetForksCount()); err != nil {
+		return err
+	}
+	if err = d.Set("stargazers", stargazers); err != nil {
+		return err
+	}
+	if err = d.Set("watchers", watchers); err != nil {
+		return err
+	}
+	if err = d.Set("forks", forks); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestListUpTo(t *testing.T) {
+	var requests int
+	list := func(opts *github.ListOptions) ([]string, *github.Response, error) {
+		requests++
+		items := make([]string, opts.PerPage)
+		for i := range items {
+			items[i] = fmt.Sprintf("item-%d", requests*opts.PerPage+i)
+		}
+		return items, &github.Response{NextPage: requests + 1}, nil
+	}
+
+	results, err := listUpTo(150, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 150 || requests != 2 {
+		t.Errorf("expected 150 results in 2 requests, got %d in %d", len(results), requests)
+	}
+
+	requests = 0
+	if results, _ = listUpTo(0, list); len(results) != 0 || requests != 0 {
+		t.Errorf("expected no request when not listing, got %d results in %d", len(results), requests)
+	}
+}
+
+func TestAccGithubRepositoryPopularityDataSource(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("queries the popularity of a repository", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			data "github_repository_popularity" "test" {
+				repository  = github_repository.test.name
+				max_results = 10
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_repository_popularity.test", "stargazers_count", "0"),
+			resource.TestCheckResourceAttr("data.github_repository_popularity.test", "stargazers.#", "0"),
+			resource.TestCheckResourceAttr("data.github_repository_popularity.test", "forks_count", "0"),
+			resource.TestCheckResourceAttrSet("data.github_repository_popularity.test", "watchers_count"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,170 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoryRulesetHistory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the version history of a repository ruleset",
+		Read:        dataSourceGithubRepositoryRulesetHistoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the ruleset.",
+			},
+			"version_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of a version of the ruleset to get the state of. Only this version is returned when set.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON encoded ruleset as of 'version_id', empty unless 'version_id' is set.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the ruleset, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the version.",
+						},
+						"actor_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the actor that made the change.",
+						},
+						"actor_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the actor that made the change, e.g. 'User'.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the change.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// rulesetVersion is a version of a ruleset as returned by the ruleset history
+// API, which is not supported by go-github.
+type rulesetVersion struct {
+	VersionID int64 `json:"version_id"`
+	Actor     struct {
+		ID   int64  `json:"id"`
+		Type string `json:"type"`
+	} `json:"actor"`
+	UpdatedAt github.Timestamp `json:"updated_at"`
+	State     json.RawMessage  `json:"state,omitempty"`
+}
+
+func flattenRulesetVersion(version *rulesetVersion) map[string]any {
+	return map[string]any{
+		"version_id": version.VersionID,
+		"actor_id":   version.Actor.ID,
+		"actor_type": version.Actor.Type,
+		"updated_at": version.UpdatedAt.String(),
+	}
+}
+
+// listGithubRulesetVersions returns the versions of the ruleset at the
+// rulesetURL endpoint, most recent first.
+func listGithubRulesetVersions(ctx context.Context, client *github.Client, rulesetURL string) ([]*rulesetVersion, error) {
+	var versions []*rulesetVersion
+	page := 1
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s/history?per_page=%d&page=%d", rulesetURL, maxPerPage, page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*rulesetVersion
+		resp, err := client.Do(ctx, req, &result)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, result...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return versions, nil
+}
+
+func getGithubRulesetVersion(ctx context.Context, client *github.Client, rulesetURL string, versionID int64) (*rulesetVersion, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("%s/history/%d", rulesetURL, versionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	version := new(rulesetVersion)
+	if _, err := client.Do(ctx, req, version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func dataSourceGithubRepositoryRulesetHistoryRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	rulesetURL := fmt.Sprintf("repos/%s/%s/rulesets/%d", owner, repoName, rulesetID)
+
+	versions := make([]any, 0)
+	state := ""
+	if v, ok := d.GetOk("version_id"); ok {
+		version, err := getGithubRulesetVersion(ctx, client, rulesetURL, int64(v.(int)))
+		if err != nil {
+			return err
+		}
+		versions = append(versions, flattenRulesetVersion(version))
+		state = string(version.State)
+	} else {
+		result, err := listGithubRulesetVersions(ctx, client, rulesetURL)
+		if err != nil {
+			return err
+		}
+		for _, version := range result {
+			versions = append(versions, flattenRulesetVersion(version))
+		}
+	}
+
+	d.SetId(buildTwoPartID(repoName, strconv.FormatInt(rulesetID, 10)))
+	if err := d.Set("versions", versions); err != nil {
+		return err
+	}
+	if err := d.Set("state", state); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositoryRulesetHistoryDataSource(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("queries the history of a repository ruleset", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_ruleset" "test" {
+				name        = "test"
+				repository  = github_repository.test.name
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+
+			data "github_repository_ruleset_history" "test" {
+				repository = github_repository.test.name
+				ruleset_id = github_repository_ruleset.test.ruleset_id
+			}
+
+			data "github_repository_ruleset_history" "version" {
+				repository = github_repository.test.name
+				ruleset_id = github_repository_ruleset.test.ruleset_id
+				version_id = data.github_repository_ruleset_history.test.versions[0].version_id
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_repository_ruleset_history.test", "versions.#", "1"),
+			resource.TestCheckResourceAttrSet("data.github_repository_ruleset_history.test", "versions.0.actor_id"),
+			resource.TestCheckResourceAttrSet("data.github_repository_ruleset_history.test", "versions.0.updated_at"),
+			resource.TestCheckResourceAttr("data.github_repository_ruleset_history.test", "state", ""),
+			resource.TestCheckResourceAttr("data.github_repository_ruleset_history.version", "versions.#", "1"),
+			resource.TestCheckResourceAttrSet("data.github_repository_ruleset_history.version", "state"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -44,6 +44,10 @@ func dataSourceGithubRepositoryTeams() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -83,10 +87,17 @@ func dataSourceGithubTeamsRead(d *schema.ResourceData, meta any) error {
 			return err
 		}
 		for _, team := range teams {
+			// The permission of a team with a custom role is the name of
+			// the role, its base permission is the highest of its permissions
+			permission := getBasePermission(team.Permissions)
+			if permission == "" {
+				permission = team.GetPermission()
+			}
 			new_team := map[string]string{
 				"name":       *team.Name,
 				"slug":       *team.Slug,
-				"permission": *team.Permission,
+				"permission": permission,
+				"role_name":  getRoleName(team.GetPermission()),
 			}
 			all_teams = append(all_teams, new_team)
 		}
@@ -10,7 +10,7 @@ import (
 
 func dataSourceGithubRepositoryWebhooks() *schema.Resource {
 	return &schema.Resource{
-		Description: "Get information on all GitHub webhooks of the organization.",
+		Description: "Get information on all GitHub webhooks of a repository.",
 		Read:        dataSourceGithubRepositoryWebhooksRead,
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +43,12 @@ func dataSourceGithubRepositoryWebhooks() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"configuration": webhookConfigurationDataSourceSchema(),
 					},
 				},
 			},
@@ -103,9 +109,22 @@ func flattenGitHubWebhooks(hooks []*github.Hook) []map[string]any {
 		result["name"] = hook.Name
 		result["url"] = hook.URL
 		result["active"] = hook.Active
+		result["events"] = hook.Events
+		result["configuration"] = flattenWebhookDataSourceConfig(hook.Config)
 
 		results = append(results, result)
 	}
 
 	return results
 }
+
+// flattenWebhookDataSourceConfig returns the configuration of a webhook
+// without its secret, which is only ever returned masked.
+func flattenWebhookDataSourceConfig(config *github.HookConfig) []any {
+	if config == nil {
+		return []any{}
+	}
+	cfg := interfaceFromWebhookConfig(config)[0].(map[string]any)
+	delete(cfg, "secret")
+	return []any{cfg}
+}
@@ -4,10 +4,36 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestFlattenGitHubWebhooks(t *testing.T) {
+	hooks := []*github.Hook{{
+		ID:     github.Ptr(int64(1)),
+		Events: []string{"push"},
+		Config: &github.HookConfig{
+			URL:         github.Ptr("https://example.com/webhook"),
+			ContentType: github.Ptr("json"),
+			InsecureSSL: github.Ptr("0"),
+			Secret:      github.Ptr("********"),
+		},
+	}}
+
+	results := flattenGitHubWebhooks(hooks)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 webhook, got %d", len(results))
+	}
+	config := results[0]["configuration"].([]any)[0].(map[string]any)
+	if _, ok := config["secret"]; ok {
+		t.Errorf("expected the secret not to be flattened, got %v", config)
+	}
+	if config["url"] != "https://example.com/webhook" || config["insecure_ssl"] != false {
+		t.Errorf("unexpected configuration %v", config)
+	}
+}
+
 func TestAccGithubRepositoryWebhooksDataSource(t *testing.T) {
 	t.Run("manages repository webhooks", func(t *testing.T) {
 		repoName := fmt.Sprintf("tf-acc-test-webhooks-%s", acctest.RandString(5))
@@ -43,6 +69,10 @@ func TestAccGithubRepositoryWebhooksDataSource(t *testing.T) {
 			resource.TestCheckResourceAttr(resourceName, "webhooks.0.name", "web"),
 			resource.TestCheckResourceAttr(resourceName, "webhooks.0.url", "https://google.de/webhook"),
 			resource.TestCheckResourceAttr(resourceName, "webhooks.0.active", "true"),
+			resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.#", "1"),
+			resource.TestCheckResourceAttr(resourceName, "webhooks.0.events.0", "pull_request"),
+			resource.TestCheckResourceAttr(resourceName, "webhooks.0.configuration.0.content_type", "json"),
+			resource.TestCheckResourceAttr(resourceName, "webhooks.0.configuration.0.insecure_ssl", "true"),
 			resource.TestCheckResourceAttrSet(resourceName, "webhooks.0.id"),
 		)
 
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverCapabilitiesMinimumEnterpriseVersions are the first GitHub Enterprise
+// Server releases supporting features of the provider. GitHub.com and GitHub
+// Enterprise Cloud support all of them.
+var serverCapabilitiesMinimumEnterpriseVersions = map[string]*version.Version{
+	"actions_variables":     version.Must(version.NewVersion("3.8")),
+	"custom_properties":     version.Must(version.NewVersion("3.12")),
+	"organization_roles":    version.Must(version.NewVersion("3.14")),
+	"organization_rulesets": version.Must(version.NewVersion("3.11")),
+	"push_rulesets":         pushRulesetsMinimumEnterpriseVersion,
+	"repository_rulesets":   version.Must(version.NewVersion("3.11")),
+}
+
+func dataSourceGithubServerCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the features of the provider supported by the connected GitHub instance.",
+		Read:        dataSourceGithubServerCapabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"is_enterprise_server": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the connected instance is a GitHub Enterprise Server.",
+			},
+			"enterprise_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the connected GitHub Enterprise Server, empty for GitHub.com and GitHub Enterprise Cloud.",
+			},
+			"features": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Whether each feature is supported by the connected instance, keyed by feature: 'actions_variables', 'custom_properties', 'organization_roles', 'organization_rulesets', 'push_rulesets' and 'repository_rulesets'.",
+			},
+		},
+	}
+}
+
+// serverCapabilities returns whether each feature is supported by a GitHub
+// Enterprise Server of the given version, or by GitHub.com if it is empty.
+func serverCapabilities(enterpriseVersion string) (map[string]bool, error) {
+	features := make(map[string]bool, len(serverCapabilitiesMinimumEnterpriseVersions))
+	if enterpriseVersion == "" {
+		for feature := range serverCapabilitiesMinimumEnterpriseVersions {
+			features[feature] = true
+		}
+		return features, nil
+	}
+
+	v, err := version.NewVersion(enterpriseVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse GitHub Enterprise Server version %q: %w", enterpriseVersion, err)
+	}
+	for feature, minimum := range serverCapabilitiesMinimumEnterpriseVersions {
+		features[feature] = v.GreaterThanOrEqual(minimum)
+	}
+	return features, nil
+}
+
+func dataSourceGithubServerCapabilitiesRead(d *schema.ResourceData, meta any) error {
+	owner := meta.(*Owner)
+
+	enterpriseVersion, err := owner.EnterpriseVersion(owner.StopContext)
+	if err != nil {
+		return err
+	}
+	features, err := serverCapabilities(enterpriseVersion)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("github-server-capabilities")
+	if err = d.Set("is_enterprise_server", enterpriseVersion != ""); err != nil {
+		return err
+	}
+	if err = d.Set("enterprise_version", enterpriseVersion); err != nil {
+		return err
+	}
+	if err = d.Set("features", features); err != nil {
+		return err
+	}
+
+	return nil
+}
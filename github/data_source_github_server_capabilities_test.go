@@ -0,0 +1,82 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestServerCapabilities(t *testing.T) {
+	features, err := serverCapabilities("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for feature, supported := range features {
+		if !supported {
+			t.Errorf("expected feature %s to be supported on GitHub.com", feature)
+		}
+	}
+
+	features, err = serverCapabilities("3.11.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !features["repository_rulesets"] || !features["organization_rulesets"] || !features["actions_variables"] {
+		t.Errorf("expected rulesets and actions variables to be supported on 3.11.4, got %v", features)
+	}
+	if features["push_rulesets"] || features["organization_roles"] {
+		t.Errorf("expected push rulesets and organization roles to be unsupported on 3.11.4, got %v", features)
+	}
+
+	features, err = serverCapabilities("3.7.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if features["repository_rulesets"] || features["actions_variables"] {
+		t.Errorf("expected rulesets and actions variables to be unsupported on 3.7.0, got %v", features)
+	}
+
+	if _, err = serverCapabilities("not-a-version"); err == nil {
+		t.Error("expected an invalid version to be rejected")
+	}
+}
+
+func TestAccGithubServerCapabilitiesDataSource(t *testing.T) {
+
+	t.Run("reads the server capabilities without error", func(t *testing.T) {
+
+		config := `data "github_server_capabilities" "test" {}`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("data.github_server_capabilities.test", "is_enterprise_server"),
+			resource.TestCheckResourceAttr("data.github_server_capabilities.test", "features.%", "6"),
+			resource.TestCheckResourceAttrSet("data.github_server_capabilities.test", "features.repository_rulesets"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			testCase(t, anonymous)
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
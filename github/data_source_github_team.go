@@ -61,6 +61,10 @@ func dataSourceGithubTeam() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -90,6 +94,10 @@ func dataSourceGithubTeam() *schema.Resource {
 }
 
 func dataSourceGithubTeamRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	slug := d.Get("slug").(string)
 
 	client := meta.(*Owner).v3client
@@ -180,8 +188,9 @@ func dataSourceGithubTeamRead(d *schema.ResourceData, meta any) error {
 			for _, v := range repository {
 				repositories = append(repositories, v.GetName())
 				repositories_detailed = append(repositories_detailed, map[string]any{
-					"repo_id":   v.GetID(),
-					"role_name": v.GetRoleName(),
+					"repo_id":    v.GetID(),
+					"role_name":  v.GetRoleName(),
+					"permission": getBasePermission(v.Permissions),
 				})
 			}
 
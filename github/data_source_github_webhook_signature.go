@@ -0,0 +1,67 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubWebhookSignature() *schema.Resource {
+	return &schema.Resource{
+		Description: "Compute the signatures GitHub sends along a webhook payload.",
+		Read:        dataSourceGithubWebhookSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The webhook payload, exactly as delivered in the request body.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret configured on the webhook.",
+			},
+			"signature_256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expected value of the 'X-Hub-Signature-256' header, e.g. 'sha256=...'.",
+			},
+			"signature": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expected value of the legacy 'X-Hub-Signature' header, e.g. 'sha1=...'.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubWebhookSignatureRead(d *schema.ResourceData, meta any) error {
+	payload := []byte(d.Get("payload").(string))
+	secret := []byte(d.Get("secret").(string))
+
+	signature256 := "sha256=" + computeWebhookSignature(sha256.New, secret, payload)
+	if err := d.Set("signature_256", signature256); err != nil {
+		return err
+	}
+	if err := d.Set("signature", "sha1="+computeWebhookSignature(sha1.New, secret, payload)); err != nil {
+		return err
+	}
+	d.SetId(signature256)
+
+	return nil
+}
+
+// computeWebhookSignature returns the hex encoded HMAC of payload, as computed
+// by GitHub for the signature headers of webhook deliveries.
+func computeWebhookSignature(h func() hash.Hash, secret, payload []byte) string {
+	mac := hmac.New(h, secret)
+	// Hash.Write never returns an error. See https://pkg.go.dev/hash#Hash
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestComputeWebhookSignature(t *testing.T) {
+	// Example from https://docs.github.com/en/webhooks/using-webhooks/validating-webhook-deliveries
+	got := computeWebhookSignature(sha256.New, []byte("It's a Secret to Everybody"), []byte("Hello, World!"))
+	want := "757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if got != want {
+		t.Errorf("computeWebhookSignature() = %q, want %q", got, want)
+	}
+}
+
+func TestAccGithubWebhookSignatureDataSource(t *testing.T) {
+	t.Run("computes webhook signatures", func(t *testing.T) {
+		config := `
+			data "github_webhook_signature" "test" {
+			  payload = "Hello, World!"
+			  secret  = "It's a Secret to Everybody"
+			}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_webhook_signature.test", "signature_256", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"),
+			resource.TestCheckResourceAttrSet("data.github_webhook_signature.test", "signature"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			testCase(t, anonymous)
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
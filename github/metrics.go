@@ -0,0 +1,290 @@
+package github
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// metricsSlowestEndpoints is the number of endpoints listed in the
+// slowest_endpoints section of the metrics summary.
+const metricsSlowestEndpoints = 10
+
+// metricsFlushInterval is how often the metrics summary is written while the
+// provider makes API calls.
+const metricsFlushInterval = 5 * time.Second
+
+var (
+	metricsRecordersMu sync.Mutex
+	metricsRecorders   []*MetricsRecorder
+)
+
+var metricsNumericPathSegment = regexp.MustCompile(`/[0-9]+(/|$)`)
+
+// MetricsRecorder collects statistics about the GitHub API calls made by the
+// provider and writes them as a JSON summary to a file.
+//
+// Terraform does not notify providers at the end of an apply, the summary is
+// therefore written periodically in the background, and once more by
+// FlushMetrics when the provider exits, so that the file holds the totals of
+// the whole run without writing it on every call.
+type MetricsRecorder struct {
+	path string
+	now  func() time.Time
+
+	// writeMu serializes the writes of the file, so that an older summary
+	// never replaces a newer one.
+	writeMu sync.Mutex
+
+	mu                 sync.Mutex
+	dirty              bool
+	startedAt          time.Time
+	calls              int
+	attempts           int
+	errors             int
+	rateLimitRemaining *int
+	rateLimitReset     *time.Time
+	endpoints          map[string]*endpointMetrics
+}
+
+type endpointMetrics struct {
+	calls         int
+	totalDuration time.Duration
+	maxDuration   time.Duration
+}
+
+type metricsSummary struct {
+	StartedAt          time.Time                `json:"started_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+	APICalls           int                      `json:"api_calls"`
+	Retries            int                      `json:"retries"`
+	Errors             int                      `json:"errors"`
+	RateLimitRemaining *int                     `json:"rate_limit_remaining"`
+	RateLimitReset     *time.Time               `json:"rate_limit_reset"`
+	SlowestEndpoints   []endpointMetricsSummary `json:"slowest_endpoints"`
+}
+
+type endpointMetricsSummary struct {
+	Endpoint        string `json:"endpoint"`
+	Calls           int    `json:"calls"`
+	MaxDurationMs   int64  `json:"max_duration_ms"`
+	TotalDurationMs int64  `json:"total_duration_ms"`
+}
+
+// NewMetricsRecorder returns a MetricsRecorder writing its summary to path
+// every metricsFlushInterval and when FlushMetrics is called.
+func NewMetricsRecorder(path string) *MetricsRecorder {
+	m := &MetricsRecorder{
+		path:      path,
+		now:       time.Now,
+		startedAt: time.Now(),
+		endpoints: make(map[string]*endpointMetrics),
+	}
+
+	metricsRecordersMu.Lock()
+	metricsRecorders = append(metricsRecorders, m)
+	metricsRecordersMu.Unlock()
+
+	go func() {
+		for range time.Tick(metricsFlushInterval) {
+			m.flushAndLog()
+		}
+	}()
+
+	return m
+}
+
+// FlushMetrics writes the pending metrics summaries. It is meant to be called
+// when the provider exits.
+func FlushMetrics() {
+	metricsRecordersMu.Lock()
+	recorders := slices.Clone(metricsRecorders)
+	metricsRecordersMu.Unlock()
+
+	for _, m := range recorders {
+		m.flushAndLog()
+	}
+}
+
+// metricsEndpoint groups requests by method and path, with numeric path
+// segments such as team or hook IDs replaced by a placeholder.
+func metricsEndpoint(req *http.Request) string {
+	path := req.URL.Path
+	for metricsNumericPathSegment.MatchString(path) {
+		path = metricsNumericPathSegment.ReplaceAllString(path, "/{id}$1")
+	}
+	return req.Method + " " + path
+}
+
+// CallTransport wraps a transport to record each API call made by the
+// provider, including the time spent retrying it. It is meant to wrap the
+// outermost transport of the client.
+func (m *MetricsRecorder) CallTransport(rt http.RoundTripper) http.RoundTripper {
+	return &metricsCallTransport{transport: rt, metrics: m}
+}
+
+// AttemptTransport wraps a transport to record each request sent to GitHub,
+// so that retries can be told apart from calls. It is meant to wrap the
+// innermost transport of the client.
+func (m *MetricsRecorder) AttemptTransport(rt http.RoundTripper) http.RoundTripper {
+	return &metricsAttemptTransport{transport: rt, metrics: m}
+}
+
+type metricsCallTransport struct {
+	transport http.RoundTripper
+	metrics   *MetricsRecorder
+}
+
+func (t *metricsCallTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.metrics.now()
+	resp, err := t.transport.RoundTrip(req)
+	t.metrics.recordCall(metricsEndpoint(req), t.metrics.now().Sub(start), resp, err)
+	return resp, err
+}
+
+type metricsAttemptTransport struct {
+	transport http.RoundTripper
+	metrics   *MetricsRecorder
+}
+
+func (t *metricsAttemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.metrics.mu.Lock()
+	t.metrics.attempts++
+	t.metrics.mu.Unlock()
+
+	return t.transport.RoundTrip(req)
+}
+
+func (m *MetricsRecorder) recordCall(endpoint string, duration time.Duration, resp *http.Response, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls++
+	if err != nil || resp == nil || resp.StatusCode >= 400 {
+		m.errors++
+	}
+
+	e, ok := m.endpoints[endpoint]
+	if !ok {
+		e = &endpointMetrics{}
+		m.endpoints[endpoint] = e
+	}
+	e.calls++
+	e.totalDuration += duration
+	if duration > e.maxDuration {
+		e.maxDuration = duration
+	}
+
+	if resp != nil {
+		if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+			m.rateLimitRemaining = &remaining
+		}
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			resetAt := time.Unix(reset, 0).UTC()
+			m.rateLimitReset = &resetAt
+		}
+	}
+
+	m.dirty = true
+}
+
+// Flush writes the summary to the file if API calls were recorded since the
+// last write.
+func (m *MetricsRecorder) Flush() error {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	m.mu.Lock()
+	if !m.dirty {
+		m.mu.Unlock()
+		return nil
+	}
+	summary := m.summary()
+	m.dirty = false
+	m.mu.Unlock()
+
+	if err := writeMetricsSummary(m.path, summary); err != nil {
+		m.mu.Lock()
+		m.dirty = true
+		m.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (m *MetricsRecorder) flushAndLog() {
+	if err := m.Flush(); err != nil {
+		log.Printf("[WARN] Unable to write the GitHub API metrics to %s: %s", m.path, err)
+	}
+}
+
+// summary builds the metrics summary, the caller must hold the lock.
+func (m *MetricsRecorder) summary() metricsSummary {
+	endpoints := make([]endpointMetricsSummary, 0, len(m.endpoints))
+	for endpoint, e := range m.endpoints {
+		endpoints = append(endpoints, endpointMetricsSummary{
+			Endpoint:        endpoint,
+			Calls:           e.calls,
+			MaxDurationMs:   e.maxDuration.Milliseconds(),
+			TotalDurationMs: e.totalDuration.Milliseconds(),
+		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].MaxDurationMs != endpoints[j].MaxDurationMs {
+			return endpoints[i].MaxDurationMs > endpoints[j].MaxDurationMs
+		}
+		return endpoints[i].Endpoint < endpoints[j].Endpoint
+	})
+	if len(endpoints) > metricsSlowestEndpoints {
+		endpoints = endpoints[:metricsSlowestEndpoints]
+	}
+
+	// Requests sent more than once by the retry or rate limit transports
+	// are counted as retries.
+	retries := max(m.attempts-m.calls, 0)
+
+	return metricsSummary{
+		StartedAt:          m.startedAt.UTC(),
+		UpdatedAt:          m.now().UTC(),
+		APICalls:           m.calls,
+		Retries:            retries,
+		Errors:             m.errors,
+		RateLimitRemaining: m.rateLimitRemaining,
+		RateLimitReset:     m.rateLimitReset,
+		SlowestEndpoints:   endpoints,
+	}
+}
+
+// writeMetricsSummary replaces the metrics file at path with summary. The
+// file is renamed into place so that readers never see a partially written
+// summary.
+func writeMetricsSummary(path string, summary metricsSummary) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	cases := map[string]string{
+		"/repos/test/blah":                "GET /repos/test/blah",
+		"/organizations/1234/team/5678":   "GET /organizations/{id}/team/{id}",
+		"/repos/test/blah/hooks/42/pings": "GET /repos/test/blah/hooks/{id}/pings",
+	}
+
+	for path, expected := range cases {
+		req := httptest.NewRequest("GET", path, nil)
+		if got := metricsEndpoint(req); got != expected {
+			t.Errorf("Expected endpoint of %s to be %q, got: %q", path, expected, got)
+		}
+	}
+}
+
+func TestMetricsRecorder(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-RateLimit-Remaining", "4990")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		// The first request is failing to exercise the retry transport.
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	metrics := NewMetricsRecorder(path)
+
+	client := &http.Client{Transport: metrics.AttemptTransport(http.DefaultTransport)}
+	client.Transport = NewRetryTransport(client.Transport, WithRetryDelay(time.Millisecond), WithMaxRetries(1))
+	client.Transport = metrics.CallTransport(client.Transport)
+
+	for _, p := range []string{"/repos/test/blah", "/orgs/test/teams/1"} {
+		resp, err := client.Get(ts.URL + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// The calls are not written until the recorder is flushed.
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("Expected no metrics file before flushing, got: %v", err)
+	}
+	FlushMetrics()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary metricsSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.APICalls != 2 {
+		t.Errorf("Expected 2 API calls, got: %d", summary.APICalls)
+	}
+	if summary.Retries != 1 {
+		t.Errorf("Expected 1 retry, got: %d", summary.Retries)
+	}
+	if summary.Errors != 0 {
+		t.Errorf("Expected no errors, got: %d", summary.Errors)
+	}
+	if summary.RateLimitRemaining == nil || *summary.RateLimitRemaining != 4990 {
+		t.Errorf("Expected 4990 remaining requests, got: %v", summary.RateLimitRemaining)
+	}
+	if summary.RateLimitReset == nil || summary.RateLimitReset.Unix() != 1700000000 {
+		t.Errorf("Expected rate limit reset at 1700000000, got: %v", summary.RateLimitReset)
+	}
+	if len(summary.SlowestEndpoints) != 2 {
+		t.Fatalf("Expected 2 endpoints, got: %d", len(summary.SlowestEndpoints))
+	}
+	for _, e := range summary.SlowestEndpoints {
+		if e.Endpoint != "GET /repos/test/blah" && e.Endpoint != "GET /orgs/test/teams/{id}" {
+			t.Errorf("Unexpected endpoint %q", e.Endpoint)
+		}
+		if e.Calls != 1 {
+			t.Errorf("Expected 1 call to %s, got: %d", e.Endpoint, e.Calls)
+		}
+	}
+}
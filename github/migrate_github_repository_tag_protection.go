@@ -0,0 +1,48 @@
+package github
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryTagProtectionV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tag_protection_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGithubRepositoryTagProtectionUpgradeV0 upgrades the state of a tag
+// protection to the tag ruleset GitHub migrated it to. Without one, the ID is
+// zeroed so that the next apply creates it.
+func resourceGithubRepositoryTagProtectionUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	repoName := rawState["repository"].(string)
+	pattern := rawState["pattern"].(string)
+
+	rulesetID, err := findTagProtectionRuleset(ctx, meta, repoName, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	rawState["id"] = strconv.FormatInt(rulesetID, 10)
+	rawState["ruleset_id"] = rulesetID
+	delete(rawState, "tag_protection_id")
+
+	return rawState, nil
+}
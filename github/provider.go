@@ -13,6 +13,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/oauth2"
 )
 
 func Provider() *schema.Provider {
@@ -63,6 +65,13 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("GITHUB_BASE_URL", "https://api.github.com/"),
 				Description: descriptions["base_url"],
 			},
+			"graphql_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("GITHUB_GRAPHQL_URL", nil),
+				ValidateDiagFunc: toDiagFunc(validation.IsURLWithHTTPorHTTPS, "graphql_url"),
+				Description:      descriptions["graphql_url"],
+			},
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -93,6 +102,13 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["parallel_requests"],
 			},
+			"max_concurrent_requests": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("GITHUB_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(0), "max_concurrent_requests"),
+				Description:      descriptions["max_concurrent_requests"],
+			},
 			"rate_limiter": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -106,6 +122,46 @@ func Provider() *schema.Provider {
 					return
 				},
 			},
+			"metrics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_METRICS_FILE", ""),
+				Description: descriptions["metrics_file"],
+			},
+			"etag_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["etag_cache"],
+			},
+			"correlation_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("GITHUB_CORRELATION_ID", ""),
+				ValidateDiagFunc: toDiagFunc(validation.StringMatch(regexp.MustCompile(`^[\w.:/-]*$`), "must only contain letters, digits and the characters '_', '.', ':', '/' and '-'"), "correlation_id"),
+				Description:      descriptions["correlation_id"],
+			},
+			"skip_refresh_resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions["skip_refresh_resources"],
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSkipRefreshPattern,
+				},
+			},
+			"batch_repository_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["batch_repository_reads"],
+			},
+			"repository_visibility_timeout_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30000,
+				Description: descriptions["repository_visibility_timeout_ms"],
+			},
 			"app_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -140,7 +196,10 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"github_enterprise_actions_permissions":                                 resourceGithubActionsEnterprisePermissions(),
 			"github_actions_environment_secret":                                     resourceGithubActionsEnvironmentSecret(),
+			"github_actions_environment_secrets":                                    resourceGithubActionsEnvironmentSecrets(),
 			"github_actions_environment_variable":                                   resourceGithubActionsEnvironmentVariable(),
+			"github_actions_environment_variables":                                  resourceGithubActionsEnvironmentVariables(),
+			"github_actions_hosted_runner":                                          resourceGithubActionsHostedRunner(),
 			"github_actions_organization_oidc_subject_claim_customization_template": resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate(),
 			"github_actions_organization_permissions":                               resourceGithubActionsOrganizationPermissions(),
 			"github_actions_organization_secret":                                    resourceGithubActionsOrganizationSecret(),
@@ -152,12 +211,14 @@ func Provider() *schema.Provider {
 			"github_actions_runner_group":                                           resourceGithubActionsRunnerGroup(),
 			"github_actions_secret":                                                 resourceGithubActionsSecret(),
 			"github_actions_variable":                                               resourceGithubActionsVariable(),
+			"github_actions_workflow_dispatch":                                      resourceGithubActionsWorkflowDispatch(),
 			"github_app_installation_repositories":                                  resourceGithubAppInstallationRepositories(),
 			"github_app_installation_repository":                                    resourceGithubAppInstallationRepository(),
 			"github_branch":                                                         resourceGithubBranch(),
 			"github_branch_default":                                                 resourceGithubBranchDefault(),
 			"github_branch_protection":                                              resourceGithubBranchProtection(),
 			"github_branch_protection_v3":                                           resourceGithubBranchProtectionV3(),
+			"github_branch_protections":                                             resourceGithubBranchProtections(),
 			"github_codespaces_organization_secret":                                 resourceGithubCodespacesOrganizationSecret(),
 			"github_codespaces_organization_secret_repositories":                    resourceGithubCodespacesOrganizationSecretRepositories(),
 			"github_codespaces_secret":                                              resourceGithubCodespacesSecret(),
@@ -170,27 +231,52 @@ func Provider() *schema.Provider {
 			"github_issue_label":                                                    resourceGithubIssueLabel(),
 			"github_issue_labels":                                                   resourceGithubIssueLabels(),
 			"github_membership":                                                     resourceGithubMembership(),
+			"github_organization_app_installation":                                  resourceGithubOrganizationAppInstallation(),
 			"github_organization_block":                                             resourceOrganizationBlock(),
+			"github_organization_community_health_file":                             resourceGithubOrganizationCommunityHealthFile(),
+			"github_organization_custom_property_values":                            resourceGithubOrganizationCustomPropertyValues(),
 			"github_organization_custom_role":                                       resourceGithubOrganizationCustomRole(),
+			"github_organization_default_labels":                                    resourceGithubOrganizationDefaultLabels(),
+			"github_organization_external_collaborator":                             resourceGithubOrganizationExternalCollaborator(),
+			"github_organization_ip_allowlist_entry":                                resourceGithubOrganizationIpAllowListEntry(),
+			"github_organization_ip_allowlist_settings":                             resourceGithubOrganizationIpAllowListSettings(),
+			"github_organization_network_configuration":                             resourceGithubOrganizationNetworkConfiguration(),
+			"github_organization_role":                                              resourceGithubOrganizationRole(),
+			"github_organization_role_assignment":                                   resourceGithubOrganizationRoleAssignment(),
 			"github_organization_security_manager":                                  resourceGithubOrganizationSecurityManager(),
 			"github_organization_ruleset":                                           resourceGithubOrganizationRuleset(),
 			"github_organization_settings":                                          resourceGithubOrganizationSettings(),
 			"github_organization_webhook":                                           resourceGithubOrganizationWebhook(),
 			"github_release":                                                        resourceGithubRelease(),
+			"github_release_asset":                                                  resourceGithubReleaseAsset(),
 			"github_repository":                                                     resourceGithubRepository(),
 			"github_repository_autolink_reference":                                  resourceGithubRepositoryAutolinkReference(),
+			"github_repository_bypass_request_review":                               resourceGithubRepositoryBypassRequestReview(),
+			"github_repository_dependabot_alerts_dismissal":                         resourceGithubRepositoryDependabotAlertsDismissal(),
 			"github_repository_dependabot_security_updates":                         resourceGithubRepositoryDependabotSecurityUpdates(),
 			"github_repository_collaborator":                                        resourceGithubRepositoryCollaborator(),
 			"github_repository_collaborators":                                       resourceGithubRepositoryCollaborators(),
+			"github_repository_custom_properties":                                   resourceGithubRepositoryCustomProperties(),
 			"github_repository_custom_property":                                     resourceGithubRepositoryCustomProperty(),
 			"github_repository_deploy_key":                                          resourceGithubRepositoryDeployKey(),
+			"github_repository_deploy_keys":                                         resourceGithubRepositoryDeployKeys(),
+			"github_repository_deployment_branch_policies":                          resourceGithubRepositoryDeploymentBranchPolicies(),
 			"github_repository_deployment_branch_policy":                            resourceGithubRepositoryDeploymentBranchPolicy(),
 			"github_repository_environment":                                         resourceGithubRepositoryEnvironment(),
 			"github_repository_environment_deployment_policy":                       resourceGithubRepositoryEnvironmentDeploymentPolicy(),
 			"github_repository_file":                                                resourceGithubRepositoryFile(),
+			"github_repository_files":                                               resourceGithubRepositoryFiles(),
+			"github_repository_issue_form":                                          resourceGithubRepositoryIssueForm(),
 			"github_repository_milestone":                                           resourceGithubRepositoryMilestone(),
+			"github_repository_pages":                                               resourceGithubRepositoryPages(),
 			"github_repository_pull_request":                                        resourceGithubRepositoryPullRequest(),
+			"github_repository_pull_request_merge":                                  resourceGithubRepositoryPullRequestMerge(),
 			"github_repository_ruleset":                                             resourceGithubRepositoryRuleset(),
+			"github_repository_ruleset_evaluation_gate":                             resourceGithubRepositoryRulesetEvaluationGate(),
+			"github_repository_secret_scanning_alerts_resolution":                   resourceGithubRepositorySecretScanningAlertsResolution(),
+			"github_repository_stale_branch_policy":                                 resourceGithubRepositoryStaleBranchPolicy(),
+			"github_repository_tag_protection":                                      resourceGithubRepositoryTagProtection(),
+			"github_repository_template_sync":                                       resourceGithubRepositoryTemplateSync(),
 			"github_repository_topics":                                              resourceGithubRepositoryTopics(),
 			"github_repository_webhook":                                             resourceGithubRepositoryWebhook(),
 			"github_team":                                                           resourceGithubTeam(),
@@ -201,9 +287,12 @@ func Provider() *schema.Provider {
 			"github_team_sync_group_mapping":                                        resourceGithubTeamSyncGroupMapping(),
 			"github_user_gpg_key":                                                   resourceGithubUserGpgKey(),
 			"github_user_invitation_accepter":                                       resourceGithubUserInvitationAccepter(),
+			"github_user_invitations_accepter":                                      resourceGithubUserInvitationsAccepter(),
 			"github_user_ssh_key":                                                   resourceGithubUserSshKey(),
+			"github_workflow_repository_permissions":                                resourceGithubWorkflowRepositoryPermissions(),
 			"github_enterprise_organization":                                        resourceGithubEnterpriseOrganization(),
 			"github_enterprise_actions_runner_group":                                resourceGithubActionsEnterpriseRunnerGroup(),
+			"github_enterprise_ldap_team_mapping":                                   resourceGithubEnterpriseLDAPTeamMapping(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -213,6 +302,7 @@ func Provider() *schema.Provider {
 			"github_actions_organization_public_key":                                dataSourceGithubActionsOrganizationPublicKey(),
 			"github_actions_organization_registration_token":                        dataSourceGithubActionsOrganizationRegistrationToken(),
 			"github_actions_organization_secrets":                                   dataSourceGithubActionsOrganizationSecrets(),
+			"github_actions_organization_secrets_usage":                             dataSourceGithubActionsOrganizationSecretsUsage(),
 			"github_actions_organization_variables":                                 dataSourceGithubActionsOrganizationVariables(),
 			"github_actions_public_key":                                             dataSourceGithubActionsPublicKey(),
 			"github_actions_registration_token":                                     dataSourceGithubActionsRegistrationToken(),
@@ -222,6 +312,7 @@ func Provider() *schema.Provider {
 			"github_app":                                                            dataSourceGithubApp(),
 			"github_app_token":                                                      dataSourceGithubAppToken(),
 			"github_branch":                                                         dataSourceGithubBranch(),
+			"github_branch_default":                                                 dataSourceGithubBranchDefault(),
 			"github_branch_protection_rules":                                        dataSourceGithubBranchProtectionRules(),
 			"github_collaborators":                                                  dataSourceGithubCollaborators(),
 			"github_codespaces_organization_public_key":                             dataSourceGithubCodespacesOrganizationPublicKey(),
@@ -230,48 +321,69 @@ func Provider() *schema.Provider {
 			"github_codespaces_secrets":                                             dataSourceGithubCodespacesSecrets(),
 			"github_codespaces_user_public_key":                                     dataSourceGithubCodespacesUserPublicKey(),
 			"github_codespaces_user_secrets":                                        dataSourceGithubCodespacesUserSecrets(),
+			"github_dependabot_alerts":                                              dataSourceGithubDependabotAlerts(),
 			"github_dependabot_organization_public_key":                             dataSourceGithubDependabotOrganizationPublicKey(),
 			"github_dependabot_organization_secrets":                                dataSourceGithubDependabotOrganizationSecrets(),
 			"github_dependabot_public_key":                                          dataSourceGithubDependabotPublicKey(),
 			"github_dependabot_secrets":                                             dataSourceGithubDependabotSecrets(),
 			"github_external_groups":                                                dataSourceGithubExternalGroups(),
+			"github_integration_ids":                                                dataSourceGithubIntegrationIDs(),
 			"github_ip_ranges":                                                      dataSourceGithubIpRanges(),
 			"github_issue_labels":                                                   dataSourceGithubIssueLabels(),
 			"github_membership":                                                     dataSourceGithubMembership(),
 			"github_organization":                                                   dataSourceGithubOrganization(),
+			"github_organization_branch_protection_rules":                           dataSourceGithubOrganizationBranchProtectionRules(),
+			"github_organization_bypass_requests":                                   dataSourceGithubOrganizationBypassRequests(),
+			"github_organization_custom_properties":                                 dataSourceGithubOrganizationCustomProperties(),
 			"github_organization_custom_role":                                       dataSourceGithubOrganizationCustomRole(),
+			"github_organization_external_collaborators":                            dataSourceGithubOrganizationExternalCollaborators(),
 			"github_organization_external_identities":                               dataSourceGithubOrganizationExternalIdentities(),
 			"github_organization_ip_allow_list":                                     dataSourceGithubOrganizationIpAllowList(),
+			"github_organization_network_configurations":                            dataSourceGithubOrganizationNetworkConfigurations(),
+			"github_organization_repository_policy_violations":                      dataSourceGithubOrganizationRepositoryPolicyViolations(),
 			"github_organization_team_sync_groups":                                  dataSourceGithubOrganizationTeamSyncGroups(),
 			"github_organization_teams":                                             dataSourceGithubOrganizationTeams(),
 			"github_organization_webhooks":                                          dataSourceGithubOrganizationWebhooks(),
+			"github_rate_limit":                                                     dataSourceGithubRateLimit(),
 			"github_ref":                                                            dataSourceGithubRef(),
 			"github_release":                                                        dataSourceGithubRelease(),
 			"github_repositories":                                                   dataSourceGithubRepositories(),
 			"github_repository":                                                     dataSourceGithubRepository(),
 			"github_repository_autolink_references":                                 dataSourceGithubRepositoryAutolinkReferences(),
+			"github_repository_bypass_requests":                                     dataSourceGithubRepositoryBypassRequests(),
 			"github_repository_branches":                                            dataSourceGithubRepositoryBranches(),
 			"github_repository_custom_properties":                                   dataSourceGithubRepositoryCustomProperties(),
+			"github_repository_effective_permission":                                dataSourceGithubRepositoryEffectivePermission(),
 			"github_repository_environments":                                        dataSourceGithubRepositoryEnvironments(),
 			"github_repository_deploy_keys":                                         dataSourceGithubRepositoryDeployKeys(),
 			"github_repository_deployment_branch_policies":                          dataSourceGithubRepositoryDeploymentBranchPolicies(),
 			"github_repository_file":                                                dataSourceGithubRepositoryFile(),
 			"github_repository_milestone":                                           dataSourceGithubRepositoryMilestone(),
+			"github_repository_milestones":                                          dataSourceGithubRepositoryMilestones(),
+			"github_repository_popularity":                                          dataSourceGithubRepositoryPopularity(),
 			"github_repository_pull_request":                                        dataSourceGithubRepositoryPullRequest(),
 			"github_repository_pull_requests":                                       dataSourceGithubRepositoryPullRequests(),
+			"github_repository_ruleset_history":                                     dataSourceGithubRepositoryRulesetHistory(),
 			"github_repository_teams":                                               dataSourceGithubRepositoryTeams(),
 			"github_repository_webhooks":                                            dataSourceGithubRepositoryWebhooks(),
 			"github_rest_api":                                                       dataSourceGithubRestApi(),
+			"github_server_capabilities":                                            dataSourceGithubServerCapabilities(),
 			"github_ssh_keys":                                                       dataSourceGithubSshKeys(),
 			"github_team":                                                           dataSourceGithubTeam(),
 			"github_tree":                                                           dataSourceGithubTree(),
 			"github_user":                                                           dataSourceGithubUser(),
 			"github_user_external_identity":                                         dataSourceGithubUserExternalIdentity(),
 			"github_users":                                                          dataSourceGithubUsers(),
+			"github_webhook_signature":                                              dataSourceGithubWebhookSignature(),
 			"github_enterprise":                                                     dataSourceGithubEnterprise(),
+			"github_enterprise_stats":                                               dataSourceGithubEnterpriseStats(),
 		},
 	}
 
+	for resourceType, r := range p.ResourcesMap {
+		wrapSkipRefresh(resourceType, r)
+	}
+
 	p.ConfigureContextFunc = providerConfigure(p)
 
 	return p
@@ -286,6 +398,9 @@ func init() {
 
 		"base_url": "The GitHub Base API URL",
 
+		"graphql_url": "The GitHub GraphQL API URL, e.g. `https://github.example.com/api/graphql`. " +
+			"Defaults to the GraphQL API URL derived from `base_url`.",
+
 		"insecure": "Enable `insecure` mode for testing purposes",
 
 		"owner": "The GitHub owner name to manage. " +
@@ -310,6 +425,9 @@ func init() {
 			"Although, it is not possible to enable this setting on github.com " +
 			"because we enforce the respect of github.com's best practices to avoid hitting abuse rate limits" +
 			"Defaults to false if not set",
+		"max_concurrent_requests": "The maximum number of API calls the provider makes at the same time, " +
+			"independently of the parallelism of Terraform, to avoid GitHub secondary rate limits when creating many resources. " +
+			"Applies with both rate limiters. Unbounded if 0 or not set.",
 		"retryable_errors": "Allow the provider to retry after receiving an error status code, the max_retries should be set for this to work" +
 			"Defaults to [500, 502, 503, 504]",
 		"max_retries": "Number of times to retry a request after receiving an error status code" +
@@ -318,6 +436,24 @@ func init() {
 			"'legacy' uses the provider's built-in rate limiting with configurable delays. " +
 			"When using 'modern', the read_delay_ms, write_delay_ms, and parallel_requests settings are ignored. " +
 			"Defaults to 'modern'.",
+		"metrics_file": "Path of a file the provider writes a JSON summary of its GitHub API usage to, " +
+			"including the number of API calls and retries, the remaining rate limit and the slowest endpoints. " +
+			"The file is updated every few seconds and when the provider exits. Disabled if not set.",
+		"etag_cache": "Cache the responses of read requests shared by resources and data sources, e.g. the repositories " +
+			"and teams looked up by several of them, and revalidate them with conditional requests which do not count " +
+			"against the rate limit when the object is unchanged. Defaults to false.",
+		"correlation_id": "An ID sent with every API call in the X-Correlation-Id header and appended to the User-Agent, " +
+			"e.g. the ID of the Terraform run, to trace the entries of the GitHub audit log back to it. Disabled if not set.",
+		"skip_refresh_resources": "Patterns of the resource types to not refresh, e.g. `github_team_*`, which keep their state " +
+			"as is instead of reading it from GitHub, to speed up the plans of large states. Changes made outside of Terraform to " +
+			"these resources are not detected. Disabled if not set.",
+		"batch_repository_reads": "Read the repositories refreshed concurrently through GraphQL queries of up to 50 " +
+			"repositories, instead of several REST API calls each, to speed up the plans of large states. Their " +
+			"`has_downloads` and `security_and_analysis` attributes are then not refreshed. Defaults to false.",
+		"repository_visibility_timeout_ms": "Amount of time in milliseconds to wait for a repository to be visible to the GitHub API " +
+			"before creating resources in it, as a freshly created repository may not be found right away. " +
+			"Only the repositories created by the provider are waited for, counting from their creation. " +
+			"Defaults to 30000ms or 30s, 0 disables the wait.",
 	}
 }
 
@@ -325,6 +461,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		owner := d.Get("owner").(string)
 		baseURL := d.Get("base_url").(string)
+		graphQLURL := d.Get("graphql_url").(string)
 		token := d.Get("token").(string)
 		insecure := d.Get("insecure").(bool)
 
@@ -350,6 +487,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			owner = org
 		}
 
+		var appTokenSource oauth2.TokenSource
 		if appAuth, ok := d.Get("app_auth").([]any); ok && len(appAuth) > 0 && appAuth[0] != nil {
 			appAuthAttr := appAuth[0].(map[string]any)
 
@@ -380,12 +518,15 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 				return nil, wrapErrors([]error{fmt.Errorf("app_auth.pem_file must be set and contain a non-empty value")})
 			}
 
-			appToken, err := GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, appPemFile)
+			// Installation access tokens expire after an hour, the token
+			// source refreshes them for both the REST and GraphQL clients.
+			appTokenSource = NewAppInstallationTokenSource(baseURL, appID, appInstallationID, appPemFile)
+			appToken, err := appTokenSource.Token()
 			if err != nil {
 				return nil, wrapErrors([]error{err})
 			}
 
-			token = appToken
+			token = appToken.AccessToken
 		}
 
 		isGithubDotCom, err := regexp.MatchString("^"+regexp.QuoteMeta("https://api.github.com"), baseURL)
@@ -445,12 +586,44 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 		log.Printf("[DEBUG] Setting parallel_requests to %t", parallelRequests)
 
+		maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+		log.Printf("[DEBUG] Setting max_concurrent_requests to %d", maxConcurrentRequests)
+
 		rateLimiter := d.Get("rate_limiter").(string)
 		log.Printf("[DEBUG] Setting rate_limiter to %s", rateLimiter)
 
+		metricsFile := d.Get("metrics_file").(string)
+		if metricsFile != "" {
+			log.Printf("[DEBUG] Writing GitHub API metrics to %s", metricsFile)
+		}
+
+		etagCache := d.Get("etag_cache").(bool)
+		log.Printf("[DEBUG] Setting etag_cache to %t", etagCache)
+
+		correlationID := d.Get("correlation_id").(string)
+		if correlationID != "" {
+			log.Printf("[DEBUG] Setting correlation_id to %s", correlationID)
+		}
+
+		skipRefreshResources := expandStringList(d.Get("skip_refresh_resources").([]any))
+		if len(skipRefreshResources) > 0 {
+			log.Printf("[INFO] Skipping the refresh of the resources matching %v", skipRefreshResources)
+		}
+
+		batchRepositoryReads := d.Get("batch_repository_reads").(bool)
+		log.Printf("[DEBUG] Setting batch_repository_reads to %t", batchRepositoryReads)
+
+		repositoryVisibilityTimeout := d.Get("repository_visibility_timeout_ms").(int)
+		if repositoryVisibilityTimeout < 0 {
+			return nil, diag.FromErr(fmt.Errorf("repository_visibility_timeout_ms must be greater than or equal to 0ms"))
+		}
+		log.Printf("[DEBUG] Setting repository_visibility_timeout_ms to %d", repositoryVisibilityTimeout)
+
 		config := Config{
 			Token:            token,
+			TokenSource:      appTokenSource,
 			BaseURL:          baseURL,
+			GraphQLURL:       graphQLURL,
 			Insecure:         insecure,
 			Owner:            owner,
 			WriteDelay:       time.Duration(writeDelay) * time.Millisecond,
@@ -460,6 +633,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			MaxRetries:       maxRetries,
 			ParallelRequests: parallelRequests,
 			RateLimiter:      rateLimiter,
+			MetricsFile:      metricsFile,
+			EtagCache:        etagCache,
+			CorrelationID:    correlationID,
+
+			MaxConcurrentRequests:       maxConcurrentRequests,
+			SkipRefreshResources:        skipRefreshResources,
+			BatchRepositoryReads:        batchRepositoryReads,
+			RepositoryVisibilityTimeout: time.Duration(repositoryVisibilityTimeout) * time.Millisecond,
 		}
 
 		meta, err := config.Meta()
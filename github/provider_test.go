@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -47,6 +48,15 @@ func TestProvider(t *testing.T) {
 		var _ = *Provider()
 	})
 
+	t.Run("validates an empty configuration without GITHUB_GRAPHQL_URL", func(t *testing.T) {
+		t.Setenv("GITHUB_GRAPHQL_URL", "")
+
+		diags := Provider().Validate(terraform.NewResourceConfigRaw(map[string]any{}))
+		if diags.HasError() {
+			t.Fatalf("unexpected error validating the provider configuration: %v", diags)
+		}
+	})
+
 }
 
 // TODO: this is failing
@@ -0,0 +1,334 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+const (
+	// repositoryBatchSize is the number of repositories read by a single
+	// GraphQL query.
+	repositoryBatchSize = 50
+	// repositoryBatchDelay is how long a read waits for other reads to share
+	// its query.
+	repositoryBatchDelay = 50 * time.Millisecond
+)
+
+// repositoryBatchFragment selects the fields of the repositories read in
+// batches, named as in the GraphQL API.
+const repositoryBatchFragment = `fragment repositoryBatchFields on Repository {
+  id
+  databaseId
+  name
+  nameWithOwner
+  description
+  homepageUrl
+  primaryLanguage { name }
+  isPrivate
+  visibility
+  hasIssuesEnabled
+  hasDiscussionsEnabled
+  hasProjectsEnabled
+  hasWikiEnabled
+  isTemplate
+  isArchived
+  defaultBranchRef { name }
+  url
+  sshUrl
+  repositoryTopics(first: 100) { nodes { topic { name } } }
+  autoMergeAllowed
+  mergeCommitAllowed
+  rebaseMergeAllowed
+  squashMergeAllowed
+  allowUpdateBranch
+  deleteBranchOnMerge
+  webCommitSignoffRequired
+  mergeCommitMessage
+  mergeCommitTitle
+  squashMergeCommitMessage
+  squashMergeCommitTitle
+  templateRepository { name owner { login } }
+  hasVulnerabilityAlertsEnabled
+}`
+
+// repositoryBatchNode is a repository read in a batch.
+type repositoryBatchNode struct {
+	ID              string `json:"id"`
+	DatabaseID      int64  `json:"databaseId"`
+	Name            string `json:"name"`
+	NameWithOwner   string `json:"nameWithOwner"`
+	Description     string `json:"description"`
+	HomepageURL     string `json:"homepageUrl"`
+	PrimaryLanguage *struct {
+		Name string `json:"name"`
+	} `json:"primaryLanguage"`
+	IsPrivate             bool   `json:"isPrivate"`
+	Visibility            string `json:"visibility"`
+	HasIssuesEnabled      bool   `json:"hasIssuesEnabled"`
+	HasDiscussionsEnabled bool   `json:"hasDiscussionsEnabled"`
+	HasProjectsEnabled    bool   `json:"hasProjectsEnabled"`
+	HasWikiEnabled        bool   `json:"hasWikiEnabled"`
+	IsTemplate            bool   `json:"isTemplate"`
+	IsArchived            bool   `json:"isArchived"`
+	DefaultBranchRef      *struct {
+		Name string `json:"name"`
+	} `json:"defaultBranchRef"`
+	URL              string `json:"url"`
+	SSHURL           string `json:"sshUrl"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string `json:"name"`
+			} `json:"topic"`
+		} `json:"nodes"`
+	} `json:"repositoryTopics"`
+	AutoMergeAllowed         bool   `json:"autoMergeAllowed"`
+	MergeCommitAllowed       bool   `json:"mergeCommitAllowed"`
+	RebaseMergeAllowed       bool   `json:"rebaseMergeAllowed"`
+	SquashMergeAllowed       bool   `json:"squashMergeAllowed"`
+	AllowUpdateBranch        bool   `json:"allowUpdateBranch"`
+	DeleteBranchOnMerge      bool   `json:"deleteBranchOnMerge"`
+	WebCommitSignoffRequired bool   `json:"webCommitSignoffRequired"`
+	MergeCommitMessage       string `json:"mergeCommitMessage"`
+	MergeCommitTitle         string `json:"mergeCommitTitle"`
+	SquashMergeCommitMessage string `json:"squashMergeCommitMessage"`
+	SquashMergeCommitTitle   string `json:"squashMergeCommitTitle"`
+	TemplateRepository       *struct {
+		Name  string `json:"name"`
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	} `json:"templateRepository"`
+	HasVulnerabilityAlertsEnabled bool `json:"hasVulnerabilityAlertsEnabled"`
+}
+
+// repository returns the repository as returned by the REST API, without the
+// fields the GraphQL API does not expose: has_downloads, has_pages and
+// security_and_analysis.
+func (n *repositoryBatchNode) repository() *github.Repository {
+	repo := &github.Repository{
+		ID:                       github.Ptr(n.DatabaseID),
+		NodeID:                   github.Ptr(n.ID),
+		Name:                     github.Ptr(n.Name),
+		FullName:                 github.Ptr(n.NameWithOwner),
+		Description:              github.Ptr(n.Description),
+		Homepage:                 github.Ptr(n.HomepageURL),
+		Private:                  github.Ptr(n.IsPrivate),
+		Visibility:               github.Ptr(strings.ToLower(n.Visibility)),
+		HasIssues:                github.Ptr(n.HasIssuesEnabled),
+		HasDiscussions:           github.Ptr(n.HasDiscussionsEnabled),
+		HasProjects:              github.Ptr(n.HasProjectsEnabled),
+		HasWiki:                  github.Ptr(n.HasWikiEnabled),
+		IsTemplate:               github.Ptr(n.IsTemplate),
+		Archived:                 github.Ptr(n.IsArchived),
+		HTMLURL:                  github.Ptr(n.URL),
+		SSHURL:                   github.Ptr(n.SSHURL),
+		SVNURL:                   github.Ptr(n.URL),
+		GitURL:                   github.Ptr(strings.Replace(n.URL, "https://", "git://", 1) + ".git"),
+		CloneURL:                 github.Ptr(n.URL + ".git"),
+		AllowAutoMerge:           github.Ptr(n.AutoMergeAllowed),
+		AllowMergeCommit:         github.Ptr(n.MergeCommitAllowed),
+		AllowRebaseMerge:         github.Ptr(n.RebaseMergeAllowed),
+		AllowSquashMerge:         github.Ptr(n.SquashMergeAllowed),
+		AllowUpdateBranch:        github.Ptr(n.AllowUpdateBranch),
+		DeleteBranchOnMerge:      github.Ptr(n.DeleteBranchOnMerge),
+		WebCommitSignoffRequired: github.Ptr(n.WebCommitSignoffRequired),
+		MergeCommitMessage:       github.Ptr(n.MergeCommitMessage),
+		MergeCommitTitle:         github.Ptr(n.MergeCommitTitle),
+		SquashMergeCommitMessage: github.Ptr(n.SquashMergeCommitMessage),
+		SquashMergeCommitTitle:   github.Ptr(n.SquashMergeCommitTitle),
+		Topics:                   make([]string, 0, len(n.RepositoryTopics.Nodes)),
+	}
+	if n.PrimaryLanguage != nil {
+		repo.Language = github.Ptr(n.PrimaryLanguage.Name)
+	}
+	if n.DefaultBranchRef != nil {
+		repo.DefaultBranch = github.Ptr(n.DefaultBranchRef.Name)
+	}
+	for _, node := range n.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, node.Topic.Name)
+	}
+	if n.TemplateRepository != nil {
+		repo.TemplateRepository = &github.Repository{
+			Name:  github.Ptr(n.TemplateRepository.Name),
+			Owner: &github.User{Login: github.Ptr(n.TemplateRepository.Owner.Login)},
+		}
+	}
+	return repo
+}
+
+type repositoryBatchRequest struct {
+	ctx    context.Context
+	owner  string
+	name   string
+	result chan repositoryBatchResult
+}
+
+type repositoryBatchResult struct {
+	node *repositoryBatchNode
+	err  error
+}
+
+// repositoryBatcher reads the repositories requested concurrently, e.g. by
+// the refresh of the repository resources, through aliased GraphQL queries
+// of up to repositoryBatchSize repositories.
+type repositoryBatcher struct {
+	client   *github.Client
+	endpoint string
+
+	mu      sync.Mutex
+	pending []*repositoryBatchRequest
+	timer   *time.Timer
+}
+
+func newRepositoryBatcher(client *github.Client, endpoint string) *repositoryBatcher {
+	return &repositoryBatcher{client: client, endpoint: endpoint}
+}
+
+// get returns the repository owner/name, or nil if it does not exist.
+func (b *repositoryBatcher) get(ctx context.Context, owner, name string) (*repositoryBatchNode, error) {
+	req := &repositoryBatchRequest{ctx: ctx, owner: owner, name: name, result: make(chan repositoryBatchResult, 1)}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, req)
+	if len(b.pending) >= repositoryBatchSize {
+		batch := b.pending
+		b.pending = nil
+		if b.timer != nil {
+			b.timer.Stop()
+			b.timer = nil
+		}
+		go b.fetch(batch)
+	} else if b.timer == nil {
+		b.timer = time.AfterFunc(repositoryBatchDelay, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case result := <-req.result:
+		return result.node, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *repositoryBatcher) flush() {
+	b.mu.Lock()
+	batch := b.pending
+	b.pending = nil
+	b.timer = nil
+	b.mu.Unlock()
+
+	if len(batch) > 0 {
+		b.fetch(batch)
+	}
+}
+
+type repositoryBatchError struct {
+	Type    string `json:"type"`
+	Path    []any  `json:"path"`
+	Message string `json:"message"`
+}
+
+// repositoryBatchContext returns the context of the query reading batch. It
+// carries the values of the context of the first request, and is canceled
+// once the contexts of all the requests are, as the others still wait for
+// the result.
+func repositoryBatchContext(batch []*repositoryBatchRequest) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(batch[0].ctx))
+
+	var waiting atomic.Int64
+	waiting.Store(int64(len(batch)))
+	stops := make([]func() bool, 0, len(batch))
+	for _, req := range batch {
+		stops = append(stops, context.AfterFunc(req.ctx, func() {
+			if waiting.Add(-1) == 0 {
+				cancel()
+			}
+		}))
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
+
+// fetch reads the repositories of batch in a single query and hands each
+// request its result.
+func (b *repositoryBatcher) fetch(batch []*repositoryBatchRequest) {
+	ctx, cancel := repositoryBatchContext(batch)
+	defer cancel()
+
+	var query strings.Builder
+	params := make([]string, 0, 2*len(batch))
+	variables := make(map[string]any, 2*len(batch))
+	for i, req := range batch {
+		params = append(params, fmt.Sprintf("$owner%d: String!, $name%d: String!", i, i))
+		variables[fmt.Sprintf("owner%d", i)] = req.owner
+		variables[fmt.Sprintf("name%d", i)] = req.name
+		fmt.Fprintf(&query, "  r%d: repository(owner: $owner%d, name: $name%d) { ...repositoryBatchFields }\n", i, i, i)
+	}
+	body := map[string]any{
+		"query":     fmt.Sprintf("query(%s) {\n%s}\n%s", strings.Join(params, ", "), query.String(), repositoryBatchFragment),
+		"variables": variables,
+	}
+
+	var out struct {
+		Data   map[string]*repositoryBatchNode `json:"data"`
+		Errors []repositoryBatchError          `json:"errors"`
+	}
+	err := b.do(ctx, body, &out)
+
+	// Errors are reported by alias, e.g. NOT_FOUND for the repositories which
+	// do not exist, while the other repositories are still returned.
+	errs := make(map[string]error)
+	for _, e := range out.Errors {
+		alias := ""
+		if len(e.Path) > 0 {
+			alias, _ = e.Path[0].(string)
+		}
+		if e.Type == "NOT_FOUND" && alias != "" {
+			continue
+		}
+		if alias == "" {
+			err = fmt.Errorf("error reading repositories: %s", e.Message)
+			continue
+		}
+		errs[alias] = fmt.Errorf("error reading repository: %s", e.Message)
+	}
+
+	for i, req := range batch {
+		alias := fmt.Sprintf("r%d", i)
+		switch {
+		case err != nil:
+			req.result <- repositoryBatchResult{err: err}
+		case errs[alias] != nil:
+			req.result <- repositoryBatchResult{err: errs[alias]}
+		default:
+			req.result <- repositoryBatchResult{node: out.Data[alias]}
+		}
+	}
+}
+
+func (b *repositoryBatcher) do(ctx context.Context, body any, out any) error {
+	req, err := b.client.NewRequest("POST", b.endpoint, body)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.BareDo(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestRepositoryBatcher(t *testing.T) {
+	var queries atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		queries.Add(1)
+
+		var body struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		}
+		if err := json.Unmarshal([]byte(mustRead(r.Body)), &body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+		if !strings.Contains(body.Query, "...repositoryBatchFields") {
+			t.Errorf("query does not select the repository fields: %s", body.Query)
+		}
+
+		data := make(map[string]any)
+		errors := make([]any, 0)
+		for name, value := range body.Variables {
+			if !strings.HasPrefix(name, "name") {
+				continue
+			}
+			alias := "r" + strings.TrimPrefix(name, "name")
+			if value == "missing" {
+				data[alias] = nil
+				errors = append(errors, map[string]any{"type": "NOT_FOUND", "path": []string{alias}, "message": "Could not resolve to a Repository."})
+				continue
+			}
+			data[alias] = map[string]any{
+				"name":             value,
+				"nameWithOwner":    body.Variables["owner"+strings.TrimPrefix(name, "name")] + "/" + value,
+				"visibility":       "INTERNAL",
+				"url":              "https://github.com/acme/" + value,
+				"defaultBranchRef": map[string]any{"name": "main"},
+				"repositoryTopics": map[string]any{"nodes": []any{map[string]any{"topic": map[string]any{"name": "terraform"}}}},
+			}
+		}
+		out, _ := json.Marshal(map[string]any{"data": data, "errors": errors})
+		mustWrite(w, string(out))
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	batcher := newRepositoryBatcher(client, "https://api.github.com/graphql")
+
+	names := []string{"one", "two", "three", "missing"}
+	nodes := make([]*repositoryBatchNode, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			node, err := batcher.get(context.Background(), "acme", name)
+			if err != nil {
+				t.Errorf("unexpected error reading %s: %v", name, err)
+			}
+			nodes[i] = node
+		}()
+	}
+	wg.Wait()
+
+	if got := queries.Load(); got != 1 {
+		t.Errorf("expected the repositories to be read by 1 query, got %d", got)
+	}
+	if nodes[3] != nil {
+		t.Errorf("expected the missing repository to not be found, got %v", nodes[3])
+	}
+	for i, name := range names[:3] {
+		if nodes[i] == nil {
+			t.Fatalf("expected repository %s to be found", name)
+		}
+		repo := nodes[i].repository()
+		if repo.GetFullName() != "acme/"+name {
+			t.Errorf("expected full name acme/%s, got %s", name, repo.GetFullName())
+		}
+		if repo.GetVisibility() != "internal" {
+			t.Errorf("expected visibility internal, got %s", repo.GetVisibility())
+		}
+		if repo.GetDefaultBranch() != "main" {
+			t.Errorf("expected default branch main, got %s", repo.GetDefaultBranch())
+		}
+		if repo.GetCloneURL() != "https://github.com/acme/"+name+".git" {
+			t.Errorf("unexpected clone URL %s", repo.GetCloneURL())
+		}
+		if len(repo.Topics) != 1 || repo.Topics[0] != "terraform" {
+			t.Errorf("expected topics [terraform], got %v", repo.Topics)
+		}
+	}
+}
+
+func TestRepositoryBatchContext(t *testing.T) {
+	first, cancelFirst := context.WithCancel(context.WithValue(context.Background(), ctxId, "one"))
+	second, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+
+	ctx, cancel := repositoryBatchContext([]*repositoryBatchRequest{{ctx: first}, {ctx: second}})
+	defer cancel()
+
+	if ctx.Value(ctxId) != "one" {
+		t.Errorf("expected the values of the first request, got %v", ctx.Value(ctxId))
+	}
+
+	cancelFirst()
+	select {
+	case <-ctx.Done():
+		t.Fatal("expected the query to go on while a request still waits")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected the query to be canceled once all the requests are")
+	}
+}
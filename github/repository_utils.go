@@ -2,11 +2,18 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"path"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 // checkRepositoryBranchExists tests if a branch exists in a repository.
@@ -25,6 +32,211 @@ func checkRepositoryBranchExists(client *github.Client, owner, repo, branch stri
 	return nil
 }
 
+// recordRepositoryCreated remembers when the provider created a repository,
+// so that the resources created in it right after wait for it to be visible.
+func recordRepositoryCreated(meta any, repo *github.Repository) {
+	now := time.Now()
+	meta.(*Owner).createdRepositories.Store(repo.GetName(), now)
+	if repo.GetNodeID() != "" {
+		meta.(*Owner).createdRepositories.Store(repo.GetNodeID(), now)
+	}
+}
+
+// repositoryVisibilityDeadline returns when to give up waiting for a
+// repository, given by name or node ID, to be visible. Only the repositories
+// created by the provider less than repository_visibility_timeout_ms ago are
+// waited for, others are expected to be visible already.
+func repositoryVisibilityDeadline(meta any, repo string) (time.Time, bool) {
+	timeout := meta.(*Owner).repositoryVisibilityTimeout
+	if timeout <= 0 {
+		return time.Time{}, false
+	}
+
+	created, ok := meta.(*Owner).createdRepositories.Load(repo)
+	if !ok {
+		return time.Time{}, false
+	}
+	deadline := created.(time.Time).Add(timeout)
+	return deadline, time.Now().Before(deadline)
+}
+
+// waitForRepositoryVisible waits for a repository to be found by the API,
+// since a freshly created repository may not be visible to the replicas
+// serving the requests which follow its creation. It gives up once
+// repository_visibility_timeout_ms elapsed since the repository was created.
+func waitForRepositoryVisible(ctx context.Context, meta any, repo string) error {
+	deadline, ok := repositoryVisibilityDeadline(meta, repo)
+	if !ok {
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	return retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
+		_, _, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[DEBUG] Waiting for repository %s/%s to be visible", owner, repo)
+				return retry.RetryableError(fmt.Errorf("repository %s/%s not found", owner, repo))
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// repositoryEnvironmentsEntry holds the names of the environments of a
+// repository, listed once per provider instance.
+type repositoryEnvironmentsEntry struct {
+	once  sync.Once
+	names []string
+	err   error
+}
+
+// listRepositoryEnvironmentNames returns the sorted names of the environments
+// of a repository.
+func listRepositoryEnvironmentNames(ctx context.Context, meta any, repo string) ([]string, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	names := make([]string, 0)
+	opts := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	for {
+		page, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range page.Environments {
+			names = append(names, env.GetName())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// isEnvironmentPattern returns whether an environment name is a pattern, e.g.
+// `prod-*`, matched with path.Match.
+func isEnvironmentPattern(env string) bool {
+	return strings.ContainsAny(env, "*?[")
+}
+
+// expandEnvironmentPatterns returns the environments with their patterns
+// replaced by the names they match, in order and without duplicates.
+func expandEnvironmentPatterns(environments []string, names []string) []string {
+	seen := make(map[string]bool)
+	expanded := make([]string, 0, len(environments))
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			expanded = append(expanded, name)
+		}
+	}
+
+	for _, env := range environments {
+		if !isEnvironmentPattern(env) {
+			add(env)
+			continue
+		}
+		for _, name := range names {
+			if matched, _ := path.Match(env, name); matched {
+				add(name)
+			}
+		}
+	}
+	return expanded
+}
+
+// missingRepositoryEnvironments returns the environments which do not exist in
+// a repository, or the patterns which match none. The environments of each
+// repository are listed once and cached, so that checking many resources
+// costs a single request each.
+func missingRepositoryEnvironments(ctx context.Context, meta any, repo string, environments []string) ([]string, error) {
+	v, _ := meta.(*Owner).repositoryEnvironments.LoadOrStore(repo, &repositoryEnvironmentsEntry{})
+	entry := v.(*repositoryEnvironmentsEntry)
+	entry.once.Do(func() {
+		entry.names, entry.err = listRepositoryEnvironmentNames(ctx, meta, repo)
+	})
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	missing := make([]string, 0)
+	for _, env := range environments {
+		if isEnvironmentPattern(env) {
+			if len(expandEnvironmentPatterns([]string{env}, entry.names)) == 0 {
+				missing = append(missing, env)
+			}
+		} else if !slices.Contains(entry.names, env) {
+			missing = append(missing, env)
+		}
+	}
+	return missing, nil
+}
+
+// repositoryCodeScanningToolsEntry holds the names of the code scanning tools
+// of a repository, listed once per provider instance.
+type repositoryCodeScanningToolsEntry struct {
+	once  sync.Once
+	tools []string
+	err   error
+}
+
+// listRepositoryCodeScanningTools returns the sorted names of the code
+// scanning tools with a recent analysis in a repository. Only the latest page
+// of analyses is considered, tools which did not run for that long are not
+// enabled anymore. A repository without any analysis has no tools.
+func listRepositoryCodeScanningTools(ctx context.Context, meta any, repo string) ([]string, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	opts := &github.AnalysesListOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	analyses, _, err := client.CodeScanning.ListAnalysesForRepo(ctx, owner, repo, opts)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	tools := make([]string, 0)
+	for _, analysis := range analyses {
+		if name := analysis.GetTool().GetName(); name != "" && !slices.Contains(tools, name) {
+			tools = append(tools, name)
+		}
+	}
+	slices.Sort(tools)
+	return tools, nil
+}
+
+// missingRepositoryCodeScanningTools returns the code scanning tools which are
+// not enabled in a repository, compared case-insensitively. The tools of each
+// repository are listed once and cached, like its environments.
+func missingRepositoryCodeScanningTools(ctx context.Context, meta any, repo string, tools []string) ([]string, error) {
+	v, _ := meta.(*Owner).repositoryCodeScanningTools.LoadOrStore(repo, &repositoryCodeScanningToolsEntry{})
+	entry := v.(*repositoryCodeScanningToolsEntry)
+	entry.once.Do(func() {
+		entry.tools, entry.err = listRepositoryCodeScanningTools(ctx, meta, repo)
+	})
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	missing := make([]string, 0)
+	for _, tool := range tools {
+		if !slices.ContainsFunc(entry.tools, func(name string) bool { return strings.EqualFold(name, tool) }) {
+			missing = append(missing, tool)
+		}
+	}
+	return missing, nil
+}
+
 func getFileCommit(client *github.Client, owner, repo, file, branch string) (*github.RepositoryCommit, error) {
 	ctx := context.WithValue(context.Background(), ctxId, fmt.Sprintf("%s/%s", repo, file))
 	opts := &github.CommitsListOptions{
@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWaitForRepositoryVisible(t *testing.T) {
+	var requests int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/tf-acc-org/visible", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		// The repository is only visible from the second request.
+		if requests < 2 {
+			w.WriteHeader(http.StatusNotFound)
+			mustWrite(w, `{"message": "Not Found"}`)
+			return
+		}
+		mustWrite(w, `{"id": 1, "name": "visible"}`)
+	})
+	mux.HandleFunc("/repos/tf-acc-org/missing", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		mustWrite(w, `{"message": "Not Found"}`)
+	})
+	mux.HandleFunc("/repos/tf-acc-org/forbidden", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		mustWrite(w, `{"message": "Forbidden"}`)
+	})
+
+	meta := &Owner{
+		v3client:                    github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:                        "tf-acc-org",
+		repositoryVisibilityTimeout: 5 * time.Second,
+	}
+	ctx := context.Background()
+
+	// Repositories not created by the provider are not waited for.
+	if err := waitForRepositoryVisible(ctx, meta, "missing"); err != nil {
+		t.Errorf("expected no wait for a repository not created by the provider, got %s", err)
+	}
+
+	for _, name := range []string{"visible", "forbidden", "missing"} {
+		recordRepositoryCreated(meta, &github.Repository{Name: github.Ptr(name)})
+	}
+
+	if err := waitForRepositoryVisible(ctx, meta, "visible"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+
+	if err := waitForRepositoryVisible(ctx, meta, "forbidden"); err == nil {
+		t.Error("expected an error for a forbidden repository")
+	}
+
+	meta.repositoryVisibilityTimeout = time.Second
+	if err := waitForRepositoryVisible(ctx, meta, "missing"); err == nil {
+		t.Error("expected an error once the timeout expired")
+	}
+
+	// The timeout counts from the creation of the repository.
+	if err := waitForRepositoryVisible(ctx, meta, "missing"); err != nil {
+		t.Errorf("expected no wait once the timeout expired, got %s", err)
+	}
+
+	meta.repositoryVisibilityTimeout = 0
+	if err := waitForRepositoryVisible(ctx, meta, "missing"); err != nil {
+		t.Errorf("expected no wait when disabled, got %s", err)
+	}
+}
+
+func TestMissingRepositoryEnvironments(t *testing.T) {
+	var requests int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/tf-acc-org/test/environments", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"total_count": 2, "environments": [{"name": "staging"}, {"name": "production"}]}`)
+	})
+
+	meta := &Owner{
+		v3client: github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:     "tf-acc-org",
+	}
+	ctx := context.Background()
+
+	missing, err := missingRepositoryEnvironments(ctx, meta, "test", []string{"staging", "prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != "prod" {
+		t.Errorf("expected environment prod to be missing, got %v", missing)
+	}
+
+	missing, err = missingRepositoryEnvironments(ctx, meta, "test", []string{"production"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("expected no missing environment, got %v", missing)
+	}
+	if requests != 1 {
+		t.Errorf("expected the environments to be listed once, got %d requests", requests)
+	}
+
+	if diags := requiredDeploymentEnvironmentsWarnings(ctx, meta, "test", []any{"staging", "qa"}); len(diags) != 1 || diags.HasError() {
+		t.Errorf("expected a warning for environment qa, got %v", diags)
+	}
+	if diags := requiredDeploymentEnvironmentsWarnings(ctx, meta, "test", []any{"prod*", "qa-*"}); len(diags) != 1 || diags.HasError() {
+		t.Errorf("expected a warning for pattern qa-*, got %v", diags)
+	}
+}
+
+func TestMissingRepositoryCodeScanningTools(t *testing.T) {
+	var requests int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/tf-acc-org/test/code-scanning/analyses", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"tool": {"name": "CodeQL"}}, {"tool": {"name": "Semgrep"}}, {"tool": {"name": "CodeQL"}}]`)
+	})
+	mux.HandleFunc("/repos/tf-acc-org/unscanned/code-scanning/analyses", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		mustWrite(w, `{"message": "no analysis found"}`)
+	})
+
+	meta := &Owner{
+		v3client: github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:     "tf-acc-org",
+	}
+	ctx := context.Background()
+
+	missing, err := missingRepositoryCodeScanningTools(ctx, meta, "test", []string{"codeql", "ESLint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != "ESLint" {
+		t.Errorf("expected tool ESLint to be missing, got %v", missing)
+	}
+
+	tools := schema.NewSet(schema.HashResource(&schema.Resource{Schema: map[string]*schema.Schema{
+		"tool": {Type: schema.TypeString, Required: true},
+	}}), []any{map[string]any{"tool": "CodeQL"}, map[string]any{"tool": "Semgrep"}})
+	if diags := requiredCodeScanningToolsWarnings(ctx, meta, "test", tools); len(diags) != 0 {
+		t.Errorf("expected no warning, got %v", diags)
+	}
+	if requests != 1 {
+		t.Errorf("expected the analyses to be listed once, got %d requests", requests)
+	}
+
+	if diags := requiredCodeScanningToolsWarnings(ctx, meta, "unscanned", tools); len(diags) != 2 || diags.HasError() {
+		t.Errorf("expected warnings for tools CodeQL and Semgrep, got %v", diags)
+	}
+}
+
+func TestExpandEnvironmentPatterns(t *testing.T) {
+	names := []string{"prod-eu", "prod-us", "staging"}
+
+	expanded := expandEnvironmentPatterns([]string{"staging", "prod-*", "prod-eu", "qa-*", "review"}, names)
+	expected := []string{"staging", "prod-eu", "prod-us", "review"}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Errorf("expected %v, got %v", expected, expanded)
+	}
+}
+
+func TestKeepRequiredDeploymentEnvironmentPatterns(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/tf-acc-org/test/environments", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"total_count": 3, "environments": [{"name": "prod-eu"}, {"name": "prod-us"}, {"name": "staging"}]}`)
+	})
+	meta := &Owner{
+		v3client: github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:     "tf-acc-org",
+	}
+	rules := func(environments ...string) []any {
+		return []any{map[string]any{
+			"required_deployments": []map[string]any{{"required_deployment_environments": environments}},
+		}}
+	}
+	environments := func(rules []any) []string {
+		return rules[0].(map[string]any)["required_deployments"].([]map[string]any)[0]["required_deployment_environments"].([]string)
+	}
+
+	upToDate := rules("prod-us", "prod-eu")
+	keepRequiredDeploymentEnvironmentPatterns(context.Background(), meta, "test", upToDate, []any{"prod-*"})
+	if got := environments(upToDate); !reflect.DeepEqual(got, []string{"prod-*"}) {
+		t.Errorf("expected the pattern to be kept, got %v", got)
+	}
+
+	// A new environment matching the pattern is not required yet.
+	drifted := rules("prod-eu")
+	keepRequiredDeploymentEnvironmentPatterns(context.Background(), meta, "test", drifted, []any{"prod-*"})
+	if got := environments(drifted); !reflect.DeepEqual(got, []string{"prod-eu"}) {
+		t.Errorf("expected the required environments to be kept, got %v", got)
+	}
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubActionsEnvironmentSecret() *schema.Resource {
@@ -46,15 +45,16 @@ func resourceGithubActionsEnvironmentSecret() *schema.Resource {
 				Sensitive:        true,
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
 				ConflictsWith:    []string{"plaintext_value"},
-				ValidateDiagFunc: toDiagFunc(validation.StringIsBase64, "encrypted_value"),
+				ValidateDiagFunc: validateEncryptedSecretValueFunc,
 			},
 			"plaintext_value": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Sensitive:     true,
-				Description:   "Plaintext value of the secret to be encrypted.",
-				ConflictsWith: []string{"encrypted_value"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				Description:      "Plaintext value of the secret to be encrypted.",
+				ConflictsWith:    []string{"encrypted_value"},
+				ValidateDiagFunc: validateSecretValueFunc,
 			},
 			"created_at": {
 				Type:        schema.TypeString,
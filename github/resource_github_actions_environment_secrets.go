@@ -0,0 +1,360 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsEnvironmentSecrets() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages several Action secrets within a GitHub repository environment",
+		Create:      resourceGithubActionsEnvironmentSecretsCreateOrUpdate,
+		Read:        resourceGithubActionsEnvironmentSecretsRead,
+		Update:      resourceGithubActionsEnvironmentSecretsCreateOrUpdate,
+		Delete:      resourceGithubActionsEnvironmentSecretsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the repository.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the environment.",
+			},
+			"plaintext_secrets": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateSecretsFunc(validateSecretValueFunc),
+				Description:      "Map of secret names to plaintext values to be encrypted.",
+			},
+			"encrypted_secrets": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateSecretsFunc(validateEncryptedSecretValueFunc),
+				Description:      "Map of secret names to values encrypted using the GitHub public key of the environment in Base64 format.",
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the secrets of the environment that are not configured, including the secrets created outside of Terraform or by 'github_actions_environment_secret'. Defaults to false.",
+			},
+			"updated_at": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of the names of the managed secrets to the date of their last update, used to detect the secrets updated outside of Terraform.",
+			},
+		},
+	}
+}
+
+// validateSecretsFunc validates the keys of a map of secrets with
+// validateSecretNameFunc and its values with validateValue.
+func validateSecretsFunc(validateValue schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		secrets, ok := v.(map[string]any)
+		if !ok {
+			return wrapErrors([]error{fmt.Errorf("expected type of %s to be map", path)})
+		}
+
+		var diags diag.Diagnostics
+		for name, value := range secrets {
+			diags = append(diags, validateSecretNameFunc(name, path.IndexString(name))...)
+			diags = append(diags, validateValue(value, path.IndexString(name))...)
+		}
+
+		return diags
+	}
+}
+
+// listGithubActionsEnvironmentSecrets returns the last update dates of the
+// secrets of an environment by name.
+func listGithubActionsEnvironmentSecrets(ctx context.Context, client *github.Client, repoID int64, envName string) (map[string]string, error) {
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	secrets := make(map[string]string)
+	for {
+		ss, resp, err := client.Actions.ListEnvSecrets(ctx, int(repoID), url.PathEscape(envName), options)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range ss.Secrets {
+			secrets[s.Name] = s.UpdatedAt.String()
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return secrets, nil
+}
+
+// flattenEnvironmentSecrets returns the plaintext and encrypted secrets to keep
+// in state from the remote secrets, by their last update dates, and the last
+// update dates known to Terraform. The values of secrets cannot be read back,
+// so the configured value is kept unless the secret was updated outside of
+// Terraform, in which case it is dropped to be set again. The secrets not
+// configured are left out, or kept with an empty value so that they are
+// deleted when authoritative.
+func flattenEnvironmentSecrets(remote map[string]string, plaintext, encrypted, updatedAt map[string]any, authoritative bool) (map[string]string, map[string]string, map[string]string) {
+	// GitHub returns secret names in upper case, keep the casing used in the
+	// configuration to avoid perpetual diffs.
+	configured := make(map[string]string)
+	for name := range plaintext {
+		configured[strings.ToUpper(name)] = name
+	}
+	for name := range encrypted {
+		configured[strings.ToUpper(name)] = name
+	}
+
+	plaintextState := make(map[string]string)
+	encryptedState := make(map[string]string)
+	updatedAtState := make(map[string]string, len(configured))
+	for remoteName, remoteUpdatedAt := range remote {
+		name, ok := configured[strings.ToUpper(remoteName)]
+		if !ok {
+			if authoritative {
+				plaintextState[remoteName] = ""
+			}
+			continue
+		}
+		updatedAtState[name] = remoteUpdatedAt
+
+		if known, ok := updatedAt[name]; ok && known.(string) != remoteUpdatedAt {
+			log.Printf("[INFO] The environment secret %s has been externally updated in GitHub", name)
+			continue
+		}
+		if value, ok := plaintext[name]; ok {
+			plaintextState[name] = value.(string)
+		}
+		if value, ok := encrypted[name]; ok {
+			encryptedState[name] = value.(string)
+		}
+	}
+
+	return plaintextState, encryptedState, updatedAtState
+}
+
+func resourceGithubActionsEnvironmentSecretsCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	escapedEnvName := url.PathEscape(envName)
+	ctx := context.WithValue(context.Background(), ctxId, buildTwoPartID(repoName, envName))
+
+	authoritative := d.Get("authoritative").(bool)
+	oldPlaintext, newPlaintext := d.GetChange("plaintext_secrets")
+	oldEncrypted, newEncrypted := d.GetChange("encrypted_secrets")
+	desired := make(map[string]bool)
+	for name := range newPlaintext.(map[string]any) {
+		desired[strings.ToUpper(name)] = true
+	}
+	for name := range newEncrypted.(map[string]any) {
+		if desired[strings.ToUpper(name)] {
+			return fmt.Errorf("secret %s is set in both plaintext_secrets and encrypted_secrets", name)
+		}
+		desired[strings.ToUpper(name)] = true
+	}
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+	existing, err := listGithubActionsEnvironmentSecrets(ctx, client, repo.GetID(), envName)
+	if err != nil {
+		return err
+	}
+	remote := make(map[string]bool, len(existing))
+	for name := range existing {
+		remote[strings.ToUpper(name)] = true
+	}
+
+	// The public key is fetched once, and the secrets set one at a time, as
+	// GitHub answers concurrent writes to the secrets of an environment with
+	// conflicts.
+	var keyID, publicKey string
+	setSecret := func(name, encryptedValue string) error {
+		log.Printf("[DEBUG] Setting actions secret %s/%s/%s/%s", owner, repoName, envName, name)
+		_, err := client.Actions.CreateOrUpdateEnvSecret(ctx, int(repo.GetID()), escapedEnvName, &github.EncryptedSecret{
+			Name:           name,
+			KeyID:          keyID,
+			EncryptedValue: encryptedValue,
+		})
+		return err
+	}
+	changed := func(old map[string]any, name string, value any) bool {
+		oldValue, ok := old[name]
+		return !ok || oldValue != value || !remote[strings.ToUpper(name)]
+	}
+
+	for name, value := range newPlaintext.(map[string]any) {
+		if !changed(oldPlaintext.(map[string]any), name, value) {
+			continue
+		}
+		if keyID == "" {
+			if keyID, publicKey, err = getEnvironmentPublicKeyDetails(repo.GetID(), escapedEnvName, meta); err != nil {
+				return err
+			}
+		}
+		encryptedBytes, err := encryptPlaintext(value.(string), publicKey)
+		if err != nil {
+			return err
+		}
+		if err := setSecret(name, base64.StdEncoding.EncodeToString(encryptedBytes)); err != nil {
+			return err
+		}
+	}
+	for name, value := range newEncrypted.(map[string]any) {
+		if !changed(oldEncrypted.(map[string]any), name, value) {
+			continue
+		}
+		if keyID == "" {
+			if keyID, publicKey, err = getEnvironmentPublicKeyDetails(repo.GetID(), escapedEnvName, meta); err != nil {
+				return err
+			}
+		}
+		if err := setSecret(name, value.(string)); err != nil {
+			return err
+		}
+	}
+
+	// Only the secrets removed from the configuration are deleted, unless
+	// authoritative.
+	removed := make(map[string]bool)
+	for name := range oldPlaintext.(map[string]any) {
+		removed[strings.ToUpper(name)] = true
+	}
+	for name := range oldEncrypted.(map[string]any) {
+		removed[strings.ToUpper(name)] = true
+	}
+	for name := range existing {
+		if desired[strings.ToUpper(name)] || !authoritative && !removed[strings.ToUpper(name)] {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting actions secret %s/%s/%s/%s", owner, repoName, envName, name)
+		if _, err := client.Actions.DeleteEnvSecret(ctx, int(repo.GetID()), escapedEnvName, name); err != nil {
+			return err
+		}
+	}
+
+	// The secrets written have new update dates, which are recorded so that
+	// the read below does not take them for updates made outside of Terraform.
+	written, err := listGithubActionsEnvironmentSecrets(ctx, client, repo.GetID(), envName)
+	if err != nil {
+		return err
+	}
+	_, _, updatedAt := flattenEnvironmentSecrets(written, newPlaintext.(map[string]any), newEncrypted.(map[string]any), nil, authoritative)
+	if err := d.Set("updated_at", updatedAt); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(repoName, envName))
+	return resourceGithubActionsEnvironmentSecretsRead(d, meta)
+}
+
+func resourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, envName, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing environment secrets %s from state because the repository no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	remote, err := listGithubActionsEnvironmentSecrets(ctx, client, repo.GetID(), envName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "actions environment secrets %s", d.Id())
+	}
+
+	plaintext, encrypted, updatedAt := flattenEnvironmentSecrets(remote,
+		d.Get("plaintext_secrets").(map[string]any),
+		d.Get("encrypted_secrets").(map[string]any),
+		d.Get("updated_at").(map[string]any),
+		d.Get("authoritative").(bool))
+
+	_ = d.Set("repository", repoName)
+	_ = d.Set("environment", envName)
+	if err := d.Set("plaintext_secrets", plaintext); err != nil {
+		return err
+	}
+	if err := d.Set("encrypted_secrets", encrypted); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", updatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubActionsEnvironmentSecretsDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, envName, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+	escapedEnvName := url.PathEscape(envName)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository %s", repoName)
+	}
+
+	// Only the configured secrets have a recorded update date, the others are
+	// left in place.
+	for name := range d.Get("updated_at").(map[string]any) {
+		log.Printf("[DEBUG] Deleting actions secret %s/%s/%s/%s", owner, repoName, envName, name)
+		_, err := client.Actions.DeleteEnvSecret(ctx, int(repo.GetID()), escapedEnvName, name)
+		if err != nil {
+			if err := deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "actions secret %s/%s", d.Id(), name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenEnvironmentSecrets(t *testing.T) {
+	remote := map[string]string{
+		"FIRST":     "2025-01-01 00:00:00 +0000 UTC",
+		"SECOND":    "2025-02-02 00:00:00 +0000 UTC",
+		"THIRD":     "2025-01-01 00:00:00 +0000 UTC",
+		"UNMANAGED": "2025-01-01 00:00:00 +0000 UTC",
+	}
+	plaintext := map[string]any{"first": "one", "SECOND": "two"}
+	encrypted := map[string]any{"THIRD": "dHJlcw=="}
+	updatedAt := map[string]any{
+		"first":  "2025-01-01 00:00:00 +0000 UTC",
+		"SECOND": "2025-01-01 00:00:00 +0000 UTC",
+	}
+
+	plaintextState, encryptedState, updatedAtState := flattenEnvironmentSecrets(remote, plaintext, encrypted, updatedAt, false)
+
+	if plaintextState["first"] != "one" {
+		t.Errorf("expected secret first to keep its configured name and value, got %v", plaintextState)
+	}
+	if _, ok := plaintextState["SECOND"]; ok {
+		t.Errorf("expected secret SECOND updated outside of Terraform to be dropped, got %v", plaintextState)
+	}
+	if encryptedState["THIRD"] != "dHJlcw==" {
+		t.Errorf("expected secret THIRD to keep its encrypted value, got %v", encryptedState)
+	}
+	if _, ok := plaintextState["UNMANAGED"]; ok {
+		t.Errorf("expected secret UNMANAGED to be left out, got %v", plaintextState)
+	}
+	if updatedAtState["SECOND"] != remote["SECOND"] || len(updatedAtState) != 3 {
+		t.Errorf("expected the remote update dates of the configured secrets, got %v", updatedAtState)
+	}
+
+	plaintextState, _, updatedAtState = flattenEnvironmentSecrets(remote, plaintext, encrypted, updatedAt, true)
+	if value, ok := plaintextState["UNMANAGED"]; !ok || value != "" {
+		t.Errorf("expected secret UNMANAGED to be kept with an empty value when authoritative, got %v", plaintextState)
+	}
+	if _, ok := updatedAtState["UNMANAGED"]; ok {
+		t.Errorf("expected no update date for secret UNMANAGED, got %v", updatedAtState)
+	}
+}
+
+func TestAccGithubActionsEnvironmentSecrets(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates, updates and deletes environment secrets without error", func(t *testing.T) {
+		config := `
+			resource "github_repository" "test" {
+			  name = "tf-acc-test-%s"
+			}
+
+			resource "github_repository_environment" "test" {
+			  repository  = github_repository.test.name
+			  environment = "environment / test"
+			}
+
+			resource "github_actions_environment_secrets" "test" {
+			  repository        = github_repository.test.name
+			  environment       = github_repository_environment.test.environment
+			  plaintext_secrets = {
+			    %s
+			  }
+			}
+		`
+
+		before := fmt.Sprintf(config, randomID, `
+			    FIRST_SECRET  = "one"
+			    SECOND_SECRET = "two"
+		`)
+		after := fmt.Sprintf(config, randomID, `
+			    FIRST_SECRET = "uno"
+			    THIRD_SECRET = "tres"
+		`)
+		updated := fmt.Sprintf(config, randomID, `
+			    FIRST_SECRET = "uno"
+			    THIRD_SECRET = "drei"
+		`)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.%", "2"),
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "updated_at.%", "2"),
+				resource.TestCheckResourceAttrSet("github_actions_environment_secrets.test", "updated_at.FIRST_SECRET"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.%", "2"),
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.FIRST_SECRET", "uno"),
+				resource.TestCheckResourceAttrSet("github_actions_environment_secrets.test", "updated_at.THIRD_SECRET"),
+			),
+			"updated": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.%", "2"),
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.FIRST_SECRET", "uno"),
+				resource.TestCheckResourceAttr("github_actions_environment_secrets.test", "plaintext_secrets.THIRD_SECRET", "drei"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: before,
+						Check:  checks["before"],
+					},
+					{
+						Config: after,
+						Check:  checks["after"],
+					},
+					{
+						// Updating a secret must not be taken for an update
+						// made outside of Terraform by the next plan.
+						Config: updated,
+						Check:  checks["updated"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -41,9 +41,10 @@ func resourceGithubActionsEnvironmentVariable() *schema.Resource {
 				ValidateDiagFunc: validateSecretNameFunc,
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the variable.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Value of the variable.",
+				ValidateDiagFunc: validateVariableValueFunc,
 			},
 			"created_at": {
 				Type:        schema.TypeString,
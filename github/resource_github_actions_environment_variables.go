@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsEnvironmentVariables() *schema.Resource {
+	return &schema.Resource{
+		Description: "Authoritatively manages the Action variables within a GitHub repository environment",
+		Create:      resourceGithubActionsEnvironmentVariablesCreateOrUpdate,
+		Read:        resourceGithubActionsEnvironmentVariablesRead,
+		Update:      resourceGithubActionsEnvironmentVariablesCreateOrUpdate,
+		Delete:      resourceGithubActionsEnvironmentVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the repository.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the environment.",
+			},
+			"variables": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateVariablesFunc,
+				Description:      "Map of variable names to values. Variables of the environment not listed here are deleted.",
+			},
+		},
+	}
+}
+
+// validateVariablesFunc validates the keys of a map of variables with the
+// same rules as validateSecretNameFunc and the size of its values.
+func validateVariablesFunc(v any, path cty.Path) diag.Diagnostics {
+	variables, ok := v.(map[string]any)
+	if !ok {
+		return wrapErrors([]error{fmt.Errorf("expected type of %s to be map", path)})
+	}
+
+	var diags diag.Diagnostics
+	for name, value := range variables {
+		diags = append(diags, validateSecretNameFunc(name, path.IndexString(name))...)
+		diags = append(diags, validateVariableValueFunc(value, path.IndexString(name))...)
+	}
+
+	return diags
+}
+
+// actionsVariableWriteMaxRetries is the number of times a write of an
+// environment variable is retried after a transient error.
+const actionsVariableWriteMaxRetries = 5
+
+// actionsVariableWriteMaxDelay caps the delay between the retries of a write
+// of an environment variable.
+const actionsVariableWriteMaxDelay = 30 * time.Second
+
+// actionsVariableWriteBaseDelay is the delay before the first retry of a write
+// of an environment variable, doubled on each retry.
+var actionsVariableWriteBaseDelay = time.Second
+
+// isTransientActionsVariableError returns whether a write of an environment
+// variable failed with an error worth retrying: a conflict with a concurrent
+// write, a secondary rate limit or a server error.
+func isTransientActionsVariableError(err error) bool {
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return true
+	}
+
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) || ghErr.Response == nil {
+		return false
+	}
+	return ghErr.Response.StatusCode == http.StatusConflict || ghErr.Response.StatusCode >= http.StatusInternalServerError
+}
+
+// retryActionsVariableWrite calls write until it succeeds, returns an error
+// which is not transient, or the retries are exhausted. Retries are delayed
+// with a capped exponential backoff and jitter, so that the many writes of a
+// bulk update recover from transient failures lasting longer than the retry
+// delay of the provider.
+func retryActionsVariableWrite(ctx context.Context, description string, write func() error) error {
+	err := write()
+	for retry := 0; retry < actionsVariableWriteMaxRetries && isTransientActionsVariableError(err); retry++ {
+		delay := min(actionsVariableWriteBaseDelay<<retry, actionsVariableWriteMaxDelay)
+		delay += rand.N(actionsVariableWriteBaseDelay + 1)
+
+		var abuseErr *github.AbuseRateLimitError
+		if errors.As(err, &abuseErr) && abuseErr.GetRetryAfter() > delay {
+			delay = abuseErr.GetRetryAfter()
+		}
+
+		log.Printf("[DEBUG] Write of %s failed with %s, retrying in %s", description, err, delay)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		err = write()
+	}
+	return err
+}
+
+func listGithubActionsEnvironmentVariables(ctx context.Context, client *github.Client, owner, repoName, envName string) (map[string]string, error) {
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	variables := make(map[string]string)
+	for {
+		vs, resp, err := client.Actions.ListEnvVariables(ctx, owner, repoName, url.PathEscape(envName), options)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vs.Variables {
+			variables[v.Name] = v.Value
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return variables, nil
+}
+
+func resourceGithubActionsEnvironmentVariablesCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	escapedEnvName := url.PathEscape(envName)
+	ctx := context.WithValue(context.Background(), ctxId, buildTwoPartID(repoName, envName))
+
+	// Diff against the remote variables rather than the prior state, so only
+	// the variables that actually differ result in API calls.
+	existing, err := listGithubActionsEnvironmentVariables(ctx, client, owner, repoName, envName)
+	if err != nil {
+		return err
+	}
+	remote := make(map[string]string, len(existing))
+	for name, value := range existing {
+		remote[strings.ToUpper(name)] = value
+	}
+
+	desired := make(map[string]string)
+	for name, value := range d.Get("variables").(map[string]any) {
+		desired[strings.ToUpper(name)] = value.(string)
+
+		variable := &github.ActionsVariable{
+			Name:  name,
+			Value: value.(string),
+		}
+		description := fmt.Sprintf("actions variable %s/%s/%s/%s", owner, repoName, envName, name)
+		remoteValue, ok := remote[strings.ToUpper(name)]
+		switch {
+		case !ok:
+			log.Printf("[DEBUG] Creating %s", description)
+			err = retryActionsVariableWrite(ctx, description, func() error {
+				_, err := client.Actions.CreateEnvVariable(ctx, owner, repoName, escapedEnvName, variable)
+				return err
+			})
+		case remoteValue != value.(string):
+			log.Printf("[DEBUG] Updating %s", description)
+			err = retryActionsVariableWrite(ctx, description, func() error {
+				_, err := client.Actions.UpdateEnvVariable(ctx, owner, repoName, escapedEnvName, variable)
+				return err
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for name := range existing {
+		if _, ok := desired[strings.ToUpper(name)]; ok {
+			continue
+		}
+		description := fmt.Sprintf("actions variable %s/%s/%s/%s", owner, repoName, envName, name)
+		log.Printf("[DEBUG] Deleting %s", description)
+		if err := retryActionsVariableWrite(ctx, description, func() error {
+			_, err := client.Actions.DeleteEnvVariable(ctx, owner, repoName, escapedEnvName, name)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(buildTwoPartID(repoName, envName))
+	return resourceGithubActionsEnvironmentVariablesRead(d, meta)
+}
+
+func resourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, envName, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	remote, err := listGithubActionsEnvironmentVariables(ctx, client, owner, repoName, envName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "actions environment variables %s", d.Id())
+	}
+
+	// GitHub returns variable names in upper case, keep the casing used in
+	// the configuration to avoid perpetual diffs.
+	configured := make(map[string]string)
+	for name := range d.Get("variables").(map[string]any) {
+		configured[strings.ToUpper(name)] = name
+	}
+
+	variables := make(map[string]string, len(remote))
+	for name, value := range remote {
+		if configuredName, ok := configured[strings.ToUpper(name)]; ok {
+			name = configuredName
+		}
+		variables[name] = value
+	}
+
+	_ = d.Set("repository", repoName)
+	_ = d.Set("environment", envName)
+	if err := d.Set("variables", variables); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubActionsEnvironmentVariablesDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, envName, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+	escapedEnvName := url.PathEscape(envName)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	for name := range d.Get("variables").(map[string]any) {
+		log.Printf("[DEBUG] Deleting actions variable %s/%s/%s/%s", owner, repoName, envName, name)
+		_, err := client.Actions.DeleteEnvVariable(ctx, owner, repoName, escapedEnvName, name)
+		if err != nil {
+			if err := deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "actions variable %s/%s", d.Id(), name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
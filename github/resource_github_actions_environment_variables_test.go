@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubActionsEnvironmentVariables(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates, updates and deletes environment variables without error", func(t *testing.T) {
+		config := `
+			resource "github_repository" "test" {
+			  name = "tf-acc-test-%s"
+			}
+
+			resource "github_repository_environment" "test" {
+			  repository  = github_repository.test.name
+			  environment = "environment / test"
+			}
+
+			resource "github_actions_environment_variables" "test" {
+			  repository  = github_repository.test.name
+			  environment = github_repository_environment.test.environment
+			  variables   = {
+			    %s
+			  }
+			}
+		`
+
+		before := fmt.Sprintf(config, randomID, `
+			    FIRST_VARIABLE  = "one"
+			    SECOND_VARIABLE = "two"
+		`)
+		after := fmt.Sprintf(config, randomID, `
+			    FIRST_VARIABLE = "uno"
+			    THIRD_VARIABLE = "tres"
+		`)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.%", "2"),
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.FIRST_VARIABLE", "one"),
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.SECOND_VARIABLE", "two"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.%", "2"),
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.FIRST_VARIABLE", "uno"),
+				resource.TestCheckResourceAttr("github_actions_environment_variables.test", "variables.THIRD_VARIABLE", "tres"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: before,
+						Check:  checks["before"],
+					},
+					{
+						Config: after,
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_actions_environment_variables.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
+
+func TestRetryActionsVariableWrite(t *testing.T) {
+	baseDelay := actionsVariableWriteBaseDelay
+	actionsVariableWriteBaseDelay = time.Millisecond
+	defer func() { actionsVariableWriteBaseDelay = baseDelay }()
+
+	conflict := &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusConflict}}
+	notFound := &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	cases := []struct {
+		name          string
+		errs          []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "retries until the write succeeds",
+			errs:          []error{conflict, conflict, nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "does not retry other errors",
+			errs:          []error{notFound},
+			expectedCalls: 1,
+			expectedErr:   notFound,
+		},
+		{
+			name:          "gives up after the maximum number of retries",
+			errs:          []error{conflict, conflict, conflict, conflict, conflict, conflict, nil},
+			expectedCalls: actionsVariableWriteMaxRetries + 1,
+			expectedErr:   conflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			err := retryActionsVariableWrite(context.Background(), "actions variable test", func() error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+
+			if err != tc.expectedErr {
+				t.Fatalf("Expected error %v, got: %v", tc.expectedErr, err)
+			}
+			if calls != tc.expectedCalls {
+				t.Fatalf("Expected %d calls, got: %d", tc.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubActionsHostedRunner() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages a GitHub-hosted larger runner within a GitHub organization",
+		Create:      resourceGithubActionsHostedRunnerCreate,
+		Read:        resourceGithubActionsHostedRunnerRead,
+		Update:      resourceGithubActionsHostedRunnerUpdate,
+		Delete:      resourceGithubActionsHostedRunnerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the runner. Must be between 1 and 64 characters and may only contain upper and lowercase letters a-z, numbers 0-9, '.', '-', and '_'.",
+			},
+			"image": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The image of the runner.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the image, e.g. 'ubuntu-latest'. Curated images are listed by the hosted runners images API.",
+						},
+						"source": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          "github",
+							ValidateDiagFunc: validateValueFunc([]string{"github", "partner", "custom"}),
+							Description:      "The source of the image. Can be one of 'github', 'partner' or 'custom'. Defaults to 'github'.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The version of the image, only applicable to custom images. Defaults to the latest version.",
+						},
+					},
+				},
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The machine size of the runner, e.g. '4-core'. Available sizes are listed by the hosted runners machine sizes API.",
+			},
+			"runner_group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the runner group the runner belongs to.",
+			},
+			"maximum_runners": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "maximum_runners"),
+				Description:      "The maximum number of runners to scale up to, limiting costs.",
+			},
+			"enable_static_ip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the runner is assigned static public IP addresses.",
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system of the runner, derived from its image.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the runner.",
+			},
+			"machine_size_details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The specification of the machine size of the runner.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_cores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of cores.",
+						},
+						"memory_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The available RAM in GB.",
+						},
+						"storage_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The available SSD storage in GB.",
+						},
+					},
+				},
+			},
+			"public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The static public IP ranges assigned to the runner.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the public IP range is enabled.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The prefix of the public IP range.",
+						},
+						"length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The length of the prefix of the public IP range.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// hostedRunnerUpdateRequest is used instead of github.HostedRunnerRequest,
+// whose fields are all omitted when empty, so that static IPs can be disabled.
+type hostedRunnerUpdateRequest struct {
+	Name           string `json:"name"`
+	RunnerGroupID  int64  `json:"runner_group_id"`
+	MaximumRunners int64  `json:"maximum_runners,omitempty"`
+	EnableStaticIP bool   `json:"enable_static_ip"`
+	ImageVersion   string `json:"image_version,omitempty"`
+}
+
+func resourceGithubActionsHostedRunnerCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	image := d.Get("image").([]any)[0].(map[string]any)
+	request := &github.HostedRunnerRequest{
+		Name: d.Get("name").(string),
+		Image: github.HostedRunnerImage{
+			ID:      image["id"].(string),
+			Source:  image["source"].(string),
+			Version: image["version"].(string),
+		},
+		RunnerGroupID:  int64(d.Get("runner_group_id").(int)),
+		Size:           d.Get("size").(string),
+		MaximumRunners: int64(d.Get("maximum_runners").(int)),
+		EnableStaticIP: d.Get("enable_static_ip").(bool),
+	}
+	if request.Image.Version == "" {
+		request.Image.Version = "latest"
+	}
+
+	runner, _, err := client.Actions.CreateHostedRunner(ctx, orgName, request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(runner.GetID(), 10))
+
+	return resourceGithubActionsHostedRunnerRead(d, meta)
+}
+
+func resourceGithubActionsHostedRunnerRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	runnerID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	runner, _, err := client.Actions.GetHostedRunner(ctx, orgName, runnerID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "hosted runner %s/%s", orgName, d.Id())
+	}
+
+	imageDetails := runner.GetImageDetails()
+	image := map[string]any{
+		"id":      imageDetails.GetID(),
+		"source":  imageDetails.GetSource(),
+		"version": imageDetails.GetVersion(),
+	}
+	// The version of curated images is managed by GitHub, keep the configured
+	// one to avoid diffs when GitHub rolls out a new version.
+	if v, ok := d.GetOk("image.0.version"); ok && imageDetails.GetSource() != "custom" {
+		image["version"] = v.(string)
+	}
+
+	machineSizeDetails := make([]any, 0)
+	if spec := runner.GetMachineSizeDetails(); spec != nil {
+		machineSizeDetails = append(machineSizeDetails, map[string]any{
+			"cpu_cores":  spec.CPUCores,
+			"memory_gb":  spec.MemoryGB,
+			"storage_gb": spec.StorageGB,
+		})
+		if err = d.Set("size", spec.ID); err != nil {
+			return err
+		}
+	}
+
+	publicIPs := make([]any, 0, len(runner.PublicIPs))
+	for _, ip := range runner.PublicIPs {
+		publicIPs = append(publicIPs, map[string]any{
+			"enabled": ip.Enabled,
+			"prefix":  ip.Prefix,
+			"length":  ip.Length,
+		})
+	}
+
+	if err = d.Set("name", runner.GetName()); err != nil {
+		return err
+	}
+	if err = d.Set("image", []any{image}); err != nil {
+		return err
+	}
+	if err = d.Set("runner_group_id", runner.GetRunnerGroupID()); err != nil {
+		return err
+	}
+	if err = d.Set("maximum_runners", runner.GetMaximumRunners()); err != nil {
+		return err
+	}
+	if err = d.Set("enable_static_ip", runner.GetPublicIPEnabled()); err != nil {
+		return err
+	}
+	if err = d.Set("platform", runner.GetPlatform()); err != nil {
+		return err
+	}
+	if err = d.Set("status", runner.GetStatus()); err != nil {
+		return err
+	}
+	if err = d.Set("machine_size_details", machineSizeDetails); err != nil {
+		return err
+	}
+	if err = d.Set("public_ips", publicIPs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubActionsHostedRunnerUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	runnerID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	update := hostedRunnerUpdateRequest{
+		Name:           d.Get("name").(string),
+		RunnerGroupID:  int64(d.Get("runner_group_id").(int)),
+		MaximumRunners: int64(d.Get("maximum_runners").(int)),
+		EnableStaticIP: d.Get("enable_static_ip").(bool),
+	}
+	if d.HasChange("image.0.version") {
+		update.ImageVersion = d.Get("image.0.version").(string)
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%s/actions/hosted-runners/%d", orgName, runnerID), update)
+	if err != nil {
+		return err
+	}
+	if _, err = client.Do(ctx, req, nil); err != nil {
+		return err
+	}
+
+	return resourceGithubActionsHostedRunnerRead(d, meta)
+}
+
+func resourceGithubActionsHostedRunnerDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	runnerID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[INFO] Deleting hosted runner: %s/%s", orgName, d.Id())
+	_, _, err = client.Actions.DeleteHostedRunner(ctx, orgName, runnerID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "hosted runner %s/%s", orgName, d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubActionsHostedRunner(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates hosted runners without error", func(t *testing.T) {
+
+		// Larger runners are billed, they are only tested when opted in.
+		if os.Getenv("GITHUB_TEST_HOSTED_RUNNERS") == "" {
+			t.Skip("Skipping because `GITHUB_TEST_HOSTED_RUNNERS` is not set")
+		}
+
+		config := `
+			resource "github_actions_runner_group" "test" {
+				name       = "tf-acc-test-%s"
+				visibility = "all"
+			}
+
+			resource "github_actions_hosted_runner" "test" {
+				name            = "tf-acc-test-%[1]s"
+				size            = "4-core"
+				runner_group_id = github_actions_runner_group.test.id
+				maximum_runners = %d
+
+				image {
+					id = "ubuntu-latest"
+				}
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_hosted_runner.test", "maximum_runners", "2"),
+				resource.TestCheckResourceAttr("github_actions_hosted_runner.test", "image.0.source", "github"),
+				resource.TestCheckResourceAttr("github_actions_hosted_runner.test", "platform", "linux-x64"),
+				resource.TestCheckResourceAttr("github_actions_hosted_runner.test", "machine_size_details.0.cpu_cores", "4"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_actions_hosted_runner.test", "maximum_runners", "4"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, 2),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, 4),
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_actions_hosted_runner.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
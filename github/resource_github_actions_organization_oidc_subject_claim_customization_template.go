@@ -2,11 +2,32 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// oidcSubjectClaimKeys are the claims of the OpenID Connect tokens of GitHub
+// Actions which the subject claim can be built from.
+var oidcSubjectClaimKeys = []string{
+	"actor", "actor_id", "base_ref", "check_run_id", "context", "enterprise", "enterprise_id",
+	"environment", "event_name", "head_ref", "job_workflow_ref", "job_workflow_sha", "ref",
+	"ref_protected", "ref_type", "repo", "repository", "repository_id", "repository_owner",
+	"repository_owner_id", "repository_visibility", "run_attempt", "run_id", "run_number",
+	"runner_environment", "sha", "workflow", "workflow_ref", "workflow_sha",
+}
+
+// validateOIDCSubjectClaimKey warns about the claim keys unknown to the
+// provider rather than failing, as GitHub adds claims over time.
+func validateOIDCSubjectClaimKey(v any, k string) (ws []string, errs []error) {
+	if key := v.(string); !slices.Contains(oidcSubjectClaimKeys, key) {
+		ws = append(ws, fmt.Sprintf("%q is not a known OpenID Connect claim, the subject claim may not include it", key))
+	}
+	return
+}
+
 func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates and manages an OpenID Connect subject claim customization template for an organization",
@@ -24,7 +45,8 @@ func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate() *s
 				MinItems:    1,
 				Description: "A list of OpenID Connect claims.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: toDiagFunc(validateOIDCSubjectClaimKey, "include_claim_keys"),
 				},
 			},
 		},
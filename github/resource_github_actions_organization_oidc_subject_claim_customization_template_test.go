@@ -198,3 +198,12 @@ func TestAccGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate(t *te
 		})
 	})
 }
+
+func TestValidateOIDCSubjectClaimKey(t *testing.T) {
+	if ws, errs := validateOIDCSubjectClaimKey("repository_owner_id", "include_claim_keys"); len(ws) != 0 || len(errs) != 0 {
+		t.Errorf("expected a known claim to be valid, got %v %v", ws, errs)
+	}
+	if ws, errs := validateOIDCSubjectClaimKey("environement", "include_claim_keys"); len(ws) != 1 || len(errs) != 0 {
+		t.Errorf("expected a warning for an unknown claim, got %v %v", ws, errs)
+	}
+}
@@ -2,14 +2,12 @@ package github
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubActionsOrganizationSecret() *schema.Resource {
@@ -43,22 +41,22 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 				Sensitive:        true,
 				ConflictsWith:    []string{"plaintext_value"},
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
-				ValidateDiagFunc: toDiagFunc(validation.StringIsBase64, "encrypted_value"),
+				ValidateDiagFunc: validateEncryptedSecretValueFunc,
 			},
 			"plaintext_value": {
-				Type:          schema.TypeString,
-				ForceNew:      true,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"encrypted_value"},
-				Description:   "Plaintext value of the secret to be encrypted.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"encrypted_value"},
+				Description:      "Plaintext value of the secret to be encrypted.",
+				ValidateDiagFunc: validateSecretValueFunc,
 			},
 			"visibility": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validateValueFunc([]string{"all", "private", "selected"}),
-				ForceNew:         true,
-				Description:      "Configures the access that repositories have to the organization secret. Must be one of 'all', 'private', or 'selected'. 'selected_repository_ids' is required if set to 'selected'.",
+				Description:      "Configures the access that repositories have to the organization secret. Must be one of 'all', 'private', or 'selected'. 'selected_repository_ids' is required if set to 'selected'. Changing it updates the secret in place, keeping its value.",
 			},
 			"selected_repository_ids": {
 				Type: schema.TypeSet,
@@ -69,6 +67,11 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 				Optional:    true,
 				Description: "An array of repository ids that can access the organization secret.",
 			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the public key of the organization the value of the secret was last sent encrypted with.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -84,14 +87,15 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 }
 
 func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
-	plaintextValue := d.Get("plaintext_value").(string)
-	var encryptedValue string
-
 	visibility := d.Get("visibility").(string)
 	selectedRepositories, hasSelectedRepositories := d.GetOk("selected_repository_ids")
 
@@ -114,27 +118,29 @@ func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceDat
 		return err
 	}
 
-	if encryptedText, ok := d.GetOk("encrypted_value"); ok {
-		encryptedValue = encryptedText.(string)
+	encryptedValue, err := organizationSecretValue(d, keyId, publicKey)
+	if err != nil {
+		return err
+	}
+
+	if encryptedValue == "" {
+		err = updateOrganizationSecretAccess(ctx, meta, "actions", secretName, visibility, selectedRepositoryIDs)
 	} else {
-		encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
-		if err != nil {
-			return err
+		// Create an EncryptedSecret and encrypt the plaintext value into it
+		eSecret := &github.EncryptedSecret{
+			Name:                  secretName,
+			KeyID:                 keyId,
+			Visibility:            visibility,
+			SelectedRepositoryIDs: selectedRepositoryIDs,
+			EncryptedValue:        encryptedValue,
 		}
-		encryptedValue = base64.StdEncoding.EncodeToString(encryptedBytes)
+		_, err = client.Actions.CreateOrUpdateOrgSecret(ctx, owner, eSecret)
 	}
-
-	// Create an EncryptedSecret and encrypt the plaintext value into it
-	eSecret := &github.EncryptedSecret{
-		Name:                  secretName,
-		KeyID:                 keyId,
-		Visibility:            visibility,
-		SelectedRepositoryIDs: selectedRepositoryIDs,
-		EncryptedValue:        encryptedValue,
+	if err != nil {
+		return err
 	}
 
-	_, err = client.Actions.CreateOrUpdateOrgSecret(ctx, owner, eSecret)
-	if err != nil {
+	if err = recordOrganizationSecretWrite(d, keyId, encryptedValue); err != nil {
 		return err
 	}
 
@@ -143,6 +149,10 @@ func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceDat
 }
 
 func resourceGithubActionsOrganizationSecretRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	ctx := context.Background()
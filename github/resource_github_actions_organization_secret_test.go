@@ -3,10 +3,14 @@ package github
 import (
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubActionsOrganizationSecret(t *testing.T) {
@@ -184,3 +188,73 @@ func TestAccGithubActionsOrganizationSecret(t *testing.T) {
 		})
 	})
 }
+
+func TestResourceGithubActionsOrganizationSecretUpdateVisibility(t *testing.T) {
+	var body string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/acme/actions/secrets/public-key", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, fmt.Sprintf(`{"key_id": "1", "key": "%s"}`, base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	})
+	mux.HandleFunc("PUT /orgs/acme/actions/secrets/TOKEN", func(w http.ResponseWriter, req *http.Request) {
+		body = mustRead(req.Body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /orgs/acme/actions/secrets/TOKEN", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"name": "TOKEN", "visibility": "all",
+			"created_at": "2026-01-01T00:00:00Z", "updated_at": "2026-01-02T00:00:00Z"}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	for _, tc := range []struct {
+		name      string
+		state     map[string]any
+		sendValue bool
+	}{
+		{
+			name:      "value encrypted with the current key",
+			state:     map[string]any{"encrypted_value": "c2VjcmV0", "key_id": "1"},
+			sendValue: true,
+		},
+		{
+			name:      "value encrypted with a rotated key",
+			state:     map[string]any{"encrypted_value": "c2VjcmV0", "key_id": "0"},
+			sendValue: false,
+		},
+		{
+			name:      "imported secret",
+			state:     map[string]any{},
+			sendValue: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body = ""
+			tc.state["secret_name"] = "TOKEN"
+			tc.state["visibility"] = "all"
+			tc.state["updated_at"] = "2026-01-01T00:00:00Z"
+			d := schema.TestResourceDataRaw(t, resourceGithubActionsOrganizationSecret().Schema, tc.state)
+			d.SetId("TOKEN")
+
+			if err := resourceGithubActionsOrganizationSecretCreateOrUpdate(d, meta); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(body, `"visibility":"all"`) {
+				t.Errorf("expected the visibility to be updated, got %s", body)
+			}
+			if sent := strings.Contains(body, "encrypted_value"); sent != tc.sendValue {
+				t.Errorf("expected sending the value to be %t, got %s", tc.sendValue, body)
+			}
+			if d.Id() != "TOKEN" {
+				t.Errorf("expected the secret to be kept after its update, got ID %q", d.Id())
+			}
+			if got := d.Get("updated_at").(string); got != "2026-01-02 00:00:00 +0000 UTC" {
+				t.Errorf("expected updated_at to be refreshed, got %q", got)
+			}
+		})
+	}
+}
@@ -30,9 +30,10 @@ func resourceGithubActionsOrganizationVariable() *schema.Resource {
 				ValidateDiagFunc: validateSecretNameFunc,
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the variable.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Value of the variable.",
+				ValidateDiagFunc: validateVariableValueFunc,
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -65,6 +66,10 @@ func resourceGithubActionsOrganizationVariable() *schema.Resource {
 }
 
 func resourceGithubActionsOrganizationVariableCreate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	ctx := context.Background()
@@ -148,6 +153,10 @@ func resourceGithubActionsOrganizationVariableUpdate(d *schema.ResourceData, met
 }
 
 func resourceGithubActionsOrganizationVariableRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	ctx := context.Background()
@@ -37,7 +37,8 @@ func resourceGithubActionsRepositoryOIDCSubjectClaimCustomizationTemplate() *sch
 				MinItems:    1,
 				Description: "A list of OpenID Connect claims.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: toDiagFunc(validateOIDCSubjectClaimKey, "include_claim_keys"),
 				},
 			},
 		},
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strconv"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -23,6 +26,8 @@ func resourceGithubActionsRunnerGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceGithubActionsRunnerGroupDiff,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -88,14 +93,68 @@ func resourceGithubActionsRunnerGroup() *schema.Resource {
 			},
 			"selected_workflows": {
 				Type:        schema.TypeList,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateRunnerGroupSelectedWorkflow},
+				Optional:    true,
+				Description: "List of workflows the runner group should be allowed to run, as '<owner>/<repository>/.github/workflows/<file>@<ref>'. This setting will be ignored unless restricted_to_workflows is set to 'true'.",
+			},
+			"network_configuration_id": {
+				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "List of workflows the runner group should be allowed to run. This setting will be ignored unless restricted_to_workflows is set to 'true'.",
+				Description: "The ID of the hosted compute network configuration the GitHub-hosted runners of the group are attached to.",
 			},
 		},
 	}
 }
 
+// runnerGroupSelectedWorkflowRegexp matches the reference of a workflow a
+// runner group can be restricted to, e.g.
+// 'octo-org/octo-repo/.github/workflows/deploy.yml@refs/heads/main'.
+var runnerGroupSelectedWorkflowRegexp = regexp.MustCompile(`^[^/@]+/[^/@]+/\.github/workflows/[^@]+\.ya?ml@.+$`)
+
+func validateRunnerGroupSelectedWorkflow(v any, k cty.Path) diag.Diagnostics {
+	var errs []error
+	if workflow := v.(string); !runnerGroupSelectedWorkflowRegexp.MatchString(workflow) {
+		errs = append(errs, fmt.Errorf("%s is an invalid workflow for argument %s, expected <owner>/<repository>/.github/workflows/<file>@<ref>", workflow, k))
+	}
+	return wrapErrors(errs)
+}
+
+// resourceGithubActionsRunnerGroupDiff rejects restricting a runner group to
+// no workflow, as an empty list of workflows cannot be sent.
+func resourceGithubActionsRunnerGroupDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("selected_workflows") || !diff.NewValueKnown("restricted_to_workflows") {
+		return nil
+	}
+	if diff.Get("restricted_to_workflows").(bool) && len(diff.Get("selected_workflows").([]any)) == 0 {
+		return fmt.Errorf("at least one of selected_workflows must be set when restricted_to_workflows is true")
+	}
+	return nil
+}
+
+// orderRunnerGroupSelectedWorkflows returns the selected workflows of a runner
+// group in the order of the current ones, as GitHub does not preserve it, so
+// that only actual changes are reported as drift.
+func orderRunnerGroupSelectedWorkflows(current []any, workflows []string) []string {
+	remaining := make(map[string]bool, len(workflows))
+	for _, workflow := range workflows {
+		remaining[workflow] = true
+	}
+
+	ordered := make([]string, 0, len(workflows))
+	for _, workflow := range current {
+		if remaining[workflow.(string)] {
+			ordered = append(ordered, workflow.(string))
+			delete(remaining, workflow.(string))
+		}
+	}
+	for _, workflow := range workflows {
+		if remaining[workflow] {
+			ordered = append(ordered, workflow)
+		}
+	}
+	return ordered
+}
+
 func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta any) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -148,6 +207,11 @@ func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta any) er
 		return err
 	}
 	d.SetId(strconv.FormatInt(runnerGroup.GetID(), 10))
+	if networkConfigurationID := d.Get("network_configuration_id").(string); networkConfigurationID != "" {
+		if err = setOrganizationRunnerGroupNetworkConfigurationID(ctx, client, orgName, runnerGroup.GetID(), networkConfigurationID); err != nil {
+			return err
+		}
+	}
 	if err = d.Set("etag", resp.Header.Get("ETag")); err != nil {
 		return err
 	}
@@ -189,6 +253,48 @@ func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta any) er
 	return resourceGithubActionsRunnerGroupRead(d, meta)
 }
 
+// runnerGroupNetworkConfiguration is the network configuration binding of a
+// runner group, which is not supported by go-github.
+type runnerGroupNetworkConfiguration struct {
+	NetworkConfigurationID *string `json:"network_configuration_id"`
+}
+
+func (g *runnerGroupNetworkConfiguration) GetNetworkConfigurationID() string {
+	if g == nil || g.NetworkConfigurationID == nil {
+		return ""
+	}
+	return *g.NetworkConfigurationID
+}
+
+func getOrganizationRunnerGroupNetworkConfigurationID(ctx context.Context, client *github.Client, org string, groupID int64) (string, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/actions/runner-groups/%d", org, groupID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var group runnerGroupNetworkConfiguration
+	if _, err = client.Do(ctx, req, &group); err != nil {
+		return "", err
+	}
+	return group.GetNetworkConfigurationID(), nil
+}
+
+// setOrganizationRunnerGroupNetworkConfigurationID attaches the runner group
+// to a network configuration, or detaches it if networkConfigurationID is empty.
+func setOrganizationRunnerGroupNetworkConfigurationID(ctx context.Context, client *github.Client, org string, groupID int64, networkConfigurationID string) error {
+	group := runnerGroupNetworkConfiguration{}
+	if networkConfigurationID != "" {
+		group.NetworkConfigurationID = &networkConfigurationID
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("orgs/%s/actions/runner-groups/%d", org, groupID), group)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
 func getOrganizationRunnerGroup(client *github.Client, ctx context.Context, org string, groupID int64) (*github.RunnerGroup, *github.Response, error) {
 	runnerGroup, resp, err := client.Actions.GetOrganizationRunnerGroup(ctx, org, groupID)
 	if err != nil {
@@ -266,7 +372,7 @@ func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta any) erro
 	if err = d.Set("restricted_to_workflows", runnerGroup.GetRestrictedToWorkflows()); err != nil {
 		return err
 	}
-	if err = d.Set("selected_workflows", runnerGroup.SelectedWorkflows); err != nil {
+	if err = d.Set("selected_workflows", orderRunnerGroupSelectedWorkflows(d.Get("selected_workflows").([]any), runnerGroup.SelectedWorkflows)); err != nil {
 		return err
 	}
 
@@ -296,6 +402,15 @@ func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta any) erro
 		return err
 	}
 
+	// The runner group was just read, it must not be revalidated with its etag.
+	networkConfigurationID, err := getOrganizationRunnerGroupNetworkConfigurationID(context.WithValue(context.Background(), ctxId, d.Id()), client, orgName, runnerGroupID)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("network_configuration_id", networkConfigurationID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -354,6 +469,12 @@ func resourceGithubActionsRunnerGroupUpdate(d *schema.ResourceData, meta any) er
 		return err
 	}
 
+	if d.HasChange("network_configuration_id") {
+		if err := setOrganizationRunnerGroupNetworkConfigurationID(ctx, client, orgName, runnerGroupID, d.Get("network_configuration_id").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubActionsRunnerGroupRead(d, meta)
 }
 
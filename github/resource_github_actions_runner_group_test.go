@@ -2,8 +2,11 @@ package github
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -11,6 +14,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestValidateRunnerGroupSelectedWorkflow(t *testing.T) {
+	testCases := map[string]bool{
+		"octo-org/octo-repo/.github/workflows/deploy.yml@refs/heads/main": true,
+		"octo-org/octo-repo/.github/workflows/ci/deploy.yaml@v1":          true,
+		"octo-org/octo-repo/.github/workflows/deploy.yml":                 false,
+		"octo-repo/.github/workflows/deploy.yml@main":                     false,
+		"octo-org/octo-repo/workflows/deploy.yml@main":                    false,
+	}
+
+	for workflow, valid := range testCases {
+		diags := validateRunnerGroupSelectedWorkflow(workflow, cty.Path{})
+		if diags.HasError() == valid {
+			t.Errorf("workflow %q: expected valid=%v, got %v", workflow, valid, diags)
+		}
+	}
+}
+
+func TestOrderRunnerGroupSelectedWorkflows(t *testing.T) {
+	current := []any{"o/r/.github/workflows/b.yml@main", "o/r/.github/workflows/a.yml@main", "o/r/.github/workflows/c.yml@main"}
+	workflows := []string{"o/r/.github/workflows/a.yml@main", "o/r/.github/workflows/d.yml@main", "o/r/.github/workflows/b.yml@main"}
+
+	expected := []string{"o/r/.github/workflows/b.yml@main", "o/r/.github/workflows/a.yml@main", "o/r/.github/workflows/d.yml@main"}
+	if got := orderRunnerGroupSelectedWorkflows(current, workflows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
 func TestAccGithubActionsRunnerGroup(t *testing.T) {
 
 	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
@@ -38,20 +38,22 @@ func resourceGithubActionsSecret() *schema.Resource {
 				ValidateDiagFunc: validateSecretNameFunc,
 			},
 			"encrypted_value": {
-				Type:          schema.TypeString,
-				ForceNew:      true,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"plaintext_value"},
-				Description:   "Encrypted value of the secret using the GitHub public key in Base64 format.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"plaintext_value"},
+				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
+				ValidateDiagFunc: validateEncryptedSecretValueFunc,
 			},
 			"plaintext_value": {
-				Type:          schema.TypeString,
-				ForceNew:      true,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"encrypted_value"},
-				Description:   "Plaintext value of the secret to be encrypted.",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"encrypted_value"},
+				Description:      "Plaintext value of the secret to be encrypted.",
+				ValidateDiagFunc: validateSecretValueFunc,
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -77,6 +79,12 @@ func resourceGithubActionsSecretCreateOrUpdate(d *schema.ResourceData, meta any)
 	plaintextValue := d.Get("plaintext_value").(string)
 	var encryptedValue string
 
+	if d.IsNewResource() {
+		if err := waitForRepositoryVisible(ctx, meta, repo); err != nil {
+			return err
+		}
+	}
+
 	keyId, publicKey, err := getPublicKeyDetails(owner, repo, meta)
 	if err != nil {
 		return err
@@ -34,9 +34,10 @@ func resourceGithubActionsVariable() *schema.Resource {
 				ValidateDiagFunc: validateSecretNameFunc,
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the variable.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Value of the variable.",
+				ValidateDiagFunc: validateVariableValueFunc,
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -63,6 +64,10 @@ func resourceGithubActionsVariableCreate(d *schema.ResourceData, meta any) error
 		Value: d.Get("value").(string),
 	}
 
+	if err := waitForRepositoryVisible(ctx, meta, repo); err != nil {
+		return err
+	}
+
 	_, err := client.Actions.CreateRepoVariable(ctx, owner, repo, variable)
 	if err != nil {
 		return err
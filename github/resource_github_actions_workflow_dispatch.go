@@ -0,0 +1,262 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// workflowRunPollInterval is the delay between two lookups of the runs of a
+// workflow while waiting for a dispatched run to start or complete.
+const workflowRunPollInterval = 5 * time.Second
+
+func resourceGithubActionsWorkflowDispatch() *schema.Resource {
+	return &schema.Resource{
+		Description: "Triggers a GitHub Actions workflow with a workflow_dispatch event when created.",
+		Create:      resourceGithubActionsWorkflowDispatchCreate,
+		Read:        resourceGithubActionsWorkflowDispatchRead,
+		Update:      resourceGithubActionsWorkflowDispatchUpdate,
+		Delete:      resourceGithubActionsWorkflowDispatchDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository of the workflow.",
+			},
+			"workflow": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The file name of the workflow, e.g. 'seed.yml', or its ID.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The branch or tag to run the workflow on.",
+			},
+			"inputs": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The inputs of the workflow, at most 10.",
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the run to complete and fail unless it concludes successfully.",
+			},
+			"timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          600,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "timeout"),
+				Description:      "The number of seconds to wait for the run to start and, with 'wait_for_completion', to complete.",
+			},
+			"run_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the workflow run.",
+			},
+			"run_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the workflow run.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the workflow run, e.g. 'queued', 'in_progress' or 'completed'.",
+			},
+			"conclusion": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The conclusion of the workflow run once completed, e.g. 'success' or 'failure'.",
+			},
+		},
+	}
+}
+
+// listGithubWorkflowDispatchRuns returns the most recent runs of the workflow
+// triggered by a workflow_dispatch event on ref.
+func listGithubWorkflowDispatchRuns(ctx context.Context, client *github.Client, owner, repoName, workflow, ref string) ([]*github.WorkflowRun, error) {
+	options := &github.ListWorkflowRunsOptions{
+		Branch:      strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/"),
+		Event:       "workflow_dispatch",
+		ListOptions: github.ListOptions{PerPage: 20},
+	}
+
+	var runs *github.WorkflowRuns
+	var err error
+	if workflowID, parseErr := strconv.ParseInt(workflow, 10, 64); parseErr == nil {
+		runs, _, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repoName, workflowID, options)
+	} else {
+		runs, _, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repoName, workflow, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return runs.WorkflowRuns, nil
+}
+
+func resourceGithubActionsWorkflowDispatchCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	workflow := d.Get("workflow").(string)
+	ref := d.Get("ref").(string)
+	timeout := time.Duration(d.Get("timeout").(int)) * time.Second
+
+	inputs := make(map[string]any)
+	for name, value := range d.Get("inputs").(map[string]any) {
+		inputs[name] = value
+	}
+
+	// The dispatch API does not return the run it triggers, it is the newest
+	// run that did not exist before the dispatch.
+	previousRuns, err := listGithubWorkflowDispatchRuns(ctx, client, owner, repoName, workflow, ref)
+	if err != nil {
+		return err
+	}
+	previousRunIDs := make(map[int64]bool, len(previousRuns))
+	for _, run := range previousRuns {
+		previousRunIDs[run.GetID()] = true
+	}
+
+	event := github.CreateWorkflowDispatchEventRequest{
+		Ref:    ref,
+		Inputs: inputs,
+	}
+	log.Printf("[INFO] Dispatching workflow %s of %s/%s on %s", workflow, owner, repoName, ref)
+	if workflowID, parseErr := strconv.ParseInt(workflow, 10, 64); parseErr == nil {
+		_, err = client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repoName, workflowID, event)
+	} else {
+		_, err = client.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repoName, workflow, event)
+	}
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	var run *github.WorkflowRun
+	for run == nil {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the run of workflow %s of %s/%s did not start within %s", workflow, owner, repoName, timeout)
+		}
+		time.Sleep(workflowRunPollInterval)
+
+		runs, err := listGithubWorkflowDispatchRuns(ctx, client, owner, repoName, workflow, ref)
+		if err != nil {
+			return err
+		}
+		for _, r := range runs {
+			if !previousRunIDs[r.GetID()] {
+				run = r
+				break
+			}
+		}
+	}
+
+	d.SetId(buildTwoPartID(repoName, strconv.FormatInt(run.GetID(), 10)))
+	if err = d.Set("run_id", run.GetID()); err != nil {
+		return err
+	}
+	if err = d.Set("run_url", run.GetHTMLURL()); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		for run.GetStatus() != "completed" {
+			if time.Now().After(deadline) {
+				return fmt.Errorf("the run %s of workflow %s did not complete within %s", run.GetHTMLURL(), workflow, timeout)
+			}
+			time.Sleep(workflowRunPollInterval)
+
+			run, _, err = client.Actions.GetWorkflowRunByID(ctx, owner, repoName, run.GetID())
+			if err != nil {
+				return err
+			}
+		}
+
+		if run.GetConclusion() != "success" {
+			// The resource is tainted, the workflow is dispatched again by the
+			// next apply.
+			if err = d.Set("status", run.GetStatus()); err != nil {
+				return err
+			}
+			if err = d.Set("conclusion", run.GetConclusion()); err != nil {
+				return err
+			}
+			return fmt.Errorf("the run %s of workflow %s concluded with %s", run.GetHTMLURL(), workflow, run.GetConclusion())
+		}
+	}
+
+	return resourceGithubActionsWorkflowDispatchRead(d, meta)
+}
+
+func resourceGithubActionsWorkflowDispatchRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, runIDString, err := parseTwoPartID(d.Id(), "repository", "run_id")
+	if err != nil {
+		return err
+	}
+	runID, err := strconv.ParseInt(runIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(runIDString, err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	run, _, err := client.Actions.GetWorkflowRunByID(ctx, owner, repoName, runID)
+	if err != nil {
+		// The resource represents the dispatch, which happened even if its run
+		// was deleted since, so it is kept to not dispatch the workflow again.
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] The run %s of workflow %s no longer exists in GitHub", runIDString, d.Get("workflow").(string))
+			return nil
+		}
+		return err
+	}
+
+	if err = d.Set("run_id", run.GetID()); err != nil {
+		return err
+	}
+	if err = d.Set("run_url", run.GetHTMLURL()); err != nil {
+		return err
+	}
+	if err = d.Set("status", run.GetStatus()); err != nil {
+		return err
+	}
+	if err = d.Set("conclusion", run.GetConclusion()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubActionsWorkflowDispatchUpdate(d *schema.ResourceData, meta any) error {
+	// Only the waiting settings can change, they apply to the next dispatch.
+	return resourceGithubActionsWorkflowDispatchRead(d, meta)
+}
+
+func resourceGithubActionsWorkflowDispatchDelete(d *schema.ResourceData, meta any) error {
+	// A workflow run cannot be undone, destroying the resource only removes it
+	// from state.
+	log.Printf("[INFO] Removing workflow dispatch %s from state, the workflow run is left unchanged", d.Id())
+	return nil
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubActionsWorkflowDispatch(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("dispatches a workflow and waits for its completion", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_file" "workflow" {
+				repository          = github_repository.test.name
+				file                = ".github/workflows/seed.yml"
+				overwrite_on_create = true
+				content             = <<-EOT
+					on:
+					  workflow_dispatch:
+					    inputs:
+					      greeting:
+					        required: true
+					jobs:
+					  seed:
+					    runs-on: ubuntu-latest
+					    steps:
+					      - run: echo "$${{ inputs.greeting }}"
+				EOT
+			}
+
+			resource "github_actions_workflow_dispatch" "test" {
+				repository = github_repository.test.name
+				workflow   = "seed.yml"
+				ref        = github_repository.test.default_branch
+
+				inputs = {
+					greeting = "hello"
+				}
+
+				wait_for_completion = true
+
+				depends_on = [github_repository_file.workflow]
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("github_actions_workflow_dispatch.test", "run_id"),
+			resource.TestCheckResourceAttrSet("github_actions_workflow_dispatch.test", "run_url"),
+			resource.TestCheckResourceAttr("github_actions_workflow_dispatch.test", "status", "completed"),
+			resource.TestCheckResourceAttr("github_actions_workflow_dispatch.test", "conclusion", "success"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +23,8 @@ func resourceGithubAppInstallationRepositories() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceGithubAppInstallationRepositoriesDiff,
+
 		Schema: map[string]*schema.Schema{
 			"installation_id": {
 				Type:        schema.TypeString,
@@ -36,6 +41,19 @@ func resourceGithubAppInstallationRepositories() *schema.Resource {
 				Required:    true,
 				Description: "A list of repository names to install the app on.",
 			},
+			"fail_on_unmanaged_repositories": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether planning fails when repositories were added to the installation outside of Terraform and are not in 'selected_repositories', instead of removing them from the installation.",
+			},
+			"unmanaged_repositories": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The repositories added to the installation outside of Terraform since the last apply, as of the last refresh.",
+			},
 		},
 	}
 }
@@ -44,8 +62,6 @@ func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceD
 	installationIDString := d.Get("installation_id").(string)
 	selectedRepositories := d.Get("selected_repositories")
 
-	client := meta.(*Owner).v3client
-	owner := meta.(*Owner).name
 	ctx := context.WithValue(context.Background(), ctxId, installationIDString)
 
 	selectedRepositoryNames := []string{}
@@ -55,6 +71,37 @@ func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceD
 		selectedRepositoryNames = append(selectedRepositoryNames, name.(string))
 	}
 
+	if err := syncAppInstallationRepositories(ctx, meta, installationIDString, selectedRepositoryNames); err != nil {
+		return err
+	}
+
+	d.SetId(installationIDString)
+	if err := d.Set("unmanaged_repositories", []string{}); err != nil {
+		return err
+	}
+	return resourceGithubAppInstallationRepositoriesRead(d, meta)
+}
+
+// unmanagedAppInstallationRepositories returns the sorted names of the
+// repositories of the installation missing from the previous state, along
+// with those previously unmanaged which the installation still has access to.
+func unmanagedAppInstallationRepositories(current []string, previous, previousUnmanaged *schema.Set) []string {
+	unmanaged := make([]string, 0)
+	for _, name := range current {
+		if !previous.Contains(name) || previousUnmanaged.Contains(name) {
+			unmanaged = append(unmanaged, name)
+		}
+	}
+	sort.Strings(unmanaged)
+	return unmanaged
+}
+
+// syncAppInstallationRepositories adds and removes repositories of an app
+// installation so that it has access to the selected repositories only.
+func syncAppInstallationRepositories(ctx context.Context, meta any, installationIDString string, selectedRepositoryNames []string) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
 	currentReposNameIDs, instID, err := getAllAccessibleRepos(meta, installationIDString)
 	if err != nil {
 		return err
@@ -93,8 +140,7 @@ func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceD
 		}
 	}
 
-	d.SetId(installationIDString)
-	return resourceGithubAppInstallationRepositoriesRead(d, meta)
+	return nil
 }
 
 func resourceGithubAppInstallationRepositoriesRead(d *schema.ResourceData, meta any) error {
@@ -110,6 +156,12 @@ func resourceGithubAppInstallationRepositoriesRead(d *schema.ResourceData, meta
 		repoNames = append(repoNames, name)
 	}
 
+	// Nothing is unmanaged on import, all the repositories are.
+	unmanaged := []string{}
+	if d.Get("installation_id").(string) != "" {
+		unmanaged = unmanagedAppInstallationRepositories(repoNames, d.Get("selected_repositories").(*schema.Set), d.Get("unmanaged_repositories").(*schema.Set))
+	}
+
 	if len(reposNameIDs) > 0 {
 		if err = d.Set("installation_id", installationIDString); err != nil {
 			return err
@@ -117,6 +169,9 @@ func resourceGithubAppInstallationRepositoriesRead(d *schema.ResourceData, meta
 		if err = d.Set("selected_repositories", repoNames); err != nil {
 			return err
 		}
+		if err = d.Set("unmanaged_repositories", unmanaged); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -158,11 +213,6 @@ func resourceGithubAppInstallationRepositoriesDelete(d *schema.ResourceData, met
 }
 
 func getAllAccessibleRepos(meta any, idString string) (map[string]int64, int64, error) {
-	err := checkOrganization(meta)
-	if err != nil {
-		return nil, 0, err
-	}
-
 	installationID, err := strconv.ParseInt(idString, 10, 64)
 	if err != nil {
 		return nil, 0, unconvertibleIdErr(idString, err)
@@ -191,3 +241,35 @@ func getAllAccessibleRepos(meta any, idString string) (map[string]int64, int64,
 
 	return allRepos, installationID, nil
 }
+
+// resourceGithubAppInstallationRepositoriesDiff fails the plan when it would
+// remove repositories added to the installation outside of Terraform and
+// fail_on_unmanaged_repositories is set. Otherwise an update is planned, which
+// removes them from the installation unless they were added to
+// selected_repositories, and no longer reports them as unmanaged.
+func resourceGithubAppInstallationRepositoriesDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	unmanaged := diff.Get("unmanaged_repositories").(*schema.Set)
+	if unmanaged.Len() == 0 {
+		return nil
+	}
+
+	if diff.Get("fail_on_unmanaged_repositories").(bool) {
+		selected := diff.Get("selected_repositories").(*schema.Set)
+		var removed []string
+		for _, name := range unmanaged.List() {
+			if !selected.Contains(name) {
+				removed = append(removed, name.(string))
+			}
+		}
+		if len(removed) > 0 {
+			sort.Strings(removed)
+			return fmt.Errorf("repositories %s were added to app installation %s outside of Terraform, add them to selected_repositories or remove them from the installation",
+				strings.Join(removed, ", "), diff.Id())
+		}
+	}
+
+	return planRefreshedDrift(diff, "unmanaged_repositories")
+}
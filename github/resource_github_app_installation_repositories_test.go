@@ -3,12 +3,29 @@ package github
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+func TestUnmanagedAppInstallationRepositories(t *testing.T) {
+	previous := schema.NewSet(schema.HashString, []any{"managed", "previously-unmanaged"})
+	previousUnmanaged := schema.NewSet(schema.HashString, []any{"previously-unmanaged", "removed"})
+
+	unmanaged := unmanagedAppInstallationRepositories([]string{"new", "managed", "previously-unmanaged"}, previous, previousUnmanaged)
+	if !reflect.DeepEqual(unmanaged, []string{"new", "previously-unmanaged"}) {
+		t.Errorf("expected the new and previously unmanaged repositories, got %v", unmanaged)
+	}
+
+	empty := schema.NewSet(schema.HashString, nil)
+	if unmanaged := unmanagedAppInstallationRepositories([]string{"managed"}, previous, empty); len(unmanaged) != 0 {
+		t.Errorf("expected no unmanaged repository, got %v", unmanaged)
+	}
+}
+
 func TestAccGithubAppInstallationRepositories(t *testing.T) {
 
 	const APP_INSTALLATION_ID = "APP_INSTALLATION_ID"
@@ -38,6 +55,8 @@ func TestAccGithubAppInstallationRepositories(t *testing.T) {
 			# The installation id of the app (in the organization).
 			installation_id         = "%s"
 			selected_repositories = [github_repository.test1.name, github_repository.test2.name]
+
+			fail_on_unmanaged_repositories = true
 		}
 
 		`, randomID1, randomID2, installation_id)
@@ -49,6 +68,9 @@ func TestAccGithubAppInstallationRepositories(t *testing.T) {
 			resource.TestCheckResourceAttr(
 				"github_app_installation_repositories.test", "selected_repositories.#", "2",
 			),
+			resource.TestCheckResourceAttr(
+				"github_app_installation_repositories.test", "unmanaged_repositories.#", "0",
+			),
 		)
 
 		testCase := func(t *testing.T, mode string) {
@@ -41,11 +41,6 @@ func resourceGithubAppInstallationRepository() *schema.Resource {
 }
 
 func resourceGithubAppInstallationRepositoryCreate(d *schema.ResourceData, meta any) error {
-	err := checkOrganization(meta)
-	if err != nil {
-		return err
-	}
-
 	installationIDString := d.Get("installation_id").(string)
 	installationID, err := strconv.ParseInt(installationIDString, 10, 64)
 	if err != nil {
@@ -72,11 +67,6 @@ func resourceGithubAppInstallationRepositoryCreate(d *schema.ResourceData, meta
 }
 
 func resourceGithubAppInstallationRepositoryRead(d *schema.ResourceData, meta any) error {
-	err := checkOrganization(meta)
-	if err != nil {
-		return err
-	}
-
 	client := meta.(*Owner).v3client
 	installationIDString, repoName, err := parseTwoPartID(d.Id(), "installation_id", "repository")
 	if err != nil {
@@ -125,11 +115,6 @@ func resourceGithubAppInstallationRepositoryRead(d *schema.ResourceData, meta an
 }
 
 func resourceGithubAppInstallationRepositoryDelete(d *schema.ResourceData, meta any) error {
-	err := checkOrganization(meta)
-	if err != nil {
-		return err
-	}
-
 	installationIDString := d.Get("installation_id").(string)
 	installationID, err := strconv.ParseInt(installationIDString, 10, 64)
 	if err != nil {
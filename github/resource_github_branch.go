@@ -20,6 +20,7 @@ func resourceGithubBranch() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubBranchImport,
 		},
+		CustomizeDiff: resourceGithubBranchDiff,
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
@@ -39,14 +40,22 @@ func resourceGithubBranch() *schema.Resource {
 				Default:     "main",
 				Optional:    true,
 				ForceNew:    true,
-				Description: "The branch name to start from. Defaults to 'main'.",
+				Description: "The branch name to start from. Defaults to 'main'. Conflicts with 'source_sha' and 'source_tag'.",
 			},
 			"source_sha": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "The commit hash to start from. Defaults to the tip of 'source_branch'. If provided, 'source_branch' is ignored.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source_tag"},
+				Description:   "The commit hash to start from. Defaults to the tip of 'source_branch', or the commit of 'source_tag'. Conflicts with 'source_branch' and 'source_tag'.",
+			},
+			"source_tag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_sha"},
+				Description:   "The tag name to start from. Conflicts with 'source_branch' and 'source_sha'.",
 			},
 			"etag": {
 				Type:        schema.TypeString,
@@ -67,6 +76,41 @@ func resourceGithubBranch() *schema.Resource {
 	}
 }
 
+// resourceGithubBranchDiff rejects a source branch configured along with a
+// source commit or tag. As 'source_branch' has a default, the conflict cannot
+// be declared in its schema.
+func resourceGithubBranchDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || config.GetAttr("source_branch").IsNull() {
+		return nil
+	}
+	for _, key := range []string{"source_sha", "source_tag"} {
+		if !config.GetAttr(key).IsNull() {
+			return fmt.Errorf("%q conflicts with %q", "source_branch", key)
+		}
+	}
+	return nil
+}
+
+// getTagCommitSHA returns the SHA of the commit a tag points to, following
+// annotated tags to their commit.
+func getTagCommitSHA(ctx context.Context, client *github.Client, owner, repoName, tagName string) (string, error) {
+	ref, _, err := client.Git.GetRef(ctx, owner, repoName, "refs/tags/"+tagName)
+	if err != nil {
+		return "", err
+	}
+
+	object := ref.GetObject()
+	for object.GetType() == "tag" {
+		tag, _, err := client.Git.GetTag(ctx, owner, repoName, object.GetSHA())
+		if err != nil {
+			return "", err
+		}
+		object = tag.GetObject()
+	}
+	return object.GetSHA(), nil
+}
+
 func resourceGithubBranchCreate(d *schema.ResourceData, meta any) error {
 	ctx := context.Background()
 	if !d.IsNewResource() {
@@ -81,7 +125,16 @@ func resourceGithubBranchCreate(d *schema.ResourceData, meta any) error {
 	sourceBranchName := d.Get("source_branch").(string)
 	sourceBranchRefName := "refs/heads/" + sourceBranchName
 
-	if _, hasSourceSHA := d.GetOk("source_sha"); !hasSourceSHA {
+	if sourceTagName, hasSourceTag := d.GetOk("source_tag"); hasSourceTag {
+		sourceTagSHA, err := getTagCommitSHA(ctx, client, orgName, repoName, sourceTagName.(string))
+		if err != nil {
+			return fmt.Errorf("error querying GitHub tag reference %s/%s (refs/tags/%s): %s",
+				orgName, repoName, sourceTagName, err)
+		}
+		if err = d.Set("source_sha", sourceTagSHA); err != nil {
+			return err
+		}
+	} else if _, hasSourceSHA := d.GetOk("source_sha"); !hasSourceSHA {
 		ref, _, err := client.Git.GetRef(ctx, orgName, repoName, sourceBranchRefName)
 		if err != nil {
 			return fmt.Errorf("error querying GitHub branch reference %s/%s (%s): %s",
@@ -71,6 +71,11 @@ func resourceGithubBranchProtection() *schema.Resource {
 				Default:     false,
 				Description: "Setting this to 'true' will make the branch read-only and preventing any pushes to it.",
 			},
+			PROTECTION_REQUIRES_MERGE_QUEUE: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether pull requests to the branch must be merged with a merge queue, as required in the GitHub UI or by a ruleset. It cannot be set with the API, use the 'merge_queue' rule of 'github_repository_ruleset' instead. Always 'false' for patterns with wildcards.",
+			},
 			PROTECTION_REQUIRES_APPROVING_REVIEWS: {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -102,14 +107,14 @@ func resourceGithubBranchProtection() *schema.Resource {
 						PROTECTION_REVIEW_DISMISSAL_ALLOWANCES: {
 							Type:        schema.TypeSet,
 							Optional:    true,
-							Description: "The list of actor Names/IDs with dismissal access. If not empty, 'restrict_dismissals' is ignored. Actor names must either begin with a '/' for users or the organization name followed by a '/' for teams.",
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The list of actor Names/IDs with dismissal access. If not empty, 'restrict_dismissals' is ignored. Actor names must either begin with a '/' for users or the organization name followed by a '/' for teams. Apps must be given by node ID.",
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: toDiagFunc(validateBranchProtectionActor, PROTECTION_REVIEW_DISMISSAL_ALLOWANCES)},
 						},
 						PROTECTION_PULL_REQUESTS_BYPASSERS: {
 							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of actor Names/IDs that are allowed to bypass pull request requirements. Actor names must either begin with a '/' for users or the organization name followed by a '/' for teams.",
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: toDiagFunc(validateBranchProtectionActor, PROTECTION_PULL_REQUESTS_BYPASSERS)},
 						},
 						PROTECTION_REQUIRE_LAST_PUSH_APPROVAL: {
 							Type:        schema.TypeBool,
@@ -156,7 +161,7 @@ func resourceGithubBranchProtection() *schema.Resource {
 							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of actor Names/IDs that may push to the branch. Actor names must either begin with a '/' for users or the organization name followed by a '/' for teams.",
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: toDiagFunc(validateBranchProtectionActor, PROTECTION_PUSH_ALLOWANCES)},
 						},
 					},
 				},
@@ -165,7 +170,7 @@ func resourceGithubBranchProtection() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "The list of actor Names/IDs that are allowed to bypass force push restrictions. Actor names must either begin with a '/' for users or the organization name followed by a '/' for teams.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: toDiagFunc(validateBranchProtectionActor, PROTECTION_FORCE_PUSHES_BYPASSERS)},
 			},
 		},
 
@@ -201,63 +206,19 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta any) erro
 			}
 		} `graphql:"createBranchProtectionRule(input: $input)"`
 	}
-	data, err := branchProtectionResourceData(d, meta)
-	if err != nil {
-		return err
-	}
-
-	var reviewIds, pushIds, bypassForcePushIds, bypassPullRequestIds []string
-	reviewIds, err = getActorIds(data.ReviewDismissalActorIDs, meta)
-	if err != nil {
-		return err
-	}
-
-	pushIds, err = getActorIds(data.PushActorIDs, meta)
-	if err != nil {
+	if err := waitForRepositoryIDVisible(context.Background(), meta, d.Get(REPOSITORY_ID).(string)); err != nil {
 		return err
 	}
-
-	bypassForcePushIds, err = getActorIds(data.BypassForcePushActorIDs, meta)
+	data, err := branchProtectionResourceData(d, meta)
 	if err != nil {
 		return err
 	}
 
-	bypassPullRequestIds, err = getActorIds(data.BypassPullRequestActorIDs, meta)
-	if err != nil {
+	if err = resolveBranchProtectionActorIDs(&data, getActorIds, meta); err != nil {
 		return err
 	}
 
-	data.PushActorIDs = pushIds
-	data.ReviewDismissalActorIDs = reviewIds
-	data.BypassForcePushActorIDs = bypassForcePushIds
-	data.BypassPullRequestActorIDs = bypassPullRequestIds
-
-	input := githubv4.CreateBranchProtectionRuleInput{
-		AllowsDeletions:                githubv4.NewBoolean(githubv4.Boolean(data.AllowsDeletions)),
-		AllowsForcePushes:              githubv4.NewBoolean(githubv4.Boolean(data.AllowsForcePushes)),
-		BlocksCreations:                githubv4.NewBoolean(githubv4.Boolean(data.BlocksCreations)),
-		BypassForcePushActorIDs:        githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassForcePushActorIDs)),
-		BypassPullRequestActorIDs:      githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassPullRequestActorIDs)),
-		DismissesStaleReviews:          githubv4.NewBoolean(githubv4.Boolean(data.DismissesStaleReviews)),
-		IsAdminEnforced:                githubv4.NewBoolean(githubv4.Boolean(data.IsAdminEnforced)),
-		Pattern:                        githubv4.String(data.Pattern),
-		PushActorIDs:                   githubv4NewIDSlice(githubv4IDSlice(data.PushActorIDs)),
-		RepositoryID:                   githubv4.NewID(githubv4.ID(data.RepositoryID)),
-		RequiredApprovingReviewCount:   githubv4.NewInt(githubv4.Int(data.RequiredApprovingReviewCount)),
-		RequiredStatusCheckContexts:    githubv4NewStringSlice(githubv4StringSliceEmpty(data.RequiredStatusCheckContexts)),
-		RequiresApprovingReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresApprovingReviews)),
-		RequiresCodeOwnerReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCodeOwnerReviews)),
-		RequiresCommitSignatures:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCommitSignatures)),
-		RequiresConversationResolution: githubv4.NewBoolean(githubv4.Boolean(data.RequiresConversationResolution)),
-		RequiresLinearHistory:          githubv4.NewBoolean(githubv4.Boolean(data.RequiresLinearHistory)),
-		RequiresStatusChecks:           githubv4.NewBoolean(githubv4.Boolean(data.RequiresStatusChecks)),
-		RequiresStrictStatusChecks:     githubv4.NewBoolean(githubv4.Boolean(data.RequiresStrictStatusChecks)),
-		RestrictsPushes:                githubv4.NewBoolean(githubv4.Boolean(data.RestrictsPushes)),
-		RestrictsReviewDismissals:      githubv4.NewBoolean(githubv4.Boolean(data.RestrictsReviewDismissals)),
-		ReviewDismissalActorIDs:        githubv4NewIDSlice(githubv4IDSlice(data.ReviewDismissalActorIDs)),
-		LockBranch:                     githubv4.NewBoolean(githubv4.Boolean(data.LockBranch)),
-		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
-	}
+	input := branchProtectionCreateInput(data)
 
 	ctx := context.Background()
 	client := meta.(*Owner).v4client
@@ -363,6 +324,15 @@ func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta any) error
 		log.Printf("[DEBUG] Problem setting '%s' in %s %s branch protection (%s)", PROTECTION_LOCK_BRANCH, protection.Repository.Name, protection.Pattern, d.Id())
 	}
 
+	requiresMergeQueue, err := branchProtectionRequiresMergeQueue(ctx, meta, githubv4.ID(protection.Repository.ID), string(protection.Pattern))
+	if err != nil {
+		return err
+	}
+	err = d.Set(PROTECTION_REQUIRES_MERGE_QUEUE, requiresMergeQueue)
+	if err != nil {
+		log.Printf("[DEBUG] Problem setting '%s' in %s %s branch protection (%s)", PROTECTION_REQUIRES_MERGE_QUEUE, protection.Repository.Name, protection.Pattern, d.Id())
+	}
+
 	return nil
 }
 
@@ -379,58 +349,11 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta any) erro
 		return err
 	}
 
-	var reviewIds, pushIds, bypassForcePushIds, bypassPullRequestIds []string
-	reviewIds, err = getActorIds(data.ReviewDismissalActorIDs, meta)
-	if err != nil {
-		return err
-	}
-
-	pushIds, err = getActorIds(data.PushActorIDs, meta)
-	if err != nil {
+	if err = resolveBranchProtectionActorIDs(&data, getActorIds, meta); err != nil {
 		return err
 	}
 
-	bypassForcePushIds, err = getActorIds(data.BypassForcePushActorIDs, meta)
-	if err != nil {
-		return err
-	}
-
-	bypassPullRequestIds, err = getActorIds(data.BypassPullRequestActorIDs, meta)
-	if err != nil {
-		return err
-	}
-
-	data.PushActorIDs = pushIds
-	data.ReviewDismissalActorIDs = reviewIds
-	data.BypassForcePushActorIDs = bypassForcePushIds
-	data.BypassPullRequestActorIDs = bypassPullRequestIds
-
-	input := githubv4.UpdateBranchProtectionRuleInput{
-		BranchProtectionRuleID:         d.Id(),
-		AllowsDeletions:                githubv4.NewBoolean(githubv4.Boolean(data.AllowsDeletions)),
-		AllowsForcePushes:              githubv4.NewBoolean(githubv4.Boolean(data.AllowsForcePushes)),
-		BlocksCreations:                githubv4.NewBoolean(githubv4.Boolean(data.BlocksCreations)),
-		BypassForcePushActorIDs:        githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassForcePushActorIDs)),
-		BypassPullRequestActorIDs:      githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassPullRequestActorIDs)),
-		DismissesStaleReviews:          githubv4.NewBoolean(githubv4.Boolean(data.DismissesStaleReviews)),
-		IsAdminEnforced:                githubv4.NewBoolean(githubv4.Boolean(data.IsAdminEnforced)),
-		Pattern:                        githubv4.NewString(githubv4.String(data.Pattern)),
-		PushActorIDs:                   githubv4NewIDSlice(githubv4IDSlice(data.PushActorIDs)),
-		RequiredApprovingReviewCount:   githubv4.NewInt(githubv4.Int(data.RequiredApprovingReviewCount)),
-		RequiredStatusCheckContexts:    githubv4NewStringSlice(githubv4StringSliceEmpty(data.RequiredStatusCheckContexts)),
-		RequiresApprovingReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresApprovingReviews)),
-		RequiresCodeOwnerReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCodeOwnerReviews)),
-		RequiresCommitSignatures:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCommitSignatures)),
-		RequiresConversationResolution: githubv4.NewBoolean(githubv4.Boolean(data.RequiresConversationResolution)),
-		RequiresLinearHistory:          githubv4.NewBoolean(githubv4.Boolean(data.RequiresLinearHistory)),
-		RequiresStatusChecks:           githubv4.NewBoolean(githubv4.Boolean(data.RequiresStatusChecks)),
-		RequiresStrictStatusChecks:     githubv4.NewBoolean(githubv4.Boolean(data.RequiresStrictStatusChecks)),
-		RestrictsPushes:                githubv4.NewBoolean(githubv4.Boolean(data.RestrictsPushes)),
-		RestrictsReviewDismissals:      githubv4.NewBoolean(githubv4.Boolean(data.RestrictsReviewDismissals)),
-		ReviewDismissalActorIDs:        githubv4NewIDSlice(githubv4IDSlice(data.ReviewDismissalActorIDs)),
-		LockBranch:                     githubv4.NewBoolean(githubv4.Boolean(data.LockBranch)),
-		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
-	}
+	input := branchProtectionUpdateInput(d.Id(), data)
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	client := meta.(*Owner).v4client
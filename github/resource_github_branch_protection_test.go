@@ -2,16 +2,140 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/shurcooL/githubv4"
 )
 
+func TestBranchProtectionRequiresMergeQueue(t *testing.T) {
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.Unmarshal([]byte(mustRead(req.Body)), &body); err != nil {
+			t.Fatalf("unexpected request body: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body.Variables["branch"] {
+		case "main":
+			mustWrite(w, `{"data": {"node": {"mergeQueue": {"id": "MQ_1"}}}}`)
+		case "legacy":
+			mustWrite(w, `{"errors": [{"message": "Field 'mergeQueue' doesn't exist on type 'Repository'"}]}`)
+		case "broken":
+			mustWrite(w, `{"errors": [{"message": "Something went wrong while executing your query."}]}`)
+		default:
+			mustWrite(w, `{"data": {"node": {"mergeQueue": null}}}`)
+		}
+	})
+
+	meta := &Owner{v4client: githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})}
+	ctx := context.Background()
+
+	for pattern, expected := range map[string]bool{
+		"main":      true,
+		"develop":   false,
+		"legacy":    false,
+		"release/*": false,
+	} {
+		requiresMergeQueue, err := branchProtectionRequiresMergeQueue(ctx, meta, "R_1", pattern)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %s", pattern, err)
+		}
+		if requiresMergeQueue != expected {
+			t.Errorf("expected %s to require a merge queue: %t, got %t", pattern, expected, requiresMergeQueue)
+		}
+	}
+	if _, err := branchProtectionRequiresMergeQueue(ctx, meta, "R_1", "broken"); err == nil {
+		t.Error("expected the error reading the merge queue to be returned")
+	}
+	if requests != 4 {
+		t.Errorf("expected patterns with wildcards not to be queried, got %d requests", requests)
+	}
+}
+
+func TestGetNodeIDv4(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.Unmarshal([]byte(mustRead(req.Body)), &body); err != nil {
+			t.Fatalf("unexpected request body: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case body.Variables["slug"] == "team":
+			mustWrite(w, `{"data": {"organization": {"team": {"id": "T_1"}}}}`)
+		case body.Variables["slug"] != nil:
+			mustWrite(w, `{"data": {"organization": {"team": null}}}`)
+		case body.Variables["user"] == "user":
+			mustWrite(w, `{"data": {"user": {"id": "U_1"}}}`)
+		case body.Variables["user"] != nil:
+			mustWrite(w, `{"data": {"user": null}, "errors": [{"message": "Could not resolve to a User with the login of 'typo'."}]}`)
+		case body.Variables["id"] == "A_1":
+			mustWrite(w, `{"data": {"node": {"__typename": "App"}}}`)
+		case body.Variables["id"] == "R_1":
+			mustWrite(w, `{"data": {"node": {"__typename": "Repository"}}}`)
+		default:
+			mustWrite(w, `{"data": {"node": null}}`)
+		}
+	})
+
+	meta := &Owner{name: "org", v4client: githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})}
+
+	for actor, want := range map[string]string{"org/team": "T_1", "/user": "U_1", "A_1": "A_1"} {
+		got, err := getNodeIDv4(actor, meta)
+		if err != nil {
+			t.Errorf("unexpected error resolving actor %s: %s", actor, err)
+		} else if got != want {
+			t.Errorf("expected actor %s to resolve to %s, got %s", actor, want, got)
+		}
+	}
+
+	for actor, want := range map[string]string{
+		"org/typo": `as team "typo" of organization org: team not found`,
+		"/typo":    `as user "typo"`,
+		"typo":     "users must be prefixed with '/' and teams with 'org/'",
+		"R_1":      "node is a Repository",
+	} {
+		_, err := getNodeIDv4(actor, meta)
+		if err == nil {
+			t.Errorf("expected actor %s not to be resolved", actor)
+		} else if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error resolving actor %s to contain %q, got %q", actor, want, err)
+		}
+	}
+}
+
+func TestValidateBranchProtectionActor(t *testing.T) {
+	for _, actor := range []string{"/user", "org/team", "U_kgDOABCDEF", "T_kwDOAB-c_d", "MDQ6VXNlcjE="} {
+		if _, errs := validateBranchProtectionActor(actor, "push_allowances"); len(errs) > 0 {
+			t.Errorf("expected actor %s to be valid, got %v", actor, errs)
+		}
+	}
+
+	for _, actor := range []string{"", "user", "/", "/org/user", "org/", "/team/", "org/team/child", "dXNlcg=="} {
+		if _, errs := validateBranchProtectionActor(actor, "push_allowances"); len(errs) == 0 {
+			t.Errorf("expected actor %q to be invalid", actor)
+		}
+	}
+}
+
 func TestAccGithubBranchProtectionV4(t *testing.T) {
 
 	t.Run("configures default settings when empty", func(t *testing.T) {
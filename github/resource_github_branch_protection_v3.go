@@ -73,6 +73,26 @@ func resourceGithubBranchProtectionV3() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"check": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A status check to require in order to merge into this branch, matching the checks object of the REST API.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"context": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the required check.",
+									},
+									"app_id": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Default:     -1,
+										Description: "The ID of the GitHub App that must provide this check. '-1' allows the check to be provided by any source. Defaults to '-1'.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -234,6 +254,10 @@ func resourceGithubBranchProtectionV3Create(d *schema.ResourceData, meta any) er
 	}
 	ctx := context.Background()
 
+	if err := waitForRepositoryVisible(ctx, meta, repoName); err != nil {
+		return err
+	}
+
 	protection, _, err := client.Repositories.UpdateBranchProtection(ctx,
 		orgName,
 		repoName,
@@ -186,19 +186,95 @@ func TestAccGithubBranchProtectionV3_required_status_checks(t *testing.T) {
 				"github_branch_protection_v3.test", "required_status_checks.#", "1",
 			),
 			resource.TestCheckResourceAttr(
-				"github_branch_protection_v3.test", "required_status_checks.strict", "true",
+				"github_branch_protection_v3.test", "required_status_checks.0.strict", "true",
 			),
 			resource.TestCheckResourceAttr(
-				"github_branch_protection_v3.test", "required_status_checks.checks.#", "3",
+				"github_branch_protection_v3.test", "required_status_checks.0.checks.#", "3",
 			),
-			resource.TestCheckResourceAttr(
-				"github_branch_protection_v3.test", "required_status_checks.checks.0", "github/foo",
+			resource.TestCheckTypeSetElemAttr(
+				"github_branch_protection_v3.test", "required_status_checks.0.checks.*", "github/foo",
 			),
-			resource.TestCheckResourceAttr(
-				"github_branch_protection_v3.test", "required_status_checks.checks.1", "github/bar",
+			resource.TestCheckTypeSetElemAttr(
+				"github_branch_protection_v3.test", "required_status_checks.0.checks.*", "github/bar:-1",
 			),
+			resource.TestCheckTypeSetElemAttr(
+				"github_branch_protection_v3.test", "required_status_checks.0.checks.*", "github:foo:baz:1",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+	t.Run("configures required status check blocks", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+
+			resource "github_repository" "test" {
+			  name      = "tf-acc-test-%s"
+			  auto_init = true
+			}
+
+			resource "github_branch_protection_v3" "test" {
+
+			  repository  = github_repository.test.name
+			  branch      = "main"
+
+			  required_status_checks {
+			    strict = true
+
+			    check {
+			      context = "github/foo"
+			    }
+
+			    check {
+			      context = "github:foo:baz"
+			      app_id  = 1
+			    }
+			  }
+
+			}
+
+	`, randomID)
+
+		check := resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr(
-				"github_branch_protection_v3.test", "required_status_checks.checks.2", "github:foo:baz",
+				"github_branch_protection_v3.test", "required_status_checks.0.check.#", "2",
+			),
+			resource.TestCheckTypeSetElemNestedAttrs(
+				"github_branch_protection_v3.test", "required_status_checks.0.check.*", map[string]string{
+					"context": "github/foo",
+					"app_id":  "-1",
+				},
+			),
+			resource.TestCheckTypeSetElemNestedAttrs(
+				"github_branch_protection_v3.test", "required_status_checks.0.check.*", map[string]string{
+					"context": "github:foo:baz",
+					"app_id":  "1",
+				},
 			),
 		)
 
@@ -44,24 +44,63 @@ func flattenAndSetRequiredStatusChecks(d *schema.ResourceData, protection *githu
 
 	if rsc != nil {
 
+		// Flatten each check into the attribute it is configured with, so that
+		// a drifted context or app_id shows up against that attribute. Checks
+		// that are not configured, e.g. on import, are flattened into blocks.
+		configuredContexts := make(map[string]bool)
+		configuredChecks := make(map[string]string)
+		configuredCheckBlocks := make(map[string]bool)
+		if v, ok := d.GetOk("required_status_checks"); ok {
+			if vL := v.([]any); len(vL) > 0 && vL[0] != nil {
+				m := vL[0].(map[string]any)
+				// TODO: Remove once contexts is fully deprecated.
+				for _, c := range expandNestedSet(m, "contexts") {
+					configuredContexts[c] = true
+				}
+				for _, c := range expandNestedSet(m, "checks") {
+					cContext, _ := splitRequiredStatusCheck(c)
+					configuredChecks[cContext] = c
+				}
+				for _, c := range m["check"].(*schema.Set).List() {
+					configuredCheckBlocks[c.(map[string]any)["context"].(string)] = true
+				}
+			}
+		}
+
 		// Contexts and Checks arrays to flatten into
 		var contexts []any
 		var checks []any
-
-		// TODO: Remove once contexts is fully deprecated.
-		// Flatten contexts
-		for _, c := range *rsc.Contexts {
-			// Parse into contexts
-			contexts = append(contexts, c)
-		}
+		var checkBlocks []any
 
 		// Flatten checks
-		for _, chk := range *rsc.Checks {
-			// Parse into checks
+		for _, chk := range rsc.GetChecks() {
+			// GitHub omits the app_id of checks that any source may provide.
+			appID := int64(-1)
 			if chk.AppID != nil {
-				checks = append(checks, fmt.Sprintf("%s:%d", chk.Context, *chk.AppID))
+				appID = *chk.AppID
+			}
+
+			if configuredCheckBlocks[chk.Context] {
+				checkBlocks = append(checkBlocks, map[string]any{
+					"context": chk.Context,
+					"app_id":  int(appID),
+				})
+			} else if c, ok := configuredChecks[chk.Context]; ok {
+				// Only report an app_id if the configuration pins one, as
+				// GitHub chooses the app of checks configured without one.
+				if _, cAppId := splitRequiredStatusCheck(c); cAppId != "" {
+					checks = append(checks, fmt.Sprintf("%s:%d", chk.Context, appID))
+				} else {
+					checks = append(checks, chk.Context)
+				}
+			} else if configuredContexts[chk.Context] {
+				// TODO: Remove once contexts is fully deprecated.
+				contexts = append(contexts, chk.Context)
 			} else {
-				checks = append(checks, chk.Context)
+				checkBlocks = append(checkBlocks, map[string]any{
+					"context": chk.Context,
+					"app_id":  int(appID),
+				})
 			}
 		}
 
@@ -71,6 +110,7 @@ func flattenAndSetRequiredStatusChecks(d *schema.ResourceData, protection *githu
 				// TODO: Remove once contexts is fully deprecated.
 				"contexts": schema.NewSet(schema.HashString, contexts),
 				"checks":   schema.NewSet(schema.HashString, checks),
+				"check":    checkBlocks,
 			},
 		})
 	}
@@ -78,6 +118,17 @@ func flattenAndSetRequiredStatusChecks(d *schema.ResourceData, protection *githu
 	return d.Set("required_status_checks", []any{})
 }
 
+// splitRequiredStatusCheck splits a string of "context:app_id", allowing for
+// the absence of "app_id".
+func splitRequiredStatusCheck(c string) (string, string) {
+	index := strings.LastIndex(c, ":")
+	if index <= 0 {
+		// If there is no ":" or it's in the first position, there is no app_id.
+		return c, ""
+	}
+	return c[:index], c[index+1:]
+}
+
 func requireSignedCommitsRead(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
@@ -277,14 +328,7 @@ func expandRequiredStatusChecks(d *schema.ResourceData) (*github.RequiredStatusC
 			for _, c := range checks {
 
 				// Expect a string of "context:app_id", allowing for the absence of "app_id"
-				index := strings.LastIndex(c, ":")
-				var cContext, cAppId string
-				if index <= 0 {
-					// If there is no ":" or it's in the first position, there is no app_id.
-					cContext, cAppId = c, ""
-				} else {
-					cContext, cAppId = c[:index], c[index+1:]
-				}
+				cContext, cAppId := splitRequiredStatusCheck(c)
 
 				var rscCheck *github.RequiredStatusCheck
 				if cAppId != "" {
@@ -303,7 +347,18 @@ func expandRequiredStatusChecks(d *schema.ResourceData) (*github.RequiredStatusC
 				// Append
 				rscChecks = append(rscChecks, rscCheck)
 			}
-			// Assign after looping both checks and contexts
+
+			// Iterate and parse check blocks, an app_id of -1 allows the check
+			// to be provided by any source.
+			for _, v := range m["check"].(*schema.Set).List() {
+				c := v.(map[string]any)
+				appID := int64(c["app_id"].(int))
+				rscChecks = append(rscChecks, &github.RequiredStatusCheck{
+					Context: c["context"].(string),
+					AppID:   &appID,
+				})
+			}
+			// Assign after looping checks, check blocks and contexts
 			rsc.Checks = &rscChecks
 		}
 		return rsc, nil
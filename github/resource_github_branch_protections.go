@@ -0,0 +1,370 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+// branchProtectionRulesBatchSize is the number of mutations of branch
+// protection rules sent in a single GraphQL request.
+const branchProtectionRulesBatchSize = 20
+
+func resourceGithubBranchProtections() *schema.Resource {
+	return &schema.Resource{
+		Description: "Protects several branch patterns of a GitHub repository at once.",
+
+		Schema: map[string]*schema.Schema{
+			REPOSITORY_ID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or node ID of the repository associated with the branch protection rules.",
+			},
+			"rule": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The branch protection rules, each identified by its pattern. Rules of the repository with other patterns are left unchanged.",
+				Elem: &schema.Resource{
+					Schema: branchProtectionRuleSchema(),
+				},
+			},
+		},
+
+		Create: resourceGithubBranchProtectionsCreateOrUpdate,
+		Read:   resourceGithubBranchProtectionsRead,
+		Update: resourceGithubBranchProtectionsCreateOrUpdate,
+		Delete: resourceGithubBranchProtectionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubBranchProtectionsImport,
+		},
+	}
+}
+
+// branchProtectionRuleSchema returns the settings of a rule of
+// github_branch_protections, which are those of github_branch_protection.
+// Computed attributes would change the hash of the rules.
+func branchProtectionRuleSchema() map[string]*schema.Schema {
+	s := resourceGithubBranchProtection().Schema
+	delete(s, REPOSITORY_ID)
+	delete(s, PROTECTION_REQUIRES_MERGE_QUEUE)
+	return s
+}
+
+// listGithubBranchProtectionRules returns the branch protection rules of the
+// repository keyed by their pattern.
+func listGithubBranchProtectionRules(ctx context.Context, meta any, repoID githubv4.ID) (map[string]BranchProtectionRule, error) {
+	var query struct {
+		Node struct {
+			Repository struct {
+				BranchProtectionRules struct {
+					Nodes    []BranchProtectionRule
+					PageInfo PageInfo
+				} `graphql:"branchProtectionRules(first: $first, after: $cursor)"`
+			} `graphql:"... on Repository"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id":     repoID,
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	client := meta.(*Owner).v4client
+	rules := make(map[string]BranchProtectionRule)
+	for {
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		for _, rule := range query.Node.Repository.BranchProtectionRules.Nodes {
+			rules[string(rule.Pattern)] = rule
+		}
+
+		if !query.Node.Repository.BranchProtectionRules.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.Repository.BranchProtectionRules.PageInfo.EndCursor)
+	}
+
+	return rules, nil
+}
+
+// branchProtectionRuleMutation is a mutation of a branch protection rule, e.g.
+// 'createBranchProtectionRule', with its input.
+type branchProtectionRuleMutation struct {
+	name  string
+	input githubv4.Input
+}
+
+// mutateBranchProtectionRules sends the mutations in batches, each as an
+// aliased field of a single GraphQL request. The fields of a mutation are run
+// in order, so deletions listed first free their patterns for the others.
+func mutateBranchProtectionRules(ctx context.Context, client *githubv4.Client, mutations []branchProtectionRuleMutation) error {
+	type payload struct {
+		ClientMutationID *githubv4.String `graphql:"clientMutationId"`
+	}
+
+	for start := 0; start < len(mutations); start += branchProtectionRulesBatchSize {
+		batch := mutations[start:min(start+branchProtectionRulesBatchSize, len(mutations))]
+
+		fields := make([]reflect.StructField, 0, len(batch))
+		variables := make(map[string]any, len(batch))
+		for i, mutation := range batch {
+			variable := "input"
+			if i > 0 {
+				variable = fmt.Sprintf("input%d", i)
+			}
+			fields = append(fields, reflect.StructField{
+				Name: fmt.Sprintf("M%d", i),
+				Type: reflect.TypeOf(payload{}),
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"m%d: %s(input: $%s)"`, i, mutation.name, variable)),
+			})
+			variables[variable] = mutation.input
+		}
+
+		input := variables["input"].(githubv4.Input)
+		delete(variables, "input")
+		m := reflect.New(reflect.StructOf(fields)).Interface()
+		if err := client.Mutate(ctx, m, input, variables); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenBranchProtectionRule returns a rule of github_branch_protections from
+// a branch protection rule, naming its actors like the rule of the state.
+func flattenBranchProtectionRule(protection BranchProtectionRule, data BranchProtectionResourceData, meta any) map[string]any {
+	approvingReviews := make([]any, 0)
+	if protection.RequiresApprovingReviews {
+		approvingReviews = append(approvingReviews, map[string]any{
+			PROTECTION_REQUIRED_APPROVING_REVIEW_COUNT: int(protection.RequiredApprovingReviewCount),
+			PROTECTION_REQUIRES_CODE_OWNER_REVIEWS:     bool(protection.RequiresCodeOwnerReviews),
+			PROTECTION_DISMISSES_STALE_REVIEWS:         bool(protection.DismissesStaleReviews),
+			PROTECTION_RESTRICTS_REVIEW_DISMISSALS:     bool(protection.RestrictsReviewDismissals),
+			PROTECTION_REVIEW_DISMISSAL_ALLOWANCES:     setDismissalActorIDs(protection.ReviewDismissalAllowances.Nodes, data, meta),
+			PROTECTION_PULL_REQUESTS_BYPASSERS:         setBypassPullRequestActorIDs(protection.BypassPullRequestAllowances.Nodes, data, meta),
+			PROTECTION_REQUIRE_LAST_PUSH_APPROVAL:      bool(protection.RequireLastPushApproval),
+		})
+	}
+
+	statusChecks := make([]any, 0)
+	if protection.RequiresStatusChecks {
+		contexts := make([]string, 0, len(protection.RequiredStatusCheckContexts))
+		for _, statusContext := range protection.RequiredStatusCheckContexts {
+			contexts = append(contexts, string(statusContext))
+		}
+		statusChecks = append(statusChecks, map[string]any{
+			PROTECTION_REQUIRES_STRICT_STATUS_CHECKS:  bool(protection.RequiresStrictStatusChecks),
+			PROTECTION_REQUIRED_STATUS_CHECK_CONTEXTS: contexts,
+		})
+	}
+
+	restrictsPushes := make([]any, 0)
+	if protection.RestrictsPushes {
+		restrictsPushes = append(restrictsPushes, map[string]any{
+			PROTECTION_BLOCKS_CREATIONS: bool(protection.BlocksCreations),
+			PROTECTION_PUSH_ALLOWANCES:  setPushActorIDs(protection.PushAllowances.Nodes, data, meta),
+		})
+	}
+
+	forcePushBypassers := make([]string, 0)
+	if !protection.AllowsForcePushes {
+		forcePushBypassers = setBypassForcePushActorIDs(protection.BypassForcePushAllowances.Nodes, data, meta)
+	}
+
+	return map[string]any{
+		PROTECTION_PATTERN:                          string(protection.Pattern),
+		PROTECTION_ALLOWS_DELETIONS:                 bool(protection.AllowsDeletions),
+		PROTECTION_ALLOWS_FORCE_PUSHES:              bool(protection.AllowsForcePushes),
+		PROTECTION_IS_ADMIN_ENFORCED:                bool(protection.IsAdminEnforced),
+		PROTECTION_REQUIRES_COMMIT_SIGNATURES:       bool(protection.RequiresCommitSignatures),
+		PROTECTION_REQUIRES_LINEAR_HISTORY:          bool(protection.RequiresLinearHistory),
+		PROTECTION_REQUIRES_CONVERSATION_RESOLUTION: bool(protection.RequiresConversationResolution),
+		PROTECTION_LOCK_BRANCH:                      bool(protection.LockBranch),
+		PROTECTION_REQUIRES_APPROVING_REVIEWS:       approvingReviews,
+		PROTECTION_REQUIRES_STATUS_CHECKS:           statusChecks,
+		PROTECTION_RESTRICTS_PUSHES:                 restrictsPushes,
+		PROTECTION_FORCE_PUSHES_BYPASSERS:           forcePushBypassers,
+	}
+}
+
+func resourceGithubBranchProtectionsCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	ctx := context.Background()
+	if d.IsNewResource() {
+		if err := waitForRepositoryIDVisible(ctx, meta, d.Get(REPOSITORY_ID).(string)); err != nil {
+			return err
+		}
+	} else {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoID, err := getRepositoryID(d.Get(REPOSITORY_ID).(string), meta)
+	if err != nil {
+		return err
+	}
+	existing, err := listGithubBranchProtectionRules(ctx, meta, repoID)
+	if err != nil {
+		return err
+	}
+
+	// Actors shared by several rules are only resolved once.
+	actorIDs := make(map[string]string)
+	getCachedActorIds := func(actors []string, meta any) ([]string, error) {
+		ids := make([]string, 0, len(actors))
+		for _, actor := range actors {
+			if _, ok := actorIDs[actor]; !ok {
+				id, err := getNodeIDv4(actor, meta)
+				if err != nil {
+					return nil, err
+				}
+				actorIDs[actor] = id
+			}
+			ids = append(ids, actorIDs[actor])
+		}
+		return ids, nil
+	}
+
+	o, n := d.GetChange("rule")
+	oldRules, newRules := o.(*schema.Set), n.(*schema.Set)
+
+	patterns := make(map[string]bool)
+	for _, rule := range newRules.List() {
+		patterns[rule.(map[string]any)[PROTECTION_PATTERN].(string)] = true
+	}
+
+	var deletions, updates, creations []branchProtectionRuleMutation
+	for _, rule := range oldRules.List() {
+		pattern := rule.(map[string]any)[PROTECTION_PATTERN].(string)
+		if protection, ok := existing[pattern]; ok && !patterns[pattern] {
+			log.Printf("[DEBUG] Deleting branch protection rule %s of repository %s", pattern, repoID)
+			deletions = append(deletions, branchProtectionRuleMutation{
+				name:  "deleteBranchProtectionRule",
+				input: githubv4.DeleteBranchProtectionRuleInput{BranchProtectionRuleID: protection.ID},
+			})
+		}
+	}
+
+	for _, rule := range newRules.List() {
+		protection, exists := existing[rule.(map[string]any)[PROTECTION_PATTERN].(string)]
+		if exists && oldRules.Contains(rule) {
+			continue
+		}
+
+		data, err := branchProtectionResourceData(branchProtectionRuleMap(rule.(map[string]any)), meta)
+		if err != nil {
+			return err
+		}
+		if err = resolveBranchProtectionActorIDs(&data, getCachedActorIds, meta); err != nil {
+			return err
+		}
+
+		if exists {
+			log.Printf("[DEBUG] Updating branch protection rule %s of repository %s", data.Pattern, repoID)
+			updates = append(updates, branchProtectionRuleMutation{
+				name:  "updateBranchProtectionRule",
+				input: branchProtectionUpdateInput(fmt.Sprintf("%s", protection.ID), data),
+			})
+		} else {
+			log.Printf("[DEBUG] Creating branch protection rule %s of repository %s", data.Pattern, repoID)
+			data.RepositoryID = fmt.Sprintf("%s", repoID)
+			creations = append(creations, branchProtectionRuleMutation{
+				name:  "createBranchProtectionRule",
+				input: branchProtectionCreateInput(data),
+			})
+		}
+	}
+
+	mutations := append(append(deletions, updates...), creations...)
+	if err = mutateBranchProtectionRules(ctx, meta.(*Owner).v4client, mutations); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s", repoID))
+	return resourceGithubBranchProtectionsRead(d, meta)
+}
+
+func resourceGithubBranchProtectionsRead(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	existing, err := listGithubBranchProtectionRules(ctx, meta, githubv4.ID(d.Id()))
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing branch protections (%s) from state because the repository no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Only the rules of the state are managed, all the rules are read on
+	// import.
+	current := make(map[string]map[string]any)
+	for _, rule := range d.Get("rule").(*schema.Set).List() {
+		current[rule.(map[string]any)[PROTECTION_PATTERN].(string)] = rule.(map[string]any)
+	}
+
+	rules := make([]any, 0, len(existing))
+	for pattern, protection := range existing {
+		rule, ok := current[pattern]
+		if !ok && len(current) > 0 {
+			continue
+		}
+
+		data, err := branchProtectionResourceDataActors(branchProtectionRuleMap(rule), meta)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, flattenBranchProtectionRule(protection, data, meta))
+	}
+
+	if err = d.Set("rule", rules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubBranchProtectionsDelete(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	existing, err := listGithubBranchProtectionRules(ctx, meta, githubv4.ID(d.Id()))
+	if err != nil {
+		return err
+	}
+
+	var deletions []branchProtectionRuleMutation
+	for _, rule := range d.Get("rule").(*schema.Set).List() {
+		if protection, ok := existing[rule.(map[string]any)[PROTECTION_PATTERN].(string)]; ok {
+			deletions = append(deletions, branchProtectionRuleMutation{
+				name:  "deleteBranchProtectionRule",
+				input: githubv4.DeleteBranchProtectionRuleInput{BranchProtectionRuleID: protection.ID},
+			})
+		}
+	}
+
+	return mutateBranchProtectionRules(ctx, meta.(*Owner).v4client, deletions)
+}
+
+func resourceGithubBranchProtectionsImport(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	repoName := d.Id()
+	repoID, err := getRepositoryID(repoName, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s", repoID))
+	if err = d.Set(REPOSITORY_ID, repoName); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, resourceGithubBranchProtectionsRead(d, meta)
+}
@@ -0,0 +1,184 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestBranchProtectionRuleMapGetOk(t *testing.T) {
+	rule := branchProtectionRuleMap{
+		PROTECTION_PATTERN:                    "main",
+		PROTECTION_ALLOWS_DELETIONS:           false,
+		PROTECTION_IS_ADMIN_ENFORCED:          true,
+		PROTECTION_REQUIRES_APPROVING_REVIEWS: []any{},
+		PROTECTION_FORCE_PUSHES_BYPASSERS:     schema.NewSet(schema.HashString, []any{"/octocat"}),
+	}
+
+	testCases := map[string]bool{
+		PROTECTION_PATTERN:                    true,
+		PROTECTION_ALLOWS_DELETIONS:           false,
+		PROTECTION_IS_ADMIN_ENFORCED:          true,
+		PROTECTION_REQUIRES_APPROVING_REVIEWS: false,
+		PROTECTION_FORCE_PUSHES_BYPASSERS:     true,
+		REPOSITORY_ID:                         false,
+	}
+
+	for key, expected := range testCases {
+		if _, ok := rule.GetOk(key); ok != expected {
+			t.Errorf("%s: expected ok=%v, got %v", key, expected, ok)
+		}
+	}
+}
+
+func TestMutateBranchProtectionRules(t *testing.T) {
+	var requests []map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		if err := json.Unmarshal([]byte(mustRead(req.Body)), &body); err != nil {
+			t.Fatalf("unexpected request body: %s", err)
+		}
+		requests = append(requests, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {}}`)
+	})
+
+	client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+
+	mutations := []branchProtectionRuleMutation{
+		{name: "deleteBranchProtectionRule", input: githubv4.DeleteBranchProtectionRuleInput{BranchProtectionRuleID: "BPR_1"}},
+	}
+	for i := 0; i < branchProtectionRulesBatchSize; i++ {
+		mutations = append(mutations, branchProtectionRuleMutation{
+			name:  "createBranchProtectionRule",
+			input: branchProtectionCreateInput(BranchProtectionResourceData{RepositoryID: "R_1", Pattern: fmt.Sprintf("release/%d", i)}),
+		})
+	}
+
+	if err := mutateBranchProtectionRules(context.Background(), client, mutations); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	query := requests[0]["query"].(string)
+	for _, field := range []string{
+		"m0: deleteBranchProtectionRule(input: $input)",
+		"m1: createBranchProtectionRule(input: $input1)",
+		fmt.Sprintf("m%d: createBranchProtectionRule(input: $input%d)", branchProtectionRulesBatchSize-1, branchProtectionRulesBatchSize-1),
+	} {
+		if !strings.Contains(query, field) {
+			t.Errorf("expected the first request to contain %q, got %s", field, query)
+		}
+	}
+	if variables := requests[0]["variables"].(map[string]any); len(variables) != branchProtectionRulesBatchSize {
+		t.Errorf("expected %d variables, got %d", branchProtectionRulesBatchSize, len(variables))
+	}
+	if query := requests[1]["query"].(string); !strings.Contains(query, "m0: createBranchProtectionRule(input: $input)") || strings.Contains(query, "m1:") {
+		t.Errorf("expected the second request to contain the last mutation only, got %s", query)
+	}
+}
+
+func TestAccGithubBranchProtections(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("protects several patterns of a repository", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_branch_protections" "test" {
+				repository_id = github_repository.test.node_id
+
+				rule {
+					pattern          = "main"
+					enforce_admins   = true
+					allows_deletions = false
+
+					required_pull_request_reviews {
+						required_approving_review_count = 1
+					}
+				}
+
+				rule {
+					pattern = "%s"
+
+					required_status_checks {
+						strict   = true
+						contexts = ["ci"]
+					}
+				}
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_branch_protections.test", "rule.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("github_branch_protections.test", "rule.*", map[string]string{
+					"pattern":        "main",
+					"enforce_admins": "true",
+					"required_pull_request_reviews.0.required_approving_review_count": "1",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("github_branch_protections.test", "rule.*", map[string]string{
+					"pattern":                             "release/*",
+					"required_status_checks.0.strict":     "true",
+					"required_status_checks.0.contexts.#": "1",
+				}),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_branch_protections.test", "rule.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("github_branch_protections.test", "rule.*", map[string]string{
+					"pattern": "hotfix/*",
+				}),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "release/*"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "hotfix/*"),
+						Check:  checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
@@ -1,10 +1,14 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -210,3 +214,40 @@ func TestAccGithubBranch(t *testing.T) {
 	})
 
 }
+
+func TestGetTagCommitSHA(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/git/ref/tags/{tag}", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.PathValue("tag") {
+		case "v1.0.0":
+			mustWrite(w, `{"ref": "refs/tags/v1.0.0", "object": {"type": "commit", "sha": "c0ffee"}}`)
+		case "v1.1.0":
+			mustWrite(w, `{"ref": "refs/tags/v1.1.0", "object": {"type": "tag", "sha": "7a9"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			mustWrite(w, `{"message": "Not Found"}`)
+		}
+	})
+	mux.HandleFunc("GET /repos/acme/app/git/tags/7a9", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"tag": "v1.1.0", "object": {"type": "commit", "sha": "decaf"}}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+
+	for tag, expected := range map[string]string{"v1.0.0": "c0ffee", "v1.1.0": "decaf"} {
+		sha, err := getTagCommitSHA(context.Background(), client, "acme", "app", tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sha != expected {
+			t.Errorf("expected %s to point to %s, got %s", tag, expected, sha)
+		}
+	}
+
+	if _, err := getTagCommitSHA(context.Background(), client, "acme", "app", "v2.0.0"); err == nil {
+		t.Error("expected an error for a missing tag")
+	}
+}
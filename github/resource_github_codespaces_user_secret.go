@@ -8,15 +8,14 @@ import (
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubCodespacesUserSecret() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates and manages an Codespaces Secret within a GitHub user",
-		Create:      resourceGithubCodespacesUserSecretCreateOrUpdate,
+		Create:      resourceGithubCodespacesUserSecretCreate,
 		Read:        resourceGithubCodespacesUserSecretRead,
-		Update:      resourceGithubCodespacesUserSecretCreateOrUpdate,
+		Update:      resourceGithubCodespacesUserSecretUpdate,
 		Delete:      resourceGithubCodespacesUserSecretDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -42,15 +41,16 @@ func resourceGithubCodespacesUserSecret() *schema.Resource {
 				Sensitive:        true,
 				ConflictsWith:    []string{"plaintext_value"},
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
-				ValidateDiagFunc: toDiagFunc(validation.StringIsBase64, "encrypted_value"),
+				ValidateDiagFunc: validateEncryptedSecretValueFunc,
 			},
 			"plaintext_value": {
-				Type:          schema.TypeString,
-				ForceNew:      true,
-				Optional:      true,
-				Sensitive:     true,
-				Description:   "Plaintext value of the secret to be encrypted.",
-				ConflictsWith: []string{"encrypted_value"},
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Plaintext value of the secret to be encrypted.",
+				ConflictsWith:    []string{"encrypted_value"},
+				ValidateDiagFunc: validateSecretValueFunc,
 			},
 			"selected_repository_ids": {
 				Type: schema.TypeSet,
@@ -75,7 +75,7 @@ func resourceGithubCodespacesUserSecret() *schema.Resource {
 	}
 }
 
-func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, meta any) error {
+func resourceGithubCodespacesUserSecretCreate(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 	ctx := context.Background()
 
@@ -129,13 +129,13 @@ func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, me
 
 func resourceGithubCodespacesUserSecretRead(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	secret, _, err := client.Codespaces.GetUserSecret(ctx, d.Id())
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotFound {
-				log.Printf("[WARN] Removing actions secret %s from state because it no longer exists in GitHub",
+				log.Printf("[WARN] Removing codespaces user secret %s from state because it no longer exists in GitHub",
 					d.Id())
 				d.SetId("")
 				return nil
@@ -206,6 +206,34 @@ func resourceGithubCodespacesUserSecretRead(d *schema.ResourceData, meta any) er
 	return nil
 }
 
+func resourceGithubCodespacesUserSecretUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// The value of the secret forces a new resource, only the selected
+	// repositories are updated so that an imported secret keeps its value.
+	selectedRepositoryIDs := github.SelectedRepoIDs{}
+	for _, id := range d.Get("selected_repository_ids").(*schema.Set).List() {
+		selectedRepositoryIDs = append(selectedRepositoryIDs, int64(id.(int)))
+	}
+
+	_, err := client.Codespaces.SetSelectedReposForUserSecret(ctx, d.Id(), selectedRepositoryIDs)
+	if err != nil {
+		return err
+	}
+
+	// Keep the drift detection from mistaking this update for an external one.
+	secret, _, err := client.Codespaces.GetUserSecret(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if err = d.Set("updated_at", secret.UpdatedAt.String()); err != nil {
+		return err
+	}
+
+	return resourceGithubCodespacesUserSecretRead(d, meta)
+}
+
 func resourceGithubCodespacesUserSecretDelete(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -89,6 +90,73 @@ func TestAccGithubCodespacesUserSecret(t *testing.T) {
 		})
 	})
 
+	t.Run("updates selected repositories without error", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		secretValue := base64.StdEncoding.EncodeToString([]byte("super_secret_value"))
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test_1" {
+			  name = "tf-acc-test-codespaces-1-%[1]s"
+			}
+
+			resource "github_repository" "test_2" {
+			  name = "tf-acc-test-codespaces-2-%[1]s"
+			}
+
+			resource "github_codespaces_user_secret" "test_secret" {
+			  secret_name             = "test_selected_secret_%[1]s"
+			  plaintext_value         = "%[2]s"
+			  selected_repository_ids = [github_repository.test_1.repo_id]
+			}
+		`, randomID, secretValue)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_codespaces_user_secret.test_secret", "selected_repository_ids.#",
+					"1",
+				),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"github_codespaces_user_secret.test_secret", "selected_repository_ids.#",
+					"2",
+				),
+				resource.TestCheckResourceAttr(
+					"github_codespaces_user_secret.test_secret", "plaintext_value",
+					secretValue,
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  checks["before"],
+					},
+					{
+						Config: strings.Replace(config,
+							"[github_repository.test_1.repo_id]",
+							"[github_repository.test_1.repo_id, github_repository.test_2.repo_id]", 1),
+						Check: checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+	})
+
 	t.Run("deletes secrets without error", func(t *testing.T) {
 		config := `
 				resource "github_codespaces_user_secret" "plaintext_secret" {
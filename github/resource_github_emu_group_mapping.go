@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"strconv"
 
 	"github.com/google/go-github/v74/github"
@@ -16,11 +18,19 @@ func resourceGithubEMUGroupMapping() *schema.Resource {
 		Read:        resourceGithubEMUGroupMappingRead,
 		Update:      resourceGithubEMUGroupMappingUpdate,
 		Delete:      resourceGithubEMUGroupMappingDelete,
+
+		CustomizeDiff: resourceGithubEMUGroupMappingDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				// The mapping is imported either by the slug of the team, or
+				// by the ID of the external group connected to a single team.
 				id, err := strconv.Atoi(d.Id())
 				if err != nil {
-					return nil, err
+					if err := d.Set("team_slug", d.Id()); err != nil {
+						return nil, err
+					}
+					d.SetId(fmt.Sprintf("teams/%s/external-groups", d.Id()))
+					return []*schema.ResourceData{d}, nil
 				}
 				if err := d.Set("group_id", id); err != nil {
 					return nil, err
@@ -49,9 +59,18 @@ func resourceGithubEMUGroupMapping() *schema.Resource {
 				Description: "Slug of the GitHub team.",
 			},
 			"group_id": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Integer corresponding to the external group ID to be linked.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "group_name"},
+				Description:  "Integer corresponding to the external group ID to be linked.",
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"group_id", "group_name"},
+				Description:  "The name of the external group to be linked, looked up among the external groups of the organization instead of 'group_id'.",
 			},
 			"etag": {
 				Type:     schema.TypeString,
@@ -72,39 +91,40 @@ func resourceGithubEMUGroupMappingRead(d *schema.ResourceData, meta any) error {
 	}
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-
-	id, ok := d.GetOk("group_id")
-	if !ok {
-		return fmt.Errorf("could not get group id from provided value")
-	}
-	id64, err := getInt64FromInterface(id)
-	if err != nil {
-		return err
-	}
+	teamSlug := d.Get("team_slug").(string)
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
-	group, resp, err := client.Teams.GetExternalGroup(ctx, orgName, id64)
+	// The group is read from the team, so that a team connected to another
+	// group outside of Terraform shows as a change of group_id.
+	groups, resp, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, orgName, teamSlug)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			// If the group is not found, remove it from state
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing external group mapping %s/%s from state because the team no longer exists in GitHub",
+				orgName, teamSlug)
 			d.SetId("")
 			return nil
 		}
 		return err
 	}
 
-	if len(group.Teams) < 1 {
-		// if there's not a team linked, that means it was removed outside of terraform
+	if len(groups.Groups) < 1 {
+		// if there's not a group linked, that means it was removed outside of terraform
 		// and we should remove it from our state
+		log.Printf("[INFO] Removing external group mapping %s/%s from state because the team is no longer connected to a group",
+			orgName, teamSlug)
 		d.SetId("")
 		return nil
 	}
 
+	group := groups.Groups[0]
 	if err = d.Set("etag", resp.Header.Get("ETag")); err != nil {
 		return err
 	}
-	if err = d.Set("group_id", int(*group.GroupID)); err != nil {
+	if err = d.Set("group_id", int(group.GetGroupID())); err != nil {
+		return err
+	}
+	if err = d.Set("group_name", group.GetGroupName()); err != nil {
 		return err
 	}
 	return nil
@@ -124,15 +144,30 @@ func resourceGithubEMUGroupMappingUpdate(d *schema.ResourceData, meta any) error
 		return fmt.Errorf("could not get team slug from provided value")
 	}
 
-	id, ok := d.GetOk("group_id")
-	if !ok {
-		return fmt.Errorf("could not get group id from provided value")
+	var id64 int64
+	if groupName, ok := d.GetOk("group_name"); ok && d.GetRawConfig().GetAttr("group_id").IsNull() {
+		id64, err = lookupExternalGroupID(ctx, client, orgName, groupName.(string))
+	} else {
+		id, ok := d.GetOk("group_id")
+		if !ok {
+			return fmt.Errorf("could not get group id from provided value")
+		}
+		id64, err = getInt64FromInterface(id)
 	}
-	id64, err := getInt64FromInterface(id)
 	if err != nil {
 		return err
 	}
 
+	// A team is connected to at most one group, the team previously mapped
+	// to the group is disconnected when the mapping moves to another team.
+	if oldSlug, _ := d.GetChange("team_slug"); !d.IsNewResource() && oldSlug.(string) != "" && oldSlug.(string) != teamSlug.(string) {
+		log.Printf("[DEBUG] Disconnecting team %s/%s from external group %d", orgName, oldSlug, id64)
+		resp, err := client.Teams.RemoveConnectedExternalGroup(ctx, orgName, oldSlug.(string))
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return err
+		}
+	}
+
 	eg := &github.ExternalGroup{
 		GroupID: &id64,
 	}
@@ -146,6 +181,51 @@ func resourceGithubEMUGroupMappingUpdate(d *schema.ResourceData, meta any) error
 	return resourceGithubEMUGroupMappingRead(d, meta)
 }
 
+// lookupExternalGroupID returns the ID of the external group of the
+// organization with the given name.
+func lookupExternalGroupID(ctx context.Context, client *github.Client, orgName, groupName string) (int64, error) {
+	opts := &github.ListExternalGroupsOptions{
+		DisplayName: github.Ptr(groupName),
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for {
+		groups, resp, err := client.Teams.ListExternalGroups(ctx, orgName, opts)
+		if err != nil {
+			return 0, err
+		}
+		// The display name filter matches names containing it.
+		for _, group := range groups.Groups {
+			if group.GetGroupName() == groupName {
+				return group.GetGroupID(), nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return 0, fmt.Errorf("external group %q not found in organization %s", groupName, orgName)
+}
+
+// resourceGithubEMUGroupMappingDiff plans the lookup of the ID of a group
+// configured by name, or of the name of a group configured by ID, when it
+// changes.
+func resourceGithubEMUGroupMappingDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	if d.HasChange("group_name") && config.GetAttr("group_id").IsNull() {
+		return d.SetNewComputed("group_id")
+	}
+	if d.HasChange("group_id") && config.GetAttr("group_name").IsNull() {
+		return d.SetNewComputed("group_name")
+	}
+	return nil
+}
+
 func resourceGithubEMUGroupMappingDelete(d *schema.ResourceData, meta any) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -162,10 +242,7 @@ func resourceGithubEMUGroupMappingDelete(d *schema.ResourceData, meta any) error
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	_, err = client.Teams.RemoveConnectedExternalGroup(ctx, orgName, teamSlug.(string))
-	if err != nil {
-		return err
-	}
-	return nil
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "external group mapping %s/%s", orgName, teamSlug)
 }
 
 func getInt64FromInterface(val any) (int64, error) {
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubEMUGroupMappingRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/org/teams/connected/external-groups", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"groups": [{"group_id": 456, "group_name": "platform"}]}`)
+	})
+	mux.HandleFunc("GET /orgs/org/teams/disconnected/external-groups", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"groups": []}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "org", IsOrganization: true}
+
+	r := resourceGithubEMUGroupMapping()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"team_slug": "connected", "group_id": 123})
+	d.SetId("teams/connected/external-groups")
+	if err := resourceGithubEMUGroupMappingRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("group_id").(int) != 456 || d.Get("group_name").(string) != "platform" {
+		t.Errorf("expected the group connected to the team to be read, got %v %v", d.Get("group_id"), d.Get("group_name"))
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{"team_slug": "disconnected", "group_id": 123})
+	d.SetId("teams/disconnected/external-groups")
+	if err := resourceGithubEMUGroupMappingRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected a disconnected team to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func TestLookupExternalGroupID(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/org/external-groups", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("display_name") == "" {
+			t.Error("expected the groups to be filtered by name")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"groups": [{"group_id": 455, "group_name": "platform-admins"}, {"group_id": 456, "group_name": "platform"}]}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+
+	id, err := lookupExternalGroupID(context.Background(), client, "org", "platform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 456 {
+		t.Errorf("expected the group named exactly platform, got %d", id)
+	}
+
+	if _, err := lookupExternalGroupID(context.Background(), client, "org", "platform-ops"); err == nil {
+		t.Error("expected an error for an unknown group")
+	}
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"errors"
+	"log"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +44,7 @@ func resourceGithubActionsEnterprisePermissions() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Sets the actions that are allowed in an enterprise. Only available when 'allowed_actions' = 'selected'",
+				Description: "Sets the actions that are allowed in an enterprise. Only available when 'allowed_actions' = 'selected'. The actions allowed in the enterprise are left unchanged when not set.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"github_owned_allowed": {
@@ -113,8 +114,7 @@ func resourceGithubActionsEnterpriseAllowedObject(d *schema.ResourceData) (*gith
 
 		allowed.PatternsAllowed = patternsAllowed
 	} else {
-		return &github.ActionsAllowed{},
-			errors.New("the allowed_actions_config {} block must be specified if allowed_actions == 'selected'")
+		return nil, nil
 	}
 
 	return allowed, nil
@@ -165,11 +165,16 @@ func resourceGithubActionsEnterprisePermissionsCreateOrUpdate(d *schema.Resource
 		if err != nil {
 			return err
 		}
-		_, _, err = client.Actions.EditActionsAllowedInEnterprise(ctx,
-			enterpriseId,
-			*actionsAllowedData)
-		if err != nil {
-			return err
+		if actionsAllowedData != nil {
+			log.Printf("[DEBUG] Allowed actions config is set")
+			_, _, err = client.Actions.EditActionsAllowedInEnterprise(ctx,
+				enterpriseId,
+				*actionsAllowedData)
+			if err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[DEBUG] Allowed actions config not set, skipping")
 		}
 	}
 
@@ -199,7 +204,15 @@ func resourceGithubActionsEnterprisePermissionsRead(d *schema.ResourceData, meta
 		return err
 	}
 
-	if actionsPermissions.GetAllowedActions() == "selected" {
+	// As with github_actions_organization_permissions, allowed_actions_config
+	// is only read when it is also set in the configuration, or on import.
+	allowedActions := d.Get("allowed_actions").(string)
+	allowedActionsConfig := d.Get("allowed_actions_config").([]any)
+
+	serverHasAllowedActionsConfig := actionsPermissions.GetAllowedActions() == "selected"
+	userWantsAllowedActionsConfig := (allowedActions == "selected" && len(allowedActionsConfig) > 0) || allowedActions == ""
+
+	if serverHasAllowedActionsConfig && userWantsAllowedActionsConfig {
 		actionsAllowed, _, err := client.Actions.GetActionsAllowedInEnterprise(ctx, d.Id())
 		if err != nil {
 			return err
@@ -56,6 +56,49 @@ func TestAccGithubActionsEnterprisePermissions(t *testing.T) {
 		})
 	})
 
+	t.Run("test setting of selected actions without allowed actions config", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_enterprise_actions_permissions" "test" {
+				enterprise_slug = "%s"
+				allowed_actions = "selected"
+				enabled_organizations = "all"
+			}
+		`, testEnterprise)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_enterprise_actions_permissions.test", "allowed_actions", "selected",
+			),
+			resource.TestCheckResourceAttr(
+				"github_enterprise_actions_permissions.test", "allowed_actions_config.#", "0",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an enterprise account", func(t *testing.T) {
+			if isEnterprise != "true" {
+				t.Skip("Skipping because `ENTERPRISE_ACCOUNT` is not set or set to false")
+			}
+			if testEnterprise == "" {
+				t.Skip("Skipping because `ENTERPRISE_SLUG` is not set")
+			}
+			testCase(t, enterprise)
+		})
+	})
+
 	t.Run("imports entire set of github action enterprise permissions without error", func(t *testing.T) {
 
 		allowedActions := "selected"
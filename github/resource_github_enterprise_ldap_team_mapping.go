@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubEnterpriseLDAPTeamMapping() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maps a LDAP group to a GitHub Enterprise Server team.",
+		Create:      resourceGithubEnterpriseLDAPTeamMappingCreateOrUpdate,
+		Read:        resourceGithubEnterpriseLDAPTeamMappingRead,
+		Update:      resourceGithubEnterpriseLDAPTeamMappingCreateOrUpdate,
+		Delete:      resourceGithubEnterpriseLDAPTeamMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GitHub team id or the GitHub team slug.",
+			},
+			"ldap_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The LDAP Distinguished Name of the group whose membership is synchronized with the team.",
+			},
+			"sync": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether a LDAP sync of the team is queued when the mapping is created or changed. Default: 'true'.",
+			},
+		},
+	}
+}
+
+// syncGithubEnterpriseLDAPTeam queues a LDAP sync of a team, which go-github
+// does not provide.
+func syncGithubEnterpriseLDAPTeam(ctx context.Context, client *github.Client, teamID int64) error {
+	req, err := client.NewRequest("POST", fmt.Sprintf("admin/ldap/teams/%d/sync", teamID), nil)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Status string `json:"status"`
+	}
+	_, err = client.Do(ctx, req, &result)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] LDAP sync of team %d: %s", teamID, result.Status)
+	return nil
+}
+
+func resourceGithubEnterpriseLDAPTeamMappingCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+
+	teamID, err := getTeamID(d.Get("team_id").(string), meta)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, strconv.FormatInt(teamID, 10))
+
+	mapping := &github.TeamLDAPMapping{
+		LDAPDN: github.Ptr(d.Get("ldap_dn").(string)),
+	}
+	_, _, err = client.Admin.UpdateTeamLDAPMapping(ctx, teamID, mapping)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("sync").(bool) && (d.IsNewResource() || d.HasChange("ldap_dn")) {
+		if err := syncGithubEnterpriseLDAPTeam(ctx, client, teamID); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strconv.FormatInt(teamID, 10))
+	return resourceGithubEnterpriseLDAPTeamMappingRead(d, meta)
+}
+
+func resourceGithubEnterpriseLDAPTeamMappingRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgId := meta.(*Owner).id
+
+	teamID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	team, _, err := client.Teams.GetTeamByID(ctx, orgId, teamID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "LDAP mapping of team %s", d.Id())
+	}
+
+	// A team whose mapping was removed outside of Terraform is treated as
+	// having no mapping resource at all.
+	if team.GetLDAPDN() == "" {
+		log.Printf("[INFO] Removing LDAP mapping of team %s from state because the team is no longer mapped", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if _, ok := d.GetOk("team_id"); !ok {
+		if err = d.Set("team_id", d.Id()); err != nil {
+			return err
+		}
+	}
+	if err = d.Set("ldap_dn", team.GetLDAPDN()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseLDAPTeamMappingDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+
+	teamID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	mapping := &github.TeamLDAPMapping{
+		LDAPDN: github.Ptr(""),
+	}
+	_, _, err = client.Admin.UpdateTeamLDAPMapping(ctx, teamID, mapping)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "LDAP mapping of team %s", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubEnterpriseLDAPTeamMapping(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	ldapDN := os.Getenv("GITHUB_TEST_LDAP_DN")
+
+	t.Run("maps a LDAP group to a team", func(t *testing.T) {
+		if ldapDN == "" {
+			t.Skip("Skipping because `GITHUB_TEST_LDAP_DN` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_team" "test" {
+				name = "tf-acc-test-ldap-%s"
+			}
+
+			resource "github_enterprise_ldap_team_mapping" "test" {
+				team_id = github_team.test.id
+				ldap_dn = "%s"
+			}
+		`, randomID, ldapDN)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_enterprise_ldap_team_mapping.test", "ldap_dn", ldapDN),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_enterprise_ldap_team_mapping.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateVerifyIgnore: []string{
+							"sync",
+						},
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -17,7 +17,7 @@ func resourceGithubIssueLabels() *schema.Resource {
 		Update:      resourceGithubIssueLabelsCreateOrUpdate,
 		Delete:      resourceGithubIssueLabelsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGithubIssueLabelsImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,10 +56,35 @@ func resourceGithubIssueLabels() *schema.Resource {
 					},
 				},
 			},
+			"prune": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to delete the labels of the repository that are not part of 'label'. When false, the other labels of the repository are left unchanged.",
+			},
 		},
 	}
 }
 
+// flattenIssueLabels returns the labels of a repository as set in state. When
+// not pruning, only the labels managed by the resource are kept, so that the
+// other labels of the repository are not reported as drift.
+func flattenIssueLabels(existing []*github.Label, managed map[string]*github.Label, prune bool) []map[string]any {
+	labels := make([]map[string]any, 0, len(existing))
+	for _, l := range existing {
+		if _, ok := managed[strings.ToLower(l.GetName())]; !ok && !prune {
+			continue
+		}
+		labels = append(labels, map[string]any{
+			"name":        l.GetName(),
+			"color":       l.GetColor(),
+			"description": l.GetDescription(),
+			"url":         l.GetURL(),
+		})
+	}
+	return labels
+}
+
 func resourceGithubIssueLabelsRead(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
@@ -70,36 +95,16 @@ func resourceGithubIssueLabelsRead(d *schema.ResourceData, meta any) error {
 
 	ctx := context.WithValue(context.Background(), ctxId, repository)
 
-	options := &github.ListOptions{
-		PerPage: maxPerPage,
-	}
-
-	labels := make([]map[string]any, 0)
-
-	for {
-		ls, resp, err := client.Issues.ListLabels(ctx, owner, repository, options)
-		if err != nil {
-			return err
-		}
-		for _, l := range ls {
-			labels = append(labels, map[string]any{
-				"name":        l.GetName(),
-				"color":       l.GetColor(),
-				"description": l.GetDescription(),
-				"url":         l.GetURL(),
-			})
-		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-		options.Page = resp.NextPage
+	existing, err := listGithubIssueLabels(ctx, client, owner, repository)
+	if err != nil {
+		return err
 	}
+	labels := flattenIssueLabels(existing, expandDefaultLabels(d), d.Get("prune").(bool))
 
 	log.Printf("[DEBUG] Found %d GitHub issue labels for %s/%s", len(labels), owner, repository)
 	log.Printf("[DEBUG] Labels: %v", labels)
 
-	err := d.Set("repository", repository)
+	err = d.Set("repository", repository)
 	if err != nil {
 		return err
 	}
@@ -119,97 +124,61 @@ func resourceGithubIssueLabelsCreateOrUpdate(d *schema.ResourceData, meta any) e
 	repository := d.Get("repository").(string)
 	ctx := context.WithValue(context.Background(), ctxId, repository)
 
-	o, n := d.GetChange("label")
-
 	log.Printf("[DEBUG] Updating GitHub issue labels for %s/%s", owner, repository)
-	log.Printf("[DEBUG] Old labels: %v", o)
-	log.Printf("[DEBUG] New labels: %v", n)
-
-	oMap := make(map[string]map[string]any)
-	nMap := make(map[string]map[string]any)
-	for _, raw := range o.(*schema.Set).List() {
-		m := raw.(map[string]any)
-		name := strings.ToLower(m["name"].(string))
-		oMap[name] = m
-	}
-	for _, raw := range n.(*schema.Set).List() {
-		m := raw.(map[string]any)
-		name := strings.ToLower(m["name"].(string))
-		nMap[name] = m
-	}
-
-	labels := make([]map[string]any, 0)
 
-	// create
-	for name, n := range nMap {
-		if _, ok := oMap[name]; !ok {
-			log.Printf("[DEBUG] Creating GitHub issue label %s/%s/%s", owner, repository, name)
-
-			label, _, err := client.Issues.CreateLabel(ctx, owner, repository, &github.Label{
-				Name:        github.Ptr(n["name"].(string)),
-				Color:       github.Ptr(n["color"].(string)),
-				Description: github.Ptr(n["description"].(string)),
-			})
-			if err != nil {
-				return err
+	// The changes are computed against the actual labels of the repository
+	// rather than the state, so that labels created or edited out of band
+	// are reconciled instead of failing the apply.
+	existing, err := listGithubIssueLabels(ctx, client, owner, repository)
+	if err != nil {
+		return err
+	}
+	desired := expandDefaultLabels(d)
+	prune := d.Get("prune").(bool)
+	drift := diffDefaultLabels(desired, existing, prune)
+
+	// Without pruning, the labels removed from the configuration are still
+	// deleted, as they were managed by the resource.
+	if !prune {
+		o, _ := d.GetChange("label")
+		removed := make(map[string]bool)
+		for _, raw := range o.(*schema.Set).List() {
+			name := strings.ToLower(raw.(map[string]any)["name"].(string))
+			if _, ok := desired[name]; !ok {
+				removed[name] = true
+			}
+		}
+		for _, label := range existing {
+			if removed[strings.ToLower(label.GetName())] {
+				drift.extra = append(drift.extra, label.GetName())
 			}
-
-			labels = append(labels, map[string]any{
-				"name":        label.GetName(),
-				"color":       label.GetColor(),
-				"description": label.GetDescription(),
-				"url":         label.GetURL(),
-			})
 		}
 	}
 
-	// delete
-	for name, o := range oMap {
-		if _, ok := nMap[name]; !ok {
-			log.Printf("[DEBUG] Deleting GitHub issue label %s/%s/%s", owner, repository, name)
+	for _, name := range drift.missing {
+		log.Printf("[DEBUG] Creating GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, _, err := client.Issues.CreateLabel(ctx, owner, repository, desired[strings.ToLower(name)]); err != nil {
+			return err
+		}
+	}
 
-			_, err := client.Issues.DeleteLabel(ctx, owner, repository, o["name"].(string))
-			if err != nil {
-				return err
-			}
+	for _, name := range drift.outdated {
+		log.Printf("[DEBUG] Updating GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, _, err := client.Issues.EditLabel(ctx, owner, repository, name, desired[strings.ToLower(name)]); err != nil {
+			return err
 		}
 	}
 
-	// update
-	for name, n := range nMap {
-		if o, ok := oMap[name]; ok {
-			if o["name"] != n["name"] || o["color"] != n["color"] || o["description"] != n["description"] {
-				log.Printf("[DEBUG] Updating GitHub issue label %s/%s/%s", owner, repository, name)
-
-				label, _, err := client.Issues.EditLabel(ctx, owner, repository, name, &github.Label{
-					Name:        github.Ptr(n["name"].(string)),
-					Color:       github.Ptr(n["color"].(string)),
-					Description: github.Ptr(n["description"].(string)),
-				})
-				if err != nil {
-					return err
-				}
-
-				labels = append(labels, map[string]any{
-					"name":        label.GetName(),
-					"color":       label.GetColor(),
-					"description": label.GetDescription(),
-					"url":         label.GetURL(),
-				})
-			} else {
-				labels = append(labels, o)
-			}
+	for _, name := range drift.extra {
+		log.Printf("[DEBUG] Deleting GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, err := client.Issues.DeleteLabel(ctx, owner, repository, name); err != nil {
+			return err
 		}
 	}
 
 	d.SetId(repository)
 
-	err := d.Set("label", labels)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return resourceGithubIssueLabelsRead(d, meta)
 }
 
 func resourceGithubIssueLabelsDelete(d *schema.ResourceData, meta any) error {
@@ -246,3 +215,12 @@ func resourceGithubIssueLabelsDelete(d *schema.ResourceData, meta any) error {
 
 	return nil
 }
+
+func resourceGithubIssueLabelsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// All the labels of the repository are imported, which is only
+	// consistent with pruning.
+	if err := d.Set("prune", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
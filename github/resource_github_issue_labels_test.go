@@ -4,10 +4,30 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestFlattenIssueLabels(t *testing.T) {
+	existing := []*github.Label{
+		{Name: github.Ptr("Bug"), Color: github.Ptr("ff0000")},
+		{Name: github.Ptr("question"), Color: github.Ptr("00ff00")},
+	}
+	managed := map[string]*github.Label{
+		"bug": {Name: github.Ptr("bug"), Color: github.Ptr("ff0000")},
+	}
+
+	if labels := flattenIssueLabels(existing, managed, true); len(labels) != 2 {
+		t.Errorf("expected all labels when pruning, got %v", labels)
+	}
+
+	labels := flattenIssueLabels(existing, managed, false)
+	if len(labels) != 1 || labels[0]["name"] != "Bug" {
+		t.Errorf("expected only the managed label when not pruning, got %v", labels)
+	}
+}
+
 func TestAccGithubIssueLabels(t *testing.T) {
 	t.Run("authoritatively overtakes existing labels", func(t *testing.T) {
 		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
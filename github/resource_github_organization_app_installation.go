@@ -0,0 +1,241 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubOrganizationAppInstallation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a GitHub App installed in a GitHub organization and the repositories it has access to.",
+		Create:      resourceGithubOrganizationAppInstallationCreate,
+		Read:        resourceGithubOrganizationAppInstallationRead,
+		Update:      resourceGithubOrganizationAppInstallationUpdate,
+		Delete:      resourceGithubOrganizationAppInstallationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the GitHub App.",
+			},
+			"selected_repositories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the repositories the app has access to. Only applicable when the app is installed on selected repositories, at least one repository must remain selected.",
+			},
+			"installation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the app installation.",
+			},
+			"app_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the GitHub App.",
+			},
+			"repository_selection": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the app has access to 'all' repositories of the organization or to 'selected' ones.",
+			},
+			"permissions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The permissions granted to the app installation.",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The events the app installation is subscribed to.",
+			},
+			"suspended": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the app installation is suspended.",
+			},
+		},
+	}
+}
+
+// flattenInstallationPermissions returns the permissions granted to an app
+// installation keyed by their API name, e.g. 'contents' to 'read'.
+func flattenInstallationPermissions(permissions *github.InstallationPermissions) (map[string]string, error) {
+	result := make(map[string]string)
+	if permissions == nil {
+		return result, nil
+	}
+
+	// The permissions are all optional fields named after the API, going
+	// through JSON avoids listing each of them.
+	data, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func resourceGithubOrganizationAppInstallationCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	appSlug := d.Get("app_slug").(string)
+	ctx := context.Background()
+
+	installations, err := listAppInstallations(client, ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	// The REST API does not allow installing an app on behalf of an
+	// organization, the installation has to be approved in the web UI.
+	installation, ok := installations[appSlug]
+	if !ok {
+		return fmt.Errorf("the %s app is not installed in the %s organization, GitHub Apps can only be installed by an organization owner at https://github.com/apps/%s/installations/new", appSlug, orgName, appSlug)
+	}
+
+	installationID := strconv.FormatInt(installation.GetID(), 10)
+	d.SetId(installationID)
+
+	if v, ok := d.GetOk("selected_repositories"); ok {
+		if installation.GetRepositorySelection() == "all" {
+			return fmt.Errorf("the %s app has access to all repositories of the %s organization, selected_repositories cannot be set", appSlug, orgName)
+		}
+
+		ctx = context.WithValue(ctx, ctxId, installationID)
+		if err := syncAppInstallationRepositories(ctx, meta, installationID, expandStringList(v.(*schema.Set).List())); err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubOrganizationAppInstallationRead(d, meta)
+}
+
+func resourceGithubOrganizationAppInstallationRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	installationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	installations, err := listAppInstallations(client, ctx, orgName)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for slug, installation := range installations {
+		if installation.GetID() != installationID {
+			continue
+		}
+		found = true
+
+		events := installation.Events
+		if events == nil {
+			events = make([]string, 0)
+		}
+		if err = d.Set("app_slug", slug); err != nil {
+			return err
+		}
+		if err = d.Set("installation_id", d.Id()); err != nil {
+			return err
+		}
+		if err = d.Set("app_id", installation.GetAppID()); err != nil {
+			return err
+		}
+		if err = d.Set("repository_selection", installation.GetRepositorySelection()); err != nil {
+			return err
+		}
+		permissions, err := flattenInstallationPermissions(installation.GetPermissions())
+		if err != nil {
+			return err
+		}
+		if err = d.Set("permissions", permissions); err != nil {
+			return err
+		}
+		if err = d.Set("events", events); err != nil {
+			return err
+		}
+		if err = d.Set("suspended", installation.SuspendedAt != nil); err != nil {
+			return err
+		}
+		break
+	}
+
+	if !found {
+		log.Printf("[INFO] Removing app installation %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	repoNames := make([]string, 0)
+	if d.Get("repository_selection").(string) == "selected" {
+		reposNameIDs, _, err := getAllAccessibleRepos(meta, d.Id())
+		if err != nil {
+			return err
+		}
+		for name := range reposNameIDs {
+			repoNames = append(repoNames, name)
+		}
+		sort.Strings(repoNames)
+	}
+	if err = d.Set("selected_repositories", repoNames); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationAppInstallationUpdate(d *schema.ResourceData, meta any) error {
+	if d.HasChange("selected_repositories") {
+		if d.Get("repository_selection").(string) == "all" {
+			return fmt.Errorf("the %s app has access to all repositories of the organization, selected_repositories cannot be set", d.Get("app_slug").(string))
+		}
+
+		ctx := context.WithValue(context.Background(), ctxId, d.Id())
+		selectedRepositoryNames := expandStringList(d.Get("selected_repositories").(*schema.Set).List())
+		if err := syncAppInstallationRepositories(ctx, meta, d.Id(), selectedRepositoryNames); err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubOrganizationAppInstallationRead(d, meta)
+}
+
+func resourceGithubOrganizationAppInstallationDelete(d *schema.ResourceData, meta any) error {
+	// Uninstalling requires authenticating as the app itself, the installation
+	// is otherwise left in place and has to be removed in the web UI.
+	log.Printf("[WARN] Removing app installation %s from state, the %s app must be uninstalled from the organization settings or with the app's own credentials", d.Id(), d.Get("app_slug").(string))
+	return nil
+}
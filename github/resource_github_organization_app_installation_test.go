@@ -0,0 +1,99 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationAppInstallation(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	appSlug := os.Getenv("GITHUB_TEST_APP_SLUG")
+
+	t.Run("manages the repositories of an installed app", func(t *testing.T) {
+		if appSlug == "" {
+			t.Skip("Skipping because `GITHUB_TEST_APP_SLUG` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-app-installation-%s"
+				auto_init = true
+			}
+
+			resource "github_organization_app_installation" "test" {
+				app_slug              = "%s"
+				selected_repositories = [github_repository.test.name]
+			}
+		`, randomID, appSlug)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_organization_app_installation.test", "app_slug", appSlug),
+			resource.TestCheckResourceAttr("github_organization_app_installation.test", "repository_selection", "selected"),
+			resource.TestCheckTypeSetElemAttr("github_organization_app_installation.test", "selected_repositories.*", fmt.Sprintf("tf-acc-test-app-installation-%s", randomID)),
+			resource.TestCheckResourceAttrSet("github_organization_app_installation.test", "installation_id"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+
+	t.Run("fails when the app is not installed", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_organization_app_installation" "test" {
+				app_slug = "tf-acc-test-not-installed-%s"
+			}
+		`, randomID)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile("is not installed"),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
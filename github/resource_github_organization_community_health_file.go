@@ -0,0 +1,264 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// organizationCommunityHealthRepository is the repository GitHub uses as the
+// source of the default community health files of an organization.
+const organizationCommunityHealthRepository = ".github"
+
+func resourceGithubOrganizationCommunityHealthFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages the default community health files of a GitHub organization",
+		Create:      resourceGithubOrganizationCommunityHealthFileCreate,
+		Read:        resourceGithubOrganizationCommunityHealthFileRead,
+		Update:      resourceGithubOrganizationCommunityHealthFileUpdate,
+		Delete:      resourceGithubOrganizationCommunityHealthFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if err := d.Set("overwrite_on_create", false); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validateValueFunc([]string{
+					"CODE_OF_CONDUCT.md",
+					"CONTRIBUTING.md",
+					"FUNDING.yml",
+					"GOVERNANCE.md",
+					"SECURITY.md",
+					"SUPPORT.md",
+					"profile/README.md",
+				}),
+				Description: "The community health file to manage. Can be one of 'CODE_OF_CONDUCT.md', 'CONTRIBUTING.md', 'FUNDING.yml', 'GOVERNANCE.md', 'SECURITY.md', 'SUPPORT.md' or 'profile/README.md'.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The file's content",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to the default branch of the organization's '.github' repository",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the file",
+			},
+			"commit_author": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit author name, defaults to the authenticated user's name.",
+			},
+			"commit_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit author email address, defaults to the authenticated user's email address.",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable overwriting the existing file of the organization, defaults to \"false\"",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The repository holding the file.",
+			},
+			"sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the file",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last modified the file",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationCommunityHealthFileCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	file := d.Get("file").(string)
+	ctx := context.WithValue(context.Background(), ctxId, file)
+
+	opts, err := resourceGithubRepositoryFileOptions(d)
+	if err != nil {
+		return err
+	}
+	if opts.Message == nil {
+		opts.Message = github.Ptr(fmt.Sprintf("Add %s", file))
+	}
+
+	log.Printf("[DEBUG] Checking if overwriting a community health file: %s/%s/%s", owner, organizationCommunityHealthRepository, file)
+	getOpts := &github.RepositoryContentGetOptions{Ref: opts.GetBranch()}
+	fc, _, resp, err := client.Repositories.GetContents(ctx, owner, organizationCommunityHealthRepository, file, getOpts)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("could not read %s from the %s/%s repository: %w", file, owner, organizationCommunityHealthRepository, err)
+	}
+	if fc != nil {
+		if !d.Get("overwrite_on_create").(bool) {
+			return fmt.Errorf("refusing to overwrite existing file: configure `overwrite_on_create` to `true` to override")
+		}
+		opts.SHA = fc.SHA
+	}
+
+	result, _, err := client.Repositories.CreateFile(ctx, owner, organizationCommunityHealthRepository, file, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(file)
+	if err = d.Set("commit_sha", result.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationCommunityHealthFileRead(d, meta)
+}
+
+func resourceGithubOrganizationCommunityHealthFileRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	file := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	opts := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Ref = branch.(string)
+	}
+
+	fc, _, _, err := client.Repositories.GetContents(ctx, owner, organizationCommunityHealthRepository, file, opts)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "community health file %s/%s/%s", owner, organizationCommunityHealthRepository, file)
+	}
+	if fc == nil {
+		return fmt.Errorf("%s is not a file in the %s/%s repository", file, owner, organizationCommunityHealthRepository)
+	}
+
+	content, err := fc.GetContent()
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("file", file); err != nil {
+		return err
+	}
+	if err = d.Set("content", content); err != nil {
+		return err
+	}
+	if err = d.Set("repository", organizationCommunityHealthRepository); err != nil {
+		return err
+	}
+	if err = d.Set("sha", fc.GetSHA()); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("commit_sha"); !ok {
+		commit, err := getFileCommit(client, owner, organizationCommunityHealthRepository, file, opts.Ref)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("commit_sha", commit.GetSHA()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCommunityHealthFileUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	file := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if !d.HasChange("content") {
+		return resourceGithubOrganizationCommunityHealthFileRead(d, meta)
+	}
+
+	opts, err := resourceGithubRepositoryFileOptions(d)
+	if err != nil {
+		return err
+	}
+	if opts.Message == nil {
+		opts.Message = github.Ptr(fmt.Sprintf("Update %s", file))
+	}
+
+	result, _, err := client.Repositories.CreateFile(ctx, owner, organizationCommunityHealthRepository, file, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("commit_sha", result.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationCommunityHealthFileRead(d, meta)
+}
+
+func resourceGithubOrganizationCommunityHealthFileDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	file := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	message := fmt.Sprintf("Delete %s", file)
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.Ptr(message),
+		SHA:     github.Ptr(d.Get("sha").(string)),
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Branch = github.Ptr(branch.(string))
+	}
+
+	_, _, err = client.Repositories.DeleteFile(ctx, owner, organizationCommunityHealthRepository, file, opts)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "community health file %s/%s/%s", owner, organizationCommunityHealthRepository, file)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationCommunityHealthFile(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages a community health file", func(t *testing.T) {
+		config := `
+			resource "github_organization_community_health_file" "test" {
+				file                = "SUPPORT.md"
+				content             = "%s"
+				overwrite_on_create = true
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "Ask in #support-"+randomID),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_community_health_file.test", "repository", ".github"),
+							resource.TestCheckResourceAttr("github_organization_community_health_file.test", "content", "Ask in #support-"+randomID),
+							resource.TestCheckResourceAttrSet("github_organization_community_health_file.test", "sha"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, "Ask in #help-"+randomID),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_community_health_file.test", "content", "Ask in #help-"+randomID),
+						),
+					},
+					{
+						ResourceName:      "github_organization_community_health_file.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateVerifyIgnore: []string{
+							"commit_sha",
+							"overwrite_on_create",
+						},
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
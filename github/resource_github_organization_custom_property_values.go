@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maxCustomPropertyValuesRepositories is the number of repositories whose
+// custom property values can be set by a single request.
+const maxCustomPropertyValuesRepositories = 30
+
+func resourceGithubOrganizationCustomPropertyValues() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets the value of an organization custom property across many repositories.",
+		Create:      resourceGithubOrganizationCustomPropertyValuesCreateOrUpdate,
+		Read:        resourceGithubOrganizationCustomPropertyValuesRead,
+		Update:      resourceGithubOrganizationCustomPropertyValuesCreateOrUpdate,
+		Delete:      resourceGithubOrganizationCustomPropertyValuesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"property_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the custom property.",
+			},
+			"property_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Type of the custom property.",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{SINGLE_SELECT, MULTI_SELECT, STRING, TRUE_FALSE}, false), "property_type"),
+			},
+			"property_value": {
+				Type:        schema.TypeSet,
+				MinItems:    1,
+				Required:    true,
+				Description: "Value of the custom property, a single one unless it is of type 'multi_select'.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"repositories": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the repositories to set the value of the custom property of.",
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the custom property is unset on the repositories not listed in 'repositories' which have the value. Otherwise, they are left unchanged.",
+			},
+		},
+	}
+}
+
+// expandCustomPropertyValue returns the value of a custom property as sent
+// to the API, a list of strings for multi select properties and a string
+// otherwise.
+func expandCustomPropertyValue(propertyType string, values []string) (any, error) {
+	switch propertyType {
+	case SINGLE_SELECT, TRUE_FALSE, STRING:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("custom property of type %s must have a single value, got %d", propertyType, len(values))
+		}
+		return values[0], nil
+	case MULTI_SELECT:
+		return values, nil
+	default:
+		return nil, fmt.Errorf("custom property type is not valid: %v", propertyType)
+	}
+}
+
+// listRepositoriesWithCustomPropertyValue returns the sorted names of the
+// repositories of the organization whose custom property has exactly the
+// given values.
+func listRepositoriesWithCustomPropertyValue(ctx context.Context, client *github.Client, owner, propertyName string, values []string) ([]string, error) {
+	want := slices.Clone(values)
+	sort.Strings(want)
+
+	opt := &github.ListCustomPropertyValuesOptions{
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+
+	var names []string
+	for {
+		repos, resp, err := client.Organizations.ListCustomPropertyValues(ctx, owner, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			for _, property := range repo.Properties {
+				if property.PropertyName != propertyName || property.Value == nil {
+					continue
+				}
+				got, err := parseRepositoryCustomPropertyValueToStringSlice(property)
+				if err != nil {
+					return nil, err
+				}
+				got = slices.Clone(got)
+				sort.Strings(got)
+				if slices.Equal(got, want) {
+					names = append(names, repo.RepositoryName)
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// setRepositoriesCustomPropertyValue sets the value of the custom property of
+// the repositories with as few requests as the API allows, a nil value unsets
+// it.
+func setRepositoriesCustomPropertyValue(ctx context.Context, client *github.Client, owner string, repositories []string, propertyName string, value any) error {
+	for start := 0; start < len(repositories); start += maxCustomPropertyValuesRepositories {
+		batch := repositories[start:min(start+maxCustomPropertyValuesRepositories, len(repositories))]
+		log.Printf("[DEBUG] Setting custom property %s of %d repositories of %s to %v", propertyName, len(batch), owner, value)
+
+		properties := []*github.CustomPropertyValue{{PropertyName: propertyName, Value: value}}
+		if _, err := client.Organizations.CreateOrUpdateRepoCustomPropertyValues(ctx, owner, batch, properties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceGithubOrganizationCustomPropertyValuesCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	propertyName := d.Get("property_name").(string)
+	value, err := expandCustomPropertyValue(d.Get("property_type").(string), expandStringList(d.Get("property_value").(*schema.Set).List()))
+	if err != nil {
+		return err
+	}
+
+	// The repositories in state are the ones which have the value, so only
+	// the added ones need it set unless the value changed.
+	o, n := d.GetChange("repositories")
+	oldRepositories, newRepositories := o.(*schema.Set), n.(*schema.Set)
+	added := newRepositories
+	if !d.HasChange("property_value") {
+		added = newRepositories.Difference(oldRepositories)
+	}
+	removed := expandStringList(oldRepositories.Difference(newRepositories).List())
+	sort.Strings(removed)
+
+	setRepositories := expandStringList(added.List())
+	sort.Strings(setRepositories)
+	if err = setRepositoriesCustomPropertyValue(ctx, client, owner, setRepositories, propertyName, value); err != nil {
+		return err
+	}
+	if err = setRepositoriesCustomPropertyValue(ctx, client, owner, removed, propertyName, nil); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(owner, propertyName))
+	return resourceGithubOrganizationCustomPropertyValuesRead(d, meta)
+}
+
+func resourceGithubOrganizationCustomPropertyValuesRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	propertyName := d.Get("property_name").(string)
+	values := expandStringList(d.Get("property_value").(*schema.Set).List())
+	repositories, err := listRepositoriesWithCustomPropertyValue(ctx, client, owner, propertyName, values)
+	if err != nil {
+		return err
+	}
+
+	// Unless authoritative, the other repositories with the value are not
+	// managed by the resource and are not reported as drift.
+	if !d.Get("authoritative").(bool) {
+		managed := d.Get("repositories").(*schema.Set)
+		repositories = slices.DeleteFunc(repositories, func(name string) bool {
+			return !managed.Contains(name)
+		})
+	}
+
+	if err = d.Set("repositories", repositories); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCustomPropertyValuesDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repositories := expandStringList(d.Get("repositories").(*schema.Set).List())
+	sort.Strings(repositories)
+
+	return setRepositoriesCustomPropertyValue(ctx, client, owner, repositories, d.Get("property_name").(string), nil)
+}
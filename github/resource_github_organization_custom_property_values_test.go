@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSetRepositoriesCustomPropertyValue(t *testing.T) {
+	var batches [][]string
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /orgs/org/properties/values", func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			RepositoryNames []string `json:"repository_names"`
+			Properties      []struct {
+				PropertyName string `json:"property_name"`
+				Value        any    `json:"value"`
+			} `json:"properties"`
+		}
+		if err := json.Unmarshal([]byte(mustRead(req.Body)), &body); err != nil {
+			t.Fatalf("unexpected request body: %s", err)
+		}
+		if len(body.Properties) != 1 || body.Properties[0].PropertyName != "team" || body.Properties[0].Value != nil {
+			t.Errorf("unexpected properties %v", body.Properties)
+		}
+		batches = append(batches, body.RepositoryNames)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+
+	repositories := make([]string, 65)
+	for i := range repositories {
+		repositories[i] = fmt.Sprintf("repo-%d", i)
+	}
+	if err := setRepositoriesCustomPropertyValue(context.Background(), client, "org", repositories, "team", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(batches) != 3 || len(batches[0]) != 30 || len(batches[1]) != 30 || len(batches[2]) != 5 {
+		t.Errorf("expected batches of 30, 30 and 5 repositories, got %v", batches)
+	}
+}
+
+func TestExpandCustomPropertyValue(t *testing.T) {
+	if value, err := expandCustomPropertyValue(STRING, []string{"a"}); err != nil || value != "a" {
+		t.Errorf("expected a string value, got %v (%v)", value, err)
+	}
+	if value, err := expandCustomPropertyValue(MULTI_SELECT, []string{"a", "b"}); err != nil || len(value.([]string)) != 2 {
+		t.Errorf("expected a list value, got %v (%v)", value, err)
+	}
+	if _, err := expandCustomPropertyValue(SINGLE_SELECT, []string{"a", "b"}); err == nil {
+		t.Error("expected an error for several values of a single select property")
+	}
+}
+
+func TestAccGithubOrganizationCustomPropertyValues(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("sets a custom property value across repositories without error", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				count     = 3
+				name      = "tf-acc-test-props-%[1]s-${count.index}"
+				auto_init = true
+			}
+
+			resource "github_organization_custom_property_values" "test" {
+				property_name  = "team"
+				property_type  = "string"
+				property_value = ["%[2]s"]
+				repositories   = slice(github_repository.test[*].name, 0, %[3]d)
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "platform", 3),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_custom_property_values.test", "repositories.#", "3"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "platform", 2),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_custom_property_values.test", "repositories.#", "2"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "security", 2),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_custom_property_values.test", "repositories.#", "2"),
+							resource.TestCheckResourceAttr("github_organization_custom_property_values.test", "property_value.0", "security"),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
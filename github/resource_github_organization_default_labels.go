@@ -0,0 +1,307 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubOrganizationDefaultLabels() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a canonical set of issue labels kept in sync across repositories of a GitHub organization.",
+		Create:      resourceGithubOrganizationDefaultLabelsCreateOrUpdate,
+		Read:        resourceGithubOrganizationDefaultLabelsRead,
+		Update:      resourceGithubOrganizationDefaultLabelsCreateOrUpdate,
+		Delete:      resourceGithubOrganizationDefaultLabelsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGithubOrganizationDefaultLabelsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The canonical labels of the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the label.",
+						},
+						"color": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "A 6 character hex code, without the leading '#', identifying the color of the label.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A short description of the label.",
+						},
+					},
+				},
+			},
+			"repositories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the repositories to keep the labels in sync in. Repositories are opted in explicitly, the labels of the other repositories are left unchanged.",
+			},
+			"prune": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the labels of the repositories that are not part of the canonical labels.",
+			},
+			"drift": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The repositories whose labels differ from the canonical labels, as of the last refresh. Any drift is reconciled by the next apply.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the repository.",
+						},
+						"missing_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The canonical labels missing from the repository.",
+						},
+						"outdated_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the repository whose color or description differs from the canonical ones.",
+						},
+						"extra_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the repository that are not canonical, only reported when 'prune' is set.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// defaultLabelsDrift is the difference between the labels of a repository
+// and the canonical labels.
+type defaultLabelsDrift struct {
+	missing  []string
+	outdated []string
+	extra    []string
+}
+
+func (drift *defaultLabelsDrift) isEmpty() bool {
+	return len(drift.missing) == 0 && len(drift.outdated) == 0 && len(drift.extra) == 0
+}
+
+// expandDefaultLabels returns the canonical labels keyed by their lowercase
+// name, as label names are case insensitive.
+func expandDefaultLabels(d *schema.ResourceData) map[string]*github.Label {
+	labels := make(map[string]*github.Label)
+	for _, raw := range d.Get("label").(*schema.Set).List() {
+		m := raw.(map[string]any)
+		labels[strings.ToLower(m["name"].(string))] = &github.Label{
+			Name:        github.Ptr(m["name"].(string)),
+			Color:       github.Ptr(m["color"].(string)),
+			Description: github.Ptr(m["description"].(string)),
+		}
+	}
+	return labels
+}
+
+func listGithubIssueLabels(ctx context.Context, client *github.Client, owner, repository string) ([]*github.Label, error) {
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	var labels []*github.Label
+	for {
+		ls, resp, err := client.Issues.ListLabels(ctx, owner, repository, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, ls...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return labels, nil
+}
+
+// diffDefaultLabels returns the drift of the labels of a repository from the
+// canonical labels, extra labels are only reported when pruning.
+func diffDefaultLabels(canonical map[string]*github.Label, existing []*github.Label, prune bool) *defaultLabelsDrift {
+	drift := &defaultLabelsDrift{}
+
+	found := make(map[string]bool)
+	for _, label := range existing {
+		name := strings.ToLower(label.GetName())
+		want, ok := canonical[name]
+		if !ok {
+			if prune {
+				drift.extra = append(drift.extra, label.GetName())
+			}
+			continue
+		}
+
+		found[name] = true
+		if label.GetName() != want.GetName() || !strings.EqualFold(label.GetColor(), want.GetColor()) || label.GetDescription() != want.GetDescription() {
+			drift.outdated = append(drift.outdated, label.GetName())
+		}
+	}
+
+	for name, label := range canonical {
+		if !found[name] {
+			drift.missing = append(drift.missing, label.GetName())
+		}
+	}
+
+	sort.Strings(drift.missing)
+	sort.Strings(drift.outdated)
+	sort.Strings(drift.extra)
+	return drift
+}
+
+// reconcileDefaultLabels applies the canonical labels to a repository with the
+// fewest requests: its labels are listed once and only the drifting ones are
+// created, updated or deleted.
+func reconcileDefaultLabels(ctx context.Context, client *github.Client, owner, repository string, canonical map[string]*github.Label, prune bool) error {
+	existing, err := listGithubIssueLabels(ctx, client, owner, repository)
+	if err != nil {
+		return err
+	}
+
+	drift := diffDefaultLabels(canonical, existing, prune)
+	if drift.isEmpty() {
+		log.Printf("[DEBUG] Labels of %s/%s are in sync", owner, repository)
+		return nil
+	}
+
+	for _, name := range drift.missing {
+		log.Printf("[DEBUG] Creating GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, _, err := client.Issues.CreateLabel(ctx, owner, repository, canonical[strings.ToLower(name)]); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range drift.outdated {
+		log.Printf("[DEBUG] Updating GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, _, err := client.Issues.EditLabel(ctx, owner, repository, name, canonical[strings.ToLower(name)]); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range drift.extra {
+		log.Printf("[DEBUG] Deleting GitHub issue label %s/%s/%s", owner, repository, name)
+		if _, err := client.Issues.DeleteLabel(ctx, owner, repository, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationDefaultLabelsCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	canonical := expandDefaultLabels(d)
+	prune := d.Get("prune").(bool)
+	for _, repository := range expandStringList(d.Get("repositories").(*schema.Set).List()) {
+		if err := reconcileDefaultLabels(ctx, client, orgName, repository, canonical, prune); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(orgName)
+	return resourceGithubOrganizationDefaultLabelsRead(d, meta)
+}
+
+func resourceGithubOrganizationDefaultLabelsRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	canonical := expandDefaultLabels(d)
+	prune := d.Get("prune").(bool)
+
+	repositories := expandStringList(d.Get("repositories").(*schema.Set).List())
+	sort.Strings(repositories)
+
+	drifts := make([]any, 0)
+	for _, repository := range repositories {
+		existing, err := listGithubIssueLabels(ctx, client, orgName, repository)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Skipping the labels of repository %s/%s because it no longer exists in GitHub", orgName, repository)
+				continue
+			}
+			return err
+		}
+
+		drift := diffDefaultLabels(canonical, existing, prune)
+		if drift.isEmpty() {
+			continue
+		}
+		log.Printf("[INFO] Labels of %s/%s drifted: missing %v, outdated %v, extra %v", orgName, repository, drift.missing, drift.outdated, drift.extra)
+		drifts = append(drifts, map[string]any{
+			"repository":      repository,
+			"missing_labels":  drift.missing,
+			"outdated_labels": drift.outdated,
+			"extra_labels":    drift.extra,
+		})
+	}
+
+	if err = d.Set("drift", drifts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationDefaultLabelsDelete(d *schema.ResourceData, meta any) error {
+	// The labels are in use by issues and pull requests, they are left in
+	// the repositories.
+	log.Printf("[INFO] Removing default labels of %s from state, the labels of the repositories are left unchanged", d.Id())
+	return nil
+}
+
+// resourceGithubOrganizationDefaultLabelsDiff plans an update when the last
+// refresh found repositories whose labels differ from the canonical labels,
+// so that their labels are created, updated or deleted.
+func resourceGithubOrganizationDefaultLabelsDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	return planRefreshedDrift(diff, "drift")
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationDefaultLabels(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("syncs default labels across repositories without error", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				count     = 2
+				name      = "tf-acc-test-labels-%[1]s-${count.index}"
+				auto_init = true
+			}
+
+			resource "github_organization_default_labels" "test" {
+				repositories = github_repository.test[*].name
+				prune        = true
+
+				label {
+					name        = "bug"
+					color       = "d73a4a"
+					description = "%[2]s"
+				}
+
+				label {
+					name  = "release"
+					color = "0e8a16"
+				}
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "Something isn't working"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_default_labels.test", "label.#", "2"),
+							resource.TestCheckResourceAttr("github_organization_default_labels.test", "drift.#", "0"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "A defect"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_organization_default_labels.test", "drift.#", "0"),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
+
+func TestDiffDefaultLabels(t *testing.T) {
+	canonical := map[string]*github.Label{
+		"bug":     {Name: github.Ptr("bug"), Color: github.Ptr("d73a4a"), Description: github.Ptr("Something isn't working")},
+		"release": {Name: github.Ptr("release"), Color: github.Ptr("0e8a16"), Description: github.Ptr("")},
+	}
+	existing := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("D73A4A"), Description: github.Ptr("Something isn't working")},
+		{Name: github.Ptr("Release"), Color: github.Ptr("0e8a16")},
+		{Name: github.Ptr("wontfix"), Color: github.Ptr("ffffff")},
+	}
+
+	tests := []struct {
+		name  string
+		prune bool
+		want  *defaultLabelsDrift
+	}{
+		{
+			name: "reports outdated labels regardless of color case",
+			want: &defaultLabelsDrift{outdated: []string{"Release"}},
+		},
+		{
+			name:  "reports extra labels when pruning",
+			prune: true,
+			want:  &defaultLabelsDrift{outdated: []string{"Release"}, extra: []string{"wontfix"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffDefaultLabels(canonical, existing, tt.prune)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("reports missing labels", func(t *testing.T) {
+		got := diffDefaultLabels(canonical, nil, false)
+		want := &defaultLabelsDrift{missing: []string{"bug", "release"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+}
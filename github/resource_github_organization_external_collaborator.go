@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	externalCollaboratorActionConvert = "convert_to_member"
+	externalCollaboratorActionRemove  = "remove"
+)
+
+func resourceGithubOrganizationExternalCollaborator() *schema.Resource {
+	return &schema.Resource{
+		Description: "Converts an outside collaborator of a GitHub organization to a member, or removes them from the organization.",
+		Create:      resourceGithubOrganizationExternalCollaboratorCreate,
+		Read:        resourceGithubOrganizationExternalCollaboratorRead,
+		Delete:      resourceGithubOrganizationExternalCollaboratorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+				Description:      "The outside collaborator.",
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc([]string{externalCollaboratorActionConvert, externalCollaboratorActionRemove}),
+				Description:      "What to do with the outside collaborator. Must be one of 'convert_to_member' or 'remove'.",
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "member",
+				ValidateDiagFunc: validateValueFunc([]string{"member", "admin"}),
+				Description:      "The role of the user within the organization when converted to a member. Must be one of 'member' or 'admin'.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the organization membership of a converted collaborator, 'pending' until the invitation is accepted.",
+			},
+		},
+	}
+}
+
+// isGithubOrganizationOutsideCollaborator reports whether username is an
+// outside collaborator of the organization, as there is no endpoint to look up
+// a single one.
+func isGithubOrganizationOutsideCollaborator(ctx context.Context, client *github.Client, orgName, username string) (bool, error) {
+	users, err := listGithubOrganizationOutsideCollaborators(ctx, client, orgName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.GetLogin(), username) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func resourceGithubOrganizationExternalCollaboratorCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	username := d.Get("username").(string)
+	ctx := context.Background()
+
+	switch d.Get("action").(string) {
+	case externalCollaboratorActionConvert:
+		// Outside collaborators become members by accepting an invitation to
+		// the organization, their repository access is kept.
+		log.Printf("[INFO] Inviting outside collaborator %s to the %s organization", username, orgName)
+		_, _, err = client.Organizations.EditOrgMembership(ctx, username, orgName, &github.Membership{
+			Role: github.Ptr(d.Get("role").(string)),
+		})
+	case externalCollaboratorActionRemove:
+		log.Printf("[INFO] Removing outside collaborator %s from the %s organization", username, orgName)
+		_, err = client.Organizations.RemoveOutsideCollaborator(ctx, orgName, username)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(orgName, username))
+
+	return resourceGithubOrganizationExternalCollaboratorRead(d, meta)
+}
+
+func resourceGithubOrganizationExternalCollaboratorRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	_, username, err := parseTwoPartID(d.Id(), "organization", "username")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// The resource is removed from state when the action no longer holds, so
+	// that the next apply performs it again.
+	switch d.Get("action").(string) {
+	case externalCollaboratorActionConvert:
+		membership, _, err := client.Organizations.GetOrgMembership(ctx, username, orgName)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing external collaborator %s from state because the invitation to the organization was not accepted", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		if err = d.Set("state", membership.GetState()); err != nil {
+			return err
+		}
+	case externalCollaboratorActionRemove:
+		outside, err := isGithubOrganizationOutsideCollaborator(ctx, client, orgName, username)
+		if err != nil {
+			return err
+		}
+		if outside {
+			log.Printf("[INFO] Removing external collaborator %s from state because the user is an outside collaborator again", d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = d.Set("username", username); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationExternalCollaboratorDelete(d *schema.ResourceData, meta any) error {
+	// Neither converting nor removing an outside collaborator can be undone,
+	// destroying the resource only removes it from state.
+	log.Printf("[INFO] Removing external collaborator %s from state, the user is left unchanged", d.Id())
+	return nil
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationExternalCollaborator(t *testing.T) {
+	collaborator := os.Getenv("GITHUB_TEST_OUTSIDE_COLLABORATOR")
+
+	t.Run("removes an outside collaborator", func(t *testing.T) {
+		if collaborator == "" {
+			t.Skip("Skipping because `GITHUB_TEST_OUTSIDE_COLLABORATOR` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_organization_external_collaborator" "test" {
+				username = "%s"
+				action   = "remove"
+			}
+		`, collaborator)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_organization_external_collaborator.test", "username", collaborator),
+			resource.TestCheckResourceAttr("github_organization_external_collaborator.test", "action", "remove"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubOrganizationIpAllowListEntry() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages an entry of the IP allow list of a GitHub organization",
+		Create:      resourceGithubOrganizationIpAllowListEntryCreate,
+		Read:        resourceGithubOrganizationIpAllowListEntryRead,
+		Update:      resourceGithubOrganizationIpAllowListEntryUpdate,
+		Delete:      resourceGithubOrganizationIpAllowListEntryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_list_value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An IP address or range of addresses in CIDR notation.",
+				ValidateDiagFunc: toDiagFunc(validation.Any(
+					validation.IsIPAddress,
+					validation.IsCIDR,
+				), "allow_list_value"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the entry.",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the entry is active when the IP allow list is enabled.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the entry was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the entry was last updated.",
+			},
+		},
+	}
+}
+
+// getOrganizationNodeID returns the GraphQL node ID of the organization of
+// the provider.
+func getOrganizationNodeID(ctx context.Context, meta any) (githubv4.ID, error) {
+	var query struct {
+		Organization struct {
+			ID githubv4.ID
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]any{
+		"login": githubv4.String(meta.(*Owner).name),
+	}
+	if err := meta.(*Owner).v4client.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+	return query.Organization.ID, nil
+}
+
+func resourceGithubOrganizationIpAllowListEntryCreate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v4client
+	ctx := context.Background()
+
+	ownerID, err := getOrganizationNodeID(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	var mutate struct {
+		CreateIpAllowListEntry struct {
+			IpAllowListEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createIpAllowListEntry(input: $input)"`
+	}
+	input := githubv4.CreateIpAllowListEntryInput{
+		OwnerID:        ownerID,
+		AllowListValue: githubv4.String(d.Get("allow_list_value").(string)),
+		IsActive:       githubv4.Boolean(d.Get("is_active").(bool)),
+		Name:           githubv4.NewString(githubv4.String(d.Get("name").(string))),
+	}
+	if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s", mutate.CreateIpAllowListEntry.IpAllowListEntry.ID))
+	return resourceGithubOrganizationIpAllowListEntryRead(d, meta)
+}
+
+func resourceGithubOrganizationIpAllowListEntryRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v4client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var query struct {
+		Node struct {
+			IpAllowListEntry struct {
+				Name           githubv4.String
+				AllowListValue githubv4.String
+				IsActive       githubv4.Boolean
+				CreatedAt      githubv4.String
+				UpdatedAt      githubv4.String
+			} `graphql:"... on IpAllowListEntry"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(d.Id()),
+	}
+	if err := client.Query(ctx, &query, variables); err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing IP allow list entry (%s) from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	entry := query.Node.IpAllowListEntry
+	if err := d.Set("allow_list_value", entry.AllowListValue); err != nil {
+		return err
+	}
+	if err := d.Set("name", entry.Name); err != nil {
+		return err
+	}
+	if err := d.Set("is_active", entry.IsActive); err != nil {
+		return err
+	}
+	if err := d.Set("created_at", entry.CreatedAt); err != nil {
+		return err
+	}
+	if err := d.Set("updated_at", entry.UpdatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationIpAllowListEntryUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v4client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var mutate struct {
+		UpdateIpAllowListEntry struct {
+			IpAllowListEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateIpAllowListEntry(input: $input)"`
+	}
+	input := githubv4.UpdateIpAllowListEntryInput{
+		IPAllowListEntryID: d.Id(),
+		AllowListValue:     githubv4.String(d.Get("allow_list_value").(string)),
+		IsActive:           githubv4.Boolean(d.Get("is_active").(bool)),
+		Name:               githubv4.NewString(githubv4.String(d.Get("name").(string))),
+	}
+	if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationIpAllowListEntryRead(d, meta)
+}
+
+func resourceGithubOrganizationIpAllowListEntryDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v4client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var mutate struct {
+		DeleteIpAllowListEntry struct { // Empty struct does not work
+			ClientMutationId githubv4.ID
+		} `graphql:"deleteIpAllowListEntry(input: $input)"`
+	}
+	input := githubv4.DeleteIpAllowListEntryInput{
+		IPAllowListEntryID: d.Id(),
+	}
+	if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] IP allow list entry (%s) no longer exists in GitHub", d.Id())
+			return nil
+		}
+		return err
+	}
+	return nil
+}
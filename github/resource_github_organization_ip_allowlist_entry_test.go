@@ -0,0 +1,97 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestResourceGithubOrganizationIpAllowListEntryCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body, "organization(login:"):
+			mustWrite(w, `{"data": {"organization": {"id": "O_1"}}}`)
+		case strings.Contains(body, "createIpAllowListEntry("):
+			if !strings.Contains(body, `"ownerId":"O_1"`) || !strings.Contains(body, `"allowListValue":"192.0.2.0/24"`) ||
+				!strings.Contains(body, `"isActive":true`) || !strings.Contains(body, `"name":"office"`) {
+				t.Errorf("unexpected input %s", body)
+			}
+			mustWrite(w, `{"data": {"createIpAllowListEntry": {"ipAllowListEntry": {"id": "IALE_1"}}}}`)
+		case strings.Contains(body, "node(id:"):
+			mustWrite(w, `{"data": {"node": {"name": "office", "allowListValue": "192.0.2.0/24", "isActive": true,
+				"createdAt": "2026-01-01T00:00:00Z", "updatedAt": "2026-01-02T00:00:00Z"}}}`)
+		default:
+			t.Fatalf("unexpected GraphQL call %s", body)
+		}
+	})
+
+	meta := &Owner{
+		v4client:       githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:           "tf-acc-org",
+		IsOrganization: true,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationIpAllowListEntry().Schema, map[string]any{
+		"allow_list_value": "192.0.2.0/24",
+		"name":             "office",
+	})
+	if err := resourceGithubOrganizationIpAllowListEntryCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "IALE_1" {
+		t.Errorf("expected the ID to be IALE_1, got %s", d.Id())
+	}
+	if got := d.Get("updated_at").(string); got != "2026-01-02T00:00:00Z" {
+		t.Errorf("expected updated_at to be set, got %q", got)
+	}
+}
+
+func TestResourceGithubOrganizationIpAllowListSettingsCreate(t *testing.T) {
+	var mutations []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		body := mustRead(req.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body, "updateIpAllowList"):
+			mutations = append(mutations, body)
+			mustWrite(w, `{"data": {}}`)
+		case strings.Contains(body, "ipAllowListEnabledSetting"):
+			mustWrite(w, `{"data": {"organization": {"ipAllowListEnabledSetting": "ENABLED",
+				"ipAllowListForInstalledAppsEnabledSetting": "DISABLED"}}}`)
+		case strings.Contains(body, "organization(login:"):
+			mustWrite(w, `{"data": {"organization": {"id": "O_1"}}}`)
+		default:
+			t.Fatalf("unexpected GraphQL call %s", body)
+		}
+	})
+
+	meta := &Owner{
+		v4client:       githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}}),
+		name:           "tf-acc-org",
+		IsOrganization: true,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationIpAllowListSettings().Schema, map[string]any{
+		"enabled": true,
+	})
+	if err := resourceGithubOrganizationIpAllowListSettingsCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mutations) != 2 || !strings.Contains(mutations[0], `"settingValue":"ENABLED"`) ||
+		!strings.Contains(mutations[1], `"settingValue":"DISABLED"`) {
+		t.Errorf("unexpected mutations %v", mutations)
+	}
+	if !d.Get("enabled").(bool) || d.Get("for_installed_apps_enabled").(bool) {
+		t.Errorf("unexpected settings enabled=%v for_installed_apps_enabled=%v", d.Get("enabled"), d.Get("for_installed_apps_enabled"))
+	}
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubOrganizationIpAllowListSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages whether the IP allow list of a GitHub organization is enabled",
+		Create:      resourceGithubOrganizationIpAllowListSettingsCreateOrUpdate,
+		Read:        resourceGithubOrganizationIpAllowListSettingsRead,
+		Update:      resourceGithubOrganizationIpAllowListSettingsCreateOrUpdate,
+		Delete:      resourceGithubOrganizationIpAllowListSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the IP allow list of the organization is enabled.",
+			},
+			"for_installed_apps_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the IP allow list configuration of the GitHub Apps installed in the organization is enforced.",
+			},
+		},
+	}
+}
+
+// ipAllowListSettingValue returns the value of an IP allow list setting.
+func ipAllowListSettingValue(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+// updateIpAllowListSettings sets the IP allow list settings of the organization.
+func updateIpAllowListSettings(ctx context.Context, meta any, enabled, forInstalledAppsEnabled bool) error {
+	client := meta.(*Owner).v4client
+
+	ownerID, err := getOrganizationNodeID(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	var enabledMutate struct {
+		UpdateIpAllowListEnabledSetting struct { // Empty struct does not work
+			ClientMutationId githubv4.ID
+		} `graphql:"updateIpAllowListEnabledSetting(input: $input)"`
+	}
+	enabledInput := githubv4.UpdateIpAllowListEnabledSettingInput{
+		OwnerID:      ownerID,
+		SettingValue: githubv4.IpAllowListEnabledSettingValue(ipAllowListSettingValue(enabled)),
+	}
+	if err := client.Mutate(ctx, &enabledMutate, enabledInput, nil); err != nil {
+		return err
+	}
+
+	var appsMutate struct {
+		UpdateIpAllowListForInstalledAppsEnabledSetting struct {
+			ClientMutationId githubv4.ID
+		} `graphql:"updateIpAllowListForInstalledAppsEnabledSetting(input: $input)"`
+	}
+	appsInput := githubv4.UpdateIpAllowListForInstalledAppsEnabledSettingInput{
+		OwnerID:      ownerID,
+		SettingValue: githubv4.IpAllowListForInstalledAppsEnabledSettingValue(ipAllowListSettingValue(forInstalledAppsEnabled)),
+	}
+	return client.Mutate(ctx, &appsMutate, appsInput, nil)
+}
+
+func resourceGithubOrganizationIpAllowListSettingsCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	if err := updateIpAllowListSettings(ctx, meta, d.Get("enabled").(bool), d.Get("for_installed_apps_enabled").(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*Owner).name)
+	return resourceGithubOrganizationIpAllowListSettingsRead(d, meta)
+}
+
+func resourceGithubOrganizationIpAllowListSettingsRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v4client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var query struct {
+		Organization struct {
+			IpAllowListEnabledSetting                 githubv4.String
+			IpAllowListForInstalledAppsEnabledSetting githubv4.String
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]any{
+		"login": githubv4.String(d.Id()),
+	}
+	if err := client.Query(ctx, &query, variables); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", query.Organization.IpAllowListEnabledSetting == "ENABLED"); err != nil {
+		return err
+	}
+	if err := d.Set("for_installed_apps_enabled", query.Organization.IpAllowListForInstalledAppsEnabledSetting == "ENABLED"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationIpAllowListSettingsDelete(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// Disable the allow list, leaving its entries
+	return updateIpAllowListSettings(ctx, meta, false, false)
+}
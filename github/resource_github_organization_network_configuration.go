@@ -0,0 +1,154 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubOrganizationNetworkConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages a hosted compute network configuration within a GitHub organization",
+		Create:      resourceGithubOrganizationNetworkConfigurationCreate,
+		Read:        resourceGithubOrganizationNetworkConfigurationRead,
+		Update:      resourceGithubOrganizationNetworkConfigurationUpdate,
+		Delete:      resourceGithubOrganizationNetworkConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringLenBetween(1, 100), "name"),
+				Description:      "Name of the network configuration. Must be between 1 and 100 characters and may only contain upper and lowercase letters a-z, numbers 0-9, '.', '-', and '_'.",
+			},
+			"compute_service": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(github.ComputeServiceActions),
+				ValidateDiagFunc: validateValueFunc([]string{string(github.ComputeServiceNone), string(github.ComputeServiceActions)}),
+				Description:      "The hosted compute service the network configuration supports. Can be one of 'none' or 'actions'. Defaults to 'actions'.",
+			},
+			"network_settings_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ID of the network settings resource, e.g. the Azure private network, to use for the network configuration.",
+			},
+			"created_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the network configuration was created.",
+			},
+		},
+	}
+}
+
+func expandNetworkConfigurationRequest(d *schema.ResourceData) github.NetworkConfigurationRequest {
+	computeService := github.ComputeService(d.Get("compute_service").(string))
+	return github.NetworkConfigurationRequest{
+		Name:               github.Ptr(d.Get("name").(string)),
+		ComputeService:     &computeService,
+		NetworkSettingsIDs: expandStringList(d.Get("network_settings_ids").([]any)),
+	}
+}
+
+func resourceGithubOrganizationNetworkConfigurationCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	networkConfiguration, _, err := client.Organizations.CreateNetworkConfiguration(ctx, orgName, expandNetworkConfigurationRequest(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(networkConfiguration.GetID())
+
+	return resourceGithubOrganizationNetworkConfigurationRead(d, meta)
+}
+
+func resourceGithubOrganizationNetworkConfigurationRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	networkConfiguration, _, err := client.Organizations.GetNetworkConfiguration(ctx, orgName, d.Id())
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "network configuration %s/%s", orgName, d.Id())
+	}
+
+	computeService := string(github.ComputeServiceNone)
+	if networkConfiguration.ComputeService != nil {
+		computeService = string(*networkConfiguration.ComputeService)
+	}
+
+	if err = d.Set("name", networkConfiguration.GetName()); err != nil {
+		return err
+	}
+	if err = d.Set("compute_service", computeService); err != nil {
+		return err
+	}
+	if err = d.Set("network_settings_ids", networkConfiguration.NetworkSettingsIDs); err != nil {
+		return err
+	}
+	if err = d.Set("created_on", networkConfiguration.GetCreatedOn().String()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationNetworkConfigurationUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, _, err = client.Organizations.UpdateNetworkConfiguration(ctx, orgName, d.Id(), expandNetworkConfigurationRequest(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationNetworkConfigurationRead(d, meta)
+}
+
+func resourceGithubOrganizationNetworkConfigurationDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[INFO] Deleting network configuration: %s/%s", orgName, d.Id())
+	_, err = client.Organizations.DeleteNetworkConfigurations(ctx, orgName, d.Id())
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "network configuration %s/%s", orgName, d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationNetworkConfiguration(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates network configurations and attaches runner groups without error", func(t *testing.T) {
+
+		// Network settings are created on the side of the cloud provider.
+		networkSettingsID := os.Getenv("GITHUB_TEST_NETWORK_SETTINGS_ID")
+		if networkSettingsID == "" {
+			t.Skip("Skipping because `GITHUB_TEST_NETWORK_SETTINGS_ID` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_organization_network_configuration" "test" {
+				name                 = "tf-acc-test-%[1]s"
+				network_settings_ids = ["%[2]s"]
+			}
+
+			resource "github_actions_runner_group" "test" {
+				name                     = "tf-acc-test-%[1]s"
+				visibility               = "all"
+				network_configuration_id = github_organization_network_configuration.test.id
+			}
+		`, randomID, networkSettingsID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_organization_network_configuration.test", "compute_service", "actions"),
+			resource.TestCheckResourceAttr("github_organization_network_configuration.test", "network_settings_ids.0", networkSettingsID),
+			resource.TestCheckResourceAttrSet("github_organization_network_configuration.test", "created_on"),
+			resource.TestCheckResourceAttrPair(
+				"github_actions_runner_group.test", "network_configuration_id",
+				"github_organization_network_configuration.test", "id",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_organization_network_configuration.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubOrganizationRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages a custom organization role in a GitHub Organization.",
+		Create:      resourceGithubOrganizationRoleCreate,
+		Read:        resourceGithubOrganizationRoleRead,
+		Update:      resourceGithubOrganizationRoleUpdate,
+		Delete:      resourceGithubOrganizationRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the organization role.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the organization role.",
+			},
+			"base_role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"read", "triage", "write", "maintain", "admin"}),
+				Description:      "The repository role granted on all the repositories of the organization by the organization role, one of 'read', 'triage', 'write', 'maintain' or 'admin'.",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The organization permissions of the organization role, e.g. 'read_audit_logs'.",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the organization role.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The source of the organization role, 'Organization' for custom organization roles.",
+			},
+		},
+	}
+}
+
+func expandOrganizationRole(d *schema.ResourceData) *github.CreateOrUpdateOrgRoleOptions {
+	opts := &github.CreateOrUpdateOrgRoleOptions{
+		Name:        github.Ptr(d.Get("name").(string)),
+		Description: github.Ptr(d.Get("description").(string)),
+		Permissions: expandStringList(d.Get("permissions").(*schema.Set).List()),
+	}
+	if baseRole, ok := d.GetOk("base_role"); ok {
+		opts.BaseRole = github.Ptr(baseRole.(string))
+	}
+	return opts
+}
+
+func resourceGithubOrganizationRoleCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	role, _, err := client.Organizations.CreateCustomOrgRole(ctx, orgName, expandOrganizationRole(d))
+	if err != nil {
+		return fmt.Errorf("error creating GitHub organization role %s (%s): %w", orgName, d.Get("name").(string), err)
+	}
+
+	d.SetId(strconv.FormatInt(role.GetID(), 10))
+	return resourceGithubOrganizationRoleRead(d, meta)
+}
+
+func resourceGithubOrganizationRoleRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	roleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	role, _, err := client.Organizations.GetOrgRole(ctx, orgName, roleID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "organization role %s (%d)", orgName, roleID)
+	}
+
+	if err = d.Set("name", role.GetName()); err != nil {
+		return err
+	}
+	if err = d.Set("description", role.GetDescription()); err != nil {
+		return err
+	}
+	if err = d.Set("base_role", role.GetBaseRole()); err != nil {
+		return err
+	}
+	if err = d.Set("permissions", role.Permissions); err != nil {
+		return err
+	}
+	if err = d.Set("role_id", role.GetID()); err != nil {
+		return err
+	}
+	if err = d.Set("source", role.GetSource()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationRoleUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	roleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	if _, _, err := client.Organizations.UpdateCustomOrgRole(ctx, orgName, roleID, expandOrganizationRole(d)); err != nil {
+		return fmt.Errorf("error updating GitHub organization role %s (%d): %w", orgName, roleID, err)
+	}
+
+	return resourceGithubOrganizationRoleRead(d, meta)
+}
+
+func resourceGithubOrganizationRoleDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	roleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	_, err = client.Organizations.DeleteCustomOrgRole(ctx, orgName, roleID)
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "organization role %s (%d)", orgName, roleID)
+}
@@ -0,0 +1,183 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubOrganizationRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Assigns a pre-defined or custom organization role to a team or a user of a GitHub Organization.",
+		Create:      resourceGithubOrganizationRoleAssignmentCreate,
+		Read:        resourceGithubOrganizationRoleAssignmentRead,
+		Delete:      resourceGithubOrganizationRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubOrganizationRoleAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the organization role to assign.",
+			},
+			"team": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"team", "user"},
+				Description:  "The slug of the team to assign the organization role to.",
+			},
+			"user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"team", "user"},
+				Description:  "The login of the user to assign the organization role to.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationRoleAssignmentCreate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	roleID := int64(d.Get("role_id").(int))
+	if team, ok := d.GetOk("team"); ok {
+		if _, err := client.Organizations.AssignOrgRoleToTeam(ctx, orgName, team.(string), roleID); err != nil {
+			return err
+		}
+		d.SetId(buildThreePartID(strconv.FormatInt(roleID, 10), "team", team.(string)))
+	} else {
+		user := d.Get("user").(string)
+		if _, err := client.Organizations.AssignOrgRoleToUser(ctx, orgName, user, roleID); err != nil {
+			return err
+		}
+		d.SetId(buildThreePartID(strconv.FormatInt(roleID, 10), "user", user))
+	}
+
+	return resourceGithubOrganizationRoleAssignmentRead(d, meta)
+}
+
+// isOrganizationRoleAssigned returns whether the organization role is
+// assigned to the team or the user, without inheritance from parent teams.
+func isOrganizationRoleAssigned(ctx context.Context, client *github.Client, orgName string, roleID int64, assigneeType, assignee string) (bool, error) {
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		var names []string
+		var resp *github.Response
+		if assigneeType == "team" {
+			teams, r, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, orgName, roleID, options)
+			if err != nil {
+				return false, err
+			}
+			for _, team := range teams {
+				names = append(names, team.GetSlug())
+			}
+			resp = r
+		} else {
+			users, r, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, orgName, roleID, options)
+			if err != nil {
+				return false, err
+			}
+			for _, user := range users {
+				names = append(names, user.GetLogin())
+			}
+			resp = r
+		}
+
+		for _, name := range names {
+			if strings.EqualFold(name, assignee) {
+				return true, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, nil
+		}
+		options.Page = resp.NextPage
+	}
+}
+
+func resourceGithubOrganizationRoleAssignmentRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	roleIDString, assigneeType, assignee, err := parseThreePartID(d.Id(), "role_id", "type", "assignee")
+	if err != nil {
+		return err
+	}
+	roleID, err := strconv.ParseInt(roleIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(roleIDString, err)
+	}
+
+	assigned, err := isOrganizationRoleAssigned(ctx, client, orgName, roleID, assigneeType, assignee)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "organization role assignment %s", d.Id())
+	}
+	if !assigned {
+		log.Printf("[INFO] Removing organization role assignment %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("role_id", roleID); err != nil {
+		return err
+	}
+	if err = d.Set(assigneeType, assignee); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationRoleAssignmentDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	roleID := int64(d.Get("role_id").(int))
+	if team, ok := d.GetOk("team"); ok {
+		_, err = client.Organizations.RemoveOrgRoleFromTeam(ctx, orgName, team.(string), roleID)
+	} else {
+		_, err = client.Organizations.RemoveOrgRoleFromUser(ctx, orgName, d.Get("user").(string), roleID)
+	}
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "organization role assignment %s", d.Id())
+}
+
+func resourceGithubOrganizationRoleAssignmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	_, assigneeType, _, err := parseThreePartID(d.Id(), "role_id", "type", "assignee")
+	if err != nil {
+		return nil, err
+	}
+	if assigneeType != "team" && assigneeType != "user" {
+		return nil, fmt.Errorf("invalid ID specified: the type of the assignee must be 'team' or 'user', got %q", assigneeType)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
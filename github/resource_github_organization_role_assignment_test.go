@@ -0,0 +1,77 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationRoleAssignment(t *testing.T) {
+	if testCollaborator == "" {
+		t.Skip("Skipping because `GITHUB_TEST_COLLABORATOR` is not set")
+	}
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("assigns an organization role to a team and a user without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_team" "test" {
+				name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_organization_role" "test" {
+				name        = "tf-acc-test-%[1]s"
+				permissions = ["read_audit_logs"]
+			}
+
+			resource "github_organization_role_assignment" "team" {
+				role_id = github_organization_role.test.role_id
+				team    = github_team.test.slug
+			}
+
+			resource "github_organization_role_assignment" "user" {
+				role_id = github_organization_role.test.role_id
+				user    = "%[2]s"
+			}
+		`, randomID, testCollaborator)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair("github_organization_role_assignment.team", "role_id", "github_organization_role.test", "role_id"),
+			resource.TestCheckResourceAttrPair("github_organization_role_assignment.team", "team", "github_team.test", "slug"),
+			resource.TestCheckResourceAttr("github_organization_role_assignment.user", "user", testCollaborator),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_organization_role_assignment.team",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubOrganizationRole(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates an organization role without error", func(t *testing.T) {
+
+		config := `
+			resource "github_organization_role" "test" {
+				name        = "tf-acc-test-%s"
+				description = "%s"
+				base_role   = "read"
+				permissions = [
+					"read_audit_logs",
+					"read_organization_custom_org_role",
+				]
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_role.test", "name", fmt.Sprintf("tf-acc-test-%s", randomID)),
+				resource.TestCheckResourceAttr("github_organization_role.test", "description", "Auditors"),
+				resource.TestCheckResourceAttr("github_organization_role.test", "base_role", "read"),
+				resource.TestCheckResourceAttr("github_organization_role.test", "permissions.#", "2"),
+				resource.TestCheckResourceAttrSet("github_organization_role.test", "role_id"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_role.test", "description", "Security auditors"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "Auditors"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "Security auditors"),
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_organization_role.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
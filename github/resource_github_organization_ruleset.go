@@ -54,13 +54,13 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 						"actor_id": {
 							Type:        schema.TypeInt,
 							Required:    true,
-							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin` or `EnterpriseOwner`, this should be set to `1`.",
 						},
 						"actor_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"RepositoryRole", "Team", "Integration", "OrganizationAdmin", "DeployKey"}, false),
-							Description:  "The type of actor that can bypass a ruleset. Can be one of: `RepositoryRole`, `Team`, `Integration`, `OrganizationAdmin`, `DeployKey`.",
+							ValidateFunc: validation.StringInSlice(bypassActorTypes, false),
+							Description:  "The type of actor that can bypass a ruleset. Can be one of: `RepositoryRole`, `Team`, `Integration`, `OrganizationAdmin`, `DeployKey`, `EnterpriseOwner`, `EnterpriseTeam`.",
 						},
 						"bypass_mode": {
 							Type:         schema.TypeString,
@@ -85,13 +85,14 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Parameters for an organization ruleset condition. `ref_name` is required alongside one of `repository_name` or `repository_id`.",
+				Description: "Parameters for an organization ruleset condition. One of `repository_name`, `repository_id` or `repository_property` is required, alongside `ref_name` for rulesets with target `branch` or `tag`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ref_name": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Targets refs by name. Required for rulesets with target `branch` or `tag`, not supported for rulesets with target `push`.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"include": {
@@ -117,8 +118,8 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 							Type:         schema.TypeList,
 							Optional:     true,
 							MaxItems:     1,
-							ExactlyOneOf: []string{"conditions.0.repository_id"},
-							AtLeastOneOf: []string{"conditions.0.repository_id"},
+							ExactlyOneOf: []string{"conditions.0.repository_id", "conditions.0.repository_property"},
+							AtLeastOneOf: []string{"conditions.0.repository_id", "conditions.0.repository_property"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"include": {
@@ -154,6 +155,29 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 								Type: schema.TypeInt,
 							},
 						},
+						"repository_property": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"conditions.0.repository_name", "conditions.0.repository_id"},
+							Description:  "Targets repositories by the values of their custom properties.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to include. All of these properties must match for the condition to pass.",
+										Elem:        repositoryPropertyConditionTargetSchema(),
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to exclude. The condition will not pass if any of these properties match.",
+										Elem:        repositoryPropertyConditionTargetSchema(),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -523,6 +547,76 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 								},
 							},
 						},
+						"file_path_restriction": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent commits that include changes in specified file paths from being pushed to the commit graph. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"restricted_file_paths": {
+										Type:        schema.TypeList,
+										MinItems:    1,
+										Required:    true,
+										Description: "The file paths that are restricted from being pushed to the commit graph.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"max_file_size": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file size. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_file_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+										Description:  "The maximum allowed size, in megabytes (MB), of a file. Valid range is 1-100 MB.",
+									},
+								},
+							},
+						},
+						"max_file_path_length": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file path length. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_file_path_length": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 256),
+										Description:  "The maximum number of characters allowed in file paths.",
+									},
+								},
+							},
+						},
+						"file_extension_restriction": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file extensions. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"restricted_file_extensions": {
+										Type:        schema.TypeSet,
+										MinItems:    1,
+										Required:    true,
+										Description: "The file extensions that are restricted from being pushed to the commit graph, e.g. `*.jar`.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -531,10 +625,43 @@ func resourceGithubOrganizationRuleset() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffPushRuleset,
+	}
+}
+
+func repositoryPropertyConditionTargetSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository property to target.",
+			},
+			"property_values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The values to match for the repository property.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "custom",
+				ValidateDiagFunc: validateValueFunc([]string{"custom", "system"}),
+				Description:      "The source of the repository property. Can be one of `custom` or `system`. Defaults to `custom`.",
+			},
+		},
 	}
 }
 
 func resourceGithubOrganizationRulesetCreate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -555,6 +682,10 @@ func resourceGithubOrganizationRulesetCreate(d *schema.ResourceData, meta any) e
 }
 
 func resourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -2,6 +2,7 @@ package github
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -24,7 +25,7 @@ func TestGithubOrganizationRulesets(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_organization_ruleset" "test" {
-				name        = "test-%s"
+				name        = "tf-acc-test-%s"
 				target      = "branch"
 				enforcement = "active"
 
@@ -122,8 +123,8 @@ func TestGithubOrganizationRulesets(t *testing.T) {
 
 	t.Run("Updates a ruleset name without error", func(t *testing.T) {
 
-		oldRSName := fmt.Sprintf(`ruleset-%[1]s`, randomID)
-		newRSName := fmt.Sprintf(`%[1]s-renamed`, randomID)
+		oldRSName := fmt.Sprintf(`tf-acc-ruleset-%[1]s`, randomID)
+		newRSName := fmt.Sprintf(`tf-acc-%[1]s-renamed`, randomID)
 
 		config := fmt.Sprintf(`
 			resource "github_organization_ruleset" "test" {
@@ -183,7 +184,7 @@ func TestGithubOrganizationRulesets(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_organization_ruleset" "test" {
-				name        = "test-%s"
+				name        = "tf-acc-test-%s"
 				target      = "branch"
 				enforcement = "active"
 
@@ -262,4 +263,179 @@ func TestGithubOrganizationRulesets(t *testing.T) {
 
 	})
 
+	t.Run("Creates a ruleset targeting repository properties without errors", func(t *testing.T) {
+		propertyName := os.Getenv("GITHUB_TEST_CUSTOM_PROPERTY")
+		if propertyName == "" {
+			t.Skip("Skipping because `GITHUB_TEST_CUSTOM_PROPERTY` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_organization_ruleset" "test" {
+				name        = "tf-acc-test-%s"
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+
+					repository_property {
+						include {
+							name            = "%s"
+							property_values = ["production"]
+						}
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+		`, randomID, propertyName)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_property.0.include.0.name", propertyName),
+			resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_property.0.include.0.property_values.0", "production"),
+			resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_property.0.include.0.source", "custom"),
+			resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_property.0.exclude.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_organization_ruleset.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an enterprise account", func(t *testing.T) {
+			testCase(t, enterprise)
+		})
+
+	})
+
+	t.Run("Creates organization ruleset with push rules", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_organization_ruleset" "test" {
+				name        = "tf-acc-test-push-%s"
+				target      = "push"
+				enforcement = "active"
+
+				conditions {
+					repository_name {
+						include = ["~ALL"]
+						exclude = []
+					}
+				}
+
+				rules {
+					file_path_restriction {
+						restricted_file_paths = ["secrets/"]
+					}
+
+					max_file_size {
+						max_file_size = 10
+					}
+
+					max_file_path_length {
+						max_file_path_length = 200
+					}
+
+					file_extension_restriction {
+						restricted_file_extensions = ["*.jar", "*.exe"]
+					}
+				}
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "target",
+				"push",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "conditions.0.ref_name.#",
+				"0",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "conditions.0.repository_name.0.include.0",
+				"~ALL",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "rules.0.file_path_restriction.0.restricted_file_paths.0",
+				"secrets/",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "rules.0.max_file_size.0.max_file_size",
+				"10",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "rules.0.max_file_path_length.0.max_file_path_length",
+				"200",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_ruleset.test", "rules.0.file_extension_restriction.0.restricted_file_extensions.#",
+				"2",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:      "github_organization_ruleset.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an enterprise account", func(t *testing.T) {
+			testCase(t, enterprise)
+		})
+
+	})
+
+}
+
+func TestExpandBypassActorsEnterprise(t *testing.T) {
+	actors := expandBypassActors([]any{
+		map[string]any{"actor_id": 1, "actor_type": "EnterpriseOwner", "bypass_mode": "always"},
+		map[string]any{"actor_id": 42, "actor_type": "EnterpriseTeam", "bypass_mode": "pull_request"},
+	})
+
+	if actors[0].ActorID != nil {
+		t.Errorf("expected no actor ID for the enterprise owners, got %d", actors[0].GetActorID())
+	}
+	if actors[1].GetActorID() != 42 {
+		t.Errorf("expected the ID of the enterprise team, got %d", actors[1].GetActorID())
+	}
+
+	flattened := flattenBypassActors(actors)
+	if id := flattened[0].(map[string]any)["actor_id"]; id != int64(1) {
+		t.Errorf("expected the enterprise owners to be flattened with the actor ID 1, got %v", id)
+	}
+	if id := flattened[1].(map[string]any)["actor_id"]; id != int64(42) {
+		t.Errorf("expected the enterprise team to keep its actor ID, got %v", id)
+	}
 }
@@ -3,11 +3,14 @@ package github
 import (
 	"context"
 	"log"
+	"slices"
+	"sort"
 	"strconv"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
 )
 
 func resourceGithubOrganizationSettings() *schema.Resource {
@@ -20,6 +23,9 @@ func resourceGithubOrganizationSettings() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: resourceGithubOrganizationSettingsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"billing_email": {
 				Type:        schema.TypeString,
@@ -128,6 +134,27 @@ func resourceGithubOrganizationSettings() *schema.Resource {
 				Default:     false,
 				Description: "Whether or not commit signatures are required for commits to the organization.",
 			},
+			"web_commit_signoff_repositories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the repositories of the organization on which 'web_commit_signoff_required' is also asserted. Those with another setting are reported in 'web_commit_signoff_drifted_repositories' and updated by the next apply.",
+			},
+			"web_commit_signoff_drifted_repositories": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the non-archived repositories of 'web_commit_signoff_repositories' whose 'web_commit_signoff_required' setting differs from the organization's, as of the last refresh.",
+			},
+			"web_commit_signoff_unmanaged_repositories": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the other non-archived repositories whose 'web_commit_signoff_required' setting differs from the organization's, as of the last refresh. They are only reported, never updated. Only reported when 'web_commit_signoff_repositories' is set.",
+			},
 			"blog": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -297,9 +324,89 @@ func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, me
 		d.SetId(id)
 	}
 
+	if managed := expandStringList(d.Get("web_commit_signoff_repositories").(*schema.Set).List()); len(managed) > 0 {
+		required := d.Get("web_commit_signoff_required").(bool)
+		drifted, _, err := listGithubWebCommitSignoffDriftedRepositories(ctx, meta, required, managed)
+		if err != nil {
+			return err
+		}
+		for _, repoName := range drifted {
+			log.Printf("[INFO] Setting web_commit_signoff_required of repository %s/%s to %t", org, repoName, required)
+			if _, _, err := client.Repositories.Edit(ctx, org, repoName, &github.Repository{WebCommitSignoffRequired: github.Ptr(required)}); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceGithubOrganizationSettingsRead(d, meta)
 }
 
+// webCommitSignoffRepository is a repository of the organization with its
+// web_commit_signoff_required setting.
+type webCommitSignoffRepository struct {
+	Name                     githubv4.String
+	IsArchived               githubv4.Boolean
+	WebCommitSignoffRequired githubv4.Boolean
+}
+
+// webCommitSignoffDriftedRepositories returns the sorted names of the
+// non-archived repositories whose web_commit_signoff_required setting is not
+// the required one, split between the managed repositories and the others.
+func webCommitSignoffDriftedRepositories(repositories []webCommitSignoffRepository, required bool, managed []string) (drifted, unmanaged []string) {
+	drifted, unmanaged = make([]string, 0), make([]string, 0)
+	for _, repo := range repositories {
+		if bool(repo.IsArchived) || bool(repo.WebCommitSignoffRequired) == required {
+			continue
+		}
+		if slices.Contains(managed, string(repo.Name)) {
+			drifted = append(drifted, string(repo.Name))
+		} else {
+			unmanaged = append(unmanaged, string(repo.Name))
+		}
+	}
+	sort.Strings(drifted)
+	sort.Strings(unmanaged)
+	return drifted, unmanaged
+}
+
+// listGithubWebCommitSignoffDriftedRepositories returns the non-archived
+// repositories of the organization whose web_commit_signoff_required setting
+// is not the required one, split between the managed repositories and the
+// others. The setting is missing from the repositories listed by the REST
+// API, they are queried with the GraphQL API instead.
+func listGithubWebCommitSignoffDriftedRepositories(ctx context.Context, meta any, required bool, managed []string) ([]string, []string, error) {
+	var query struct {
+		Organization struct {
+			Repositories struct {
+				Nodes    []webCommitSignoffRepository
+				PageInfo PageInfo
+			} `graphql:"repositories(first: $first, after: $cursor)"`
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]any{
+		"login":  githubv4.String(meta.(*Owner).name),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	client := meta.(*Owner).v4client
+	var repositories []webCommitSignoffRepository
+	for {
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return nil, nil, err
+		}
+		repositories = append(repositories, query.Organization.Repositories.Nodes...)
+
+		if !query.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.Repositories.PageInfo.EndCursor)
+	}
+
+	drifted, unmanaged := webCommitSignoffDriftedRepositories(repositories, required, managed)
+	return drifted, unmanaged, nil
+}
+
 func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta any) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -371,6 +478,19 @@ func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta any) er
 	if err = d.Set("web_commit_signoff_required", orgSettings.GetWebCommitSignoffRequired()); err != nil {
 		return err
 	}
+	drifted, unmanaged := make([]string, 0), make([]string, 0)
+	if managed := expandStringList(d.Get("web_commit_signoff_repositories").(*schema.Set).List()); len(managed) > 0 {
+		drifted, unmanaged, err = listGithubWebCommitSignoffDriftedRepositories(ctx, meta, orgSettings.GetWebCommitSignoffRequired(), managed)
+		if err != nil {
+			return err
+		}
+	}
+	if err = d.Set("web_commit_signoff_drifted_repositories", drifted); err != nil {
+		return err
+	}
+	if err = d.Set("web_commit_signoff_unmanaged_repositories", unmanaged); err != nil {
+		return err
+	}
 	if err = d.Set("blog", orgSettings.GetBlog()); err != nil {
 		return err
 	}
@@ -517,3 +637,18 @@ func resourceGithubOrganizationSettingsDelete(d *schema.ResourceData, meta any)
 
 	return nil
 }
+
+// resourceGithubOrganizationSettingsDiff plans an update when the last
+// refresh found repositories of web_commit_signoff_repositories with another
+// web_commit_signoff_required setting, so that the setting of the
+// organization is asserted on them. Both lists are recomputed when the
+// setting or the repositories change.
+func resourceGithubOrganizationSettingsDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() != "" && (diff.HasChange("web_commit_signoff_required") || diff.HasChange("web_commit_signoff_repositories")) {
+		if err := diff.SetNewComputed("web_commit_signoff_unmanaged_repositories"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("web_commit_signoff_drifted_repositories")
+	}
+	return planRefreshedDrift(diff, "web_commit_signoff_drifted_repositories")
+}
@@ -2,11 +2,38 @@ package github
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestWebCommitSignoffDriftedRepositories(t *testing.T) {
+	repositories := []webCommitSignoffRepository{
+		{Name: "signed", WebCommitSignoffRequired: true},
+		{Name: "unsigned-b", WebCommitSignoffRequired: false},
+		{Name: "unsigned-a", WebCommitSignoffRequired: false},
+		{Name: "archived", IsArchived: true, WebCommitSignoffRequired: false},
+	}
+
+	drifted, unmanaged := webCommitSignoffDriftedRepositories(repositories, true, []string{"unsigned-a", "signed", "archived"})
+	if !reflect.DeepEqual(drifted, []string{"unsigned-a"}) {
+		t.Errorf("expected the managed unsigned repository to drift, got %v", drifted)
+	}
+	if !reflect.DeepEqual(unmanaged, []string{"unsigned-b"}) {
+		t.Errorf("expected the unmanaged unsigned repository to be reported, got %v", unmanaged)
+	}
+
+	drifted, unmanaged = webCommitSignoffDriftedRepositories(repositories, false, []string{"signed"})
+	if !reflect.DeepEqual(drifted, []string{"signed"}) {
+		t.Errorf("expected the signed repository to drift, got %v", drifted)
+	}
+	if len(unmanaged) != 0 {
+		t.Errorf("expected no unmanaged repository to be reported, got %v", unmanaged)
+	}
+}
+
 func TestAccGithubOrganizationSettings(t *testing.T) {
 	t.Run("creates organization settings without error", func(t *testing.T) {
 
@@ -132,6 +159,55 @@ func TestAccGithubOrganizationSettings(t *testing.T) {
 		})
 	})
 
+	t.Run("asserts web commit signoff on repositories without error", func(t *testing.T) {
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := fmt.Sprintf(`
+		resource "github_organization_settings" "test" {
+			billing_email                   = "test@example.com"
+			web_commit_signoff_required     = true
+			web_commit_signoff_repositories = [github_repository.test.name]
+		}
+
+		resource "github_repository" "test" {
+			name = "tf-acc-test-signoff-%s"
+
+			lifecycle {
+				ignore_changes = [web_commit_signoff_required]
+			}
+		}`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_organization_settings.test", "web_commit_signoff_required", "true"),
+			resource.TestCheckResourceAttr("github_organization_settings.test", "web_commit_signoff_drifted_repositories.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+
 	t.Run("imports organization settings without error", func(t *testing.T) {
 		billingEmail := "test@example.com"
 		company := "Test Company"
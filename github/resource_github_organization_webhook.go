@@ -2,12 +2,15 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGithubOrganizationWebhook() *schema.Resource {
@@ -19,7 +22,7 @@ func resourceGithubOrganizationWebhook() *schema.Resource {
 		Update:      resourceGithubOrganizationWebhookUpdate,
 		Delete:      resourceGithubOrganizationWebhookDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGithubOrganizationWebhookImport,
 		},
 
 		SchemaVersion: 1,
@@ -45,6 +48,19 @@ func resourceGithubOrganizationWebhook() *schema.Resource {
 				Default:     true,
 				Description: "Indicate if the webhook should receive events.",
 			},
+			"test_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a ping event after the webhook is created or updated and fail if it is not delivered successfully.",
+			},
+			"test_timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          30,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "test_timeout"),
+				Description:      "The number of seconds to wait for the ping event to be delivered when 'test_on_create' is set.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -53,6 +69,52 @@ func resourceGithubOrganizationWebhook() *schema.Resource {
 	}
 }
 
+// webhookDeliveryPollInterval is the delay between two lookups of the
+// deliveries of a webhook while waiting for a ping to be delivered.
+const webhookDeliveryPollInterval = 2 * time.Second
+
+// testGithubOrganizationWebhook pings the webhook and waits until the ping is
+// delivered, returning an error if the endpoint did not respond successfully.
+func testGithubOrganizationWebhook(ctx context.Context, client *github.Client, orgName string, hookID int64, timeout time.Duration) error {
+	options := &github.ListCursorOptions{PerPage: 10}
+
+	// Deliveries are listed most recent first.
+	lastDeliveryID := int64(0)
+	deliveries, _, err := client.Organizations.ListHookDeliveries(ctx, orgName, hookID, options)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) > 0 {
+		lastDeliveryID = deliveries[0].GetID()
+	}
+
+	log.Printf("[DEBUG] Pinging organization webhook %s/%d", orgName, hookID)
+	if _, err = client.Organizations.PingHook(ctx, orgName, hookID); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(webhookDeliveryPollInterval)
+
+		deliveries, _, err := client.Organizations.ListHookDeliveries(ctx, orgName, hookID, options)
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if delivery.GetID() <= lastDeliveryID || delivery.GetEvent() != "ping" {
+				continue
+			}
+			if delivery.GetStatusCode() < 200 || delivery.GetStatusCode() > 299 {
+				return fmt.Errorf("the ping of organization webhook %s/%d was not delivered successfully: %d %s", orgName, hookID, delivery.GetStatusCode(), delivery.GetStatus())
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the ping of organization webhook %s/%d was not delivered within %s", orgName, hookID, timeout)
+}
+
 func resourceGithubOrganizationWebhookObject(d *schema.ResourceData) *github.Hook {
 	events := []string{}
 	eventSet := d.Get("events").(*schema.Set)
@@ -104,6 +166,12 @@ func resourceGithubOrganizationWebhookCreate(d *schema.ResourceData, meta any) e
 		return err
 	}
 
+	if d.Get("test_on_create").(bool) {
+		if err = testGithubOrganizationWebhook(ctx, client, orgName, hook.GetID(), time.Duration(d.Get("test_timeout").(int))*time.Second); err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubOrganizationWebhookRead(d, meta)
 }
 
@@ -195,9 +263,27 @@ func resourceGithubOrganizationWebhookUpdate(d *schema.ResourceData, meta any) e
 		return err
 	}
 
+	if d.Get("test_on_create").(bool) {
+		if err = testGithubOrganizationWebhook(ctx, client, orgName, hookID, time.Duration(d.Get("test_timeout").(int))*time.Second); err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubOrganizationWebhookRead(d, meta)
 }
 
+func resourceGithubOrganizationWebhookImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// The delivery test settings only exist in the configuration.
+	if err := d.Set("test_on_create", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("test_timeout", 30); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGithubOrganizationWebhookDelete(d *schema.ResourceData, meta any) error {
 	err := checkOrganization(meta)
 	if err != nil {
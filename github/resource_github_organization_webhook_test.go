@@ -2,6 +2,8 @@ package github
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -140,4 +142,98 @@ func TestAccGithubOrganizationWebhook(t *testing.T) {
 		})
 	})
 
+	t.Run("tests the delivery of form webhooks without error", func(t *testing.T) {
+		webhookURL := os.Getenv("GITHUB_TEST_WEBHOOK_URL")
+		if webhookURL == "" {
+			t.Skip("Skipping because `GITHUB_TEST_WEBHOOK_URL` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_organization_webhook" "test" {
+			  configuration {
+			    url          = "%s"
+			    content_type = "form"
+			  }
+
+			  events         = ["issues"]
+			  test_on_create = true
+			}
+		`, webhookURL)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(
+				"github_organization_webhook.test", "configuration.0.content_type",
+				"form",
+			),
+			resource.TestCheckResourceAttr(
+				"github_organization_webhook.test", "test_on_create",
+				"true",
+			),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+
+	t.Run("fails when the ping is not delivered successfully", func(t *testing.T) {
+
+		config := `
+			resource "github_organization_webhook" "test" {
+			  configuration {
+			    url          = "https://google.de/webhook"
+			    content_type = "form"
+			  }
+
+			  events         = ["issues"]
+			  test_on_create = true
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile("was not delivered"),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
 }
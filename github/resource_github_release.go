@@ -2,10 +2,17 @@ package github
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +28,7 @@ func resourceGithubRelease() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubReleaseImport,
 		},
+		CustomizeDiff: resourceGithubReleaseDiff,
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
@@ -79,6 +87,22 @@ func resourceGithubRelease() *schema.Resource {
 				ForceNew:    true,
 				Description: "If specified, a discussion of the specified category is created and linked to the release. The value must be a category that already exists in the repository.",
 			},
+			"checksums_asset_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of an asset listing the SHA256 checksums of the other assets of the release in the format of `sha256sum`, e.g. `SHA256SUMS`, published and kept up to date by the provider. Disabled if not set.",
+			},
+			"asset_checksums": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA256 checksums of the assets of the release by name, as published in the checksums asset.",
+			},
+			"checksums_outdated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the checksums asset was found missing or outdated by the last refresh, in which case it is published again by the next apply.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,7 +207,11 @@ func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta any) error {
 			log.Printf("[DEBUG] Response from creating release: %#v", *resp)
 		}
 	} else {
-		number := d.Get("number").(int64)
+		var number int64
+		number, err = strconv.ParseInt(d.Id(), 10, 64)
+		if err != nil {
+			return unconvertibleIdErr(d.Id(), err)
+		}
 		log.Printf("[DEBUG] Updating release: %d:%s (%s/%s)",
 			number, targetCommitish, owner, repoName)
 		release, resp, err = client.Repositories.EditRelease(ctx, owner, repoName, number, req)
@@ -196,9 +224,262 @@ func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta any) error {
 		return err
 	}
 	transformResponseToResourceData(d, release, repoName)
+
+	if d.IsNewResource() || d.HasChanges("checksums_asset_name", "checksums_outdated") {
+		return publishReleaseChecksums(ctx, d, meta)
+	}
+
+	entry := recordReleaseChecksums(meta, release.GetID())
+	entry.Lock()
+	entry.repoName, entry.name = repoName, d.Get("checksums_asset_name").(string)
+	entry.Unlock()
 	return nil
 }
 
+// listReleaseAssets returns the assets of a release other than the checksums
+// asset, and the checksums asset if it exists.
+func listReleaseAssets(ctx context.Context, meta any, repoName string, releaseID int64, checksumsName string) ([]*github.ReleaseAsset, *github.ReleaseAsset, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	var assets []*github.ReleaseAsset
+	var checksumsAsset *github.ReleaseAsset
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		page, resp, err := client.Repositories.ListReleaseAssets(ctx, owner, repoName, releaseID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, asset := range page {
+			if asset.GetName() == checksumsName {
+				checksumsAsset = asset
+				continue
+			}
+			assets = append(assets, asset)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return assets, checksumsAsset, nil
+}
+
+// releaseAssetChecksums returns the SHA256 checksums of release assets by
+// name. The checksums are taken from the digests of the assets, or computed by
+// downloading the assets uploaded before GitHub recorded them.
+func releaseAssetChecksums(ctx context.Context, meta any, repoName string, assets []*github.ReleaseAsset) (map[string]string, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	checksums := make(map[string]string)
+	for _, asset := range assets {
+		if digest, ok := strings.CutPrefix(asset.GetDigest(), "sha256:"); ok {
+			checksums[asset.GetName()] = digest
+			continue
+		}
+
+		log.Printf("[DEBUG] Downloading release asset %s to compute its checksum", asset.GetName())
+		rc, _, err := client.Repositories.DownloadReleaseAsset(ctx, owner, repoName, asset.GetID(), http.DefaultClient)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.New()
+		_, err = io.Copy(hash, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		checksums[asset.GetName()] = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	return checksums, nil
+}
+
+// releaseChecksumsOutdated returns the SHA256 checksums of release assets by
+// name, and whether the checksums asset is missing or lists other checksums,
+// without downloading any asset: the digest of the checksums asset is
+// compared with the one of the checksums of the assets. The published
+// checksums stand in for the assets uploaded before GitHub recorded digests.
+func releaseChecksumsOutdated(assets []*github.ReleaseAsset, checksumsAsset *github.ReleaseAsset, published map[string]string) (map[string]string, bool) {
+	checksums := make(map[string]string)
+	for _, asset := range assets {
+		digest, ok := strings.CutPrefix(asset.GetDigest(), "sha256:")
+		if !ok {
+			if digest, ok = published[asset.GetName()]; !ok {
+				return nil, true
+			}
+		}
+		checksums[asset.GetName()] = digest
+	}
+
+	if len(checksums) == 0 {
+		return checksums, checksumsAsset != nil
+	}
+	if checksumsAsset == nil {
+		return nil, true
+	}
+	sum := sha256.Sum256([]byte(formatReleaseChecksums(checksums)))
+	return checksums, checksumsAsset.GetDigest() != "sha256:"+hex.EncodeToString(sum[:])
+}
+
+// formatReleaseChecksums returns the checksums in the format of sha256sum,
+// sorted by asset name.
+func formatReleaseChecksums(checksums map[string]string) string {
+	names := make([]string, 0, len(checksums))
+	for name := range checksums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sums strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sums, "%s  %s\n", checksums[name], name)
+	}
+	return sums.String()
+}
+
+// releaseChecksumsEntry is the checksums asset of a release created or
+// updated by this run, which the github_release_asset resources of the
+// release publish again after uploading or deleting their asset. The lock
+// serializes the publications.
+type releaseChecksumsEntry struct {
+	sync.Mutex
+	repoName string
+	name     string
+}
+
+// recordReleaseChecksums returns the checksums asset entry of a release,
+// recording it for the github_release_asset resources of the release.
+func recordReleaseChecksums(meta any, releaseID int64) *releaseChecksumsEntry {
+	v, _ := meta.(*Owner).releaseChecksums.LoadOrStore(releaseID, &releaseChecksumsEntry{})
+	return v.(*releaseChecksumsEntry)
+}
+
+// uploadReleaseChecksums replaces the checksums asset of the release with the
+// checksums of its current assets and returns them. The checksums asset is
+// removed while the release has no other assets.
+func uploadReleaseChecksums(ctx context.Context, meta any, repoName string, releaseID int64, checksumsName string) (map[string]string, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	assets, checksumsAsset, err := listReleaseAssets(ctx, meta, repoName, releaseID, checksumsName)
+	if err != nil {
+		return nil, err
+	}
+	checksums, err := releaseAssetChecksums(ctx, meta, repoName, assets)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksumsAsset != nil {
+		log.Printf("[DEBUG] Deleting release checksums asset %s", checksumsName)
+		if _, err := client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, checksumsAsset.GetID()); err != nil {
+			return nil, err
+		}
+	}
+	if len(checksums) == 0 {
+		log.Printf("[DEBUG] Not publishing the checksums of release %d of %s/%s as it has no assets", releaseID, owner, repoName)
+		return checksums, nil
+	}
+
+	file, err := os.CreateTemp("", "terraform-provider-github-checksums-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err = file.WriteString(formatReleaseChecksums(checksums)); err != nil {
+		return nil, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Uploading release checksums asset %s", checksumsName)
+	if _, _, err = client.Repositories.UploadReleaseAsset(ctx, owner, repoName, releaseID, &github.UploadOptions{
+		Name:      checksumsName,
+		MediaType: "text/plain",
+	}, file); err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+// publishReleaseChecksums replaces the checksums asset of the release with the
+// checksums of its current assets, and removes the previous checksums asset
+// when its name changes.
+func publishReleaseChecksums(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	releaseID := int64(d.Get("release_id").(int))
+
+	entry := recordReleaseChecksums(meta, releaseID)
+	entry.Lock()
+	defer entry.Unlock()
+
+	oldName, newName := d.GetChange("checksums_asset_name")
+	entry.repoName, entry.name = repoName, newName.(string)
+	if oldName.(string) != "" && oldName.(string) != newName.(string) {
+		_, checksumsAsset, err := listReleaseAssets(ctx, meta, repoName, releaseID, oldName.(string))
+		if err != nil {
+			return err
+		}
+		if checksumsAsset != nil {
+			log.Printf("[DEBUG] Deleting release checksums asset %s", checksumsAsset.GetName())
+			if _, err := client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, checksumsAsset.GetID()); err != nil {
+				return err
+			}
+		}
+	}
+
+	checksums := make(map[string]string)
+	if entry.name != "" {
+		var err error
+		if checksums, err = uploadReleaseChecksums(ctx, meta, repoName, releaseID, entry.name); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("asset_checksums", checksums); err != nil {
+		return err
+	}
+	return d.Set("checksums_outdated", false)
+}
+
+// republishReleaseChecksums publishes the checksums asset of a release again
+// after one of its assets changed, when the release was created or updated by
+// this run with a checksums asset. Otherwise the release shows the change on
+// the next plan.
+func republishReleaseChecksums(ctx context.Context, meta any, releaseID int64) error {
+	v, ok := meta.(*Owner).releaseChecksums.Load(releaseID)
+	if !ok {
+		return nil
+	}
+	entry := v.(*releaseChecksumsEntry)
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.name == "" {
+		return nil
+	}
+	_, err := uploadReleaseChecksums(ctx, meta, entry.repoName, releaseID, entry.name)
+	return err
+}
+
+// resourceGithubReleaseDiff plans the publication of the checksums asset when
+// the last refresh found it outdated. The assets are only listed when
+// refreshing, so that planning makes no request.
+func resourceGithubReleaseDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Get("checksums_asset_name").(string) == "" || d.HasChange("checksums_asset_name") {
+		return nil
+	}
+	return planRefreshedDrift(d, "checksums_outdated", "asset_checksums")
+}
+
 func resourceGithubReleaseRead(d *schema.ResourceData, meta any) error {
 	repository := d.Get("repository").(string)
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
@@ -224,6 +505,27 @@ func resourceGithubReleaseRead(d *schema.ResourceData, meta any) error {
 		return err
 	}
 	transformResponseToResourceData(d, release, repository)
+
+	if checksumsName := d.Get("checksums_asset_name").(string); checksumsName != "" {
+		assets, checksumsAsset, err := listReleaseAssets(ctx, meta, repository, releaseID, checksumsName)
+		if err != nil {
+			return err
+		}
+		published := make(map[string]string)
+		for name, checksum := range d.Get("asset_checksums").(map[string]any) {
+			published[name] = checksum.(string)
+		}
+
+		checksums, outdated := releaseChecksumsOutdated(assets, checksumsAsset, published)
+		if outdated {
+			log.Printf("[DEBUG] The checksums asset of release %d is outdated", releaseID)
+		} else if err = d.Set("asset_checksums", checksums); err != nil {
+			return err
+		}
+		if err = d.Set("checksums_outdated", outdated); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,317 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubReleaseAsset() *schema.Resource {
+	return &schema.Resource{
+		Description: "Uploads and manages an asset of a release within a GitHub repository",
+		Create:      resourceGithubReleaseAssetCreate,
+		Read:        resourceGithubReleaseAssetRead,
+		Update:      resourceGithubReleaseAssetUpdate,
+		Delete:      resourceGithubReleaseAssetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGithubReleaseAssetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"release_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the release.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The file name of the asset.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the asset shown instead of its name.",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source", "content_base64"},
+				Description:  "The path of the local file to upload.",
+			},
+			"content_base64": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"source", "content_base64"},
+				ValidateDiagFunc: toDiagFunc(validation.StringIsBase64, "content_base64"),
+				Description:      "The content of the asset in Base64 format.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "application/octet-stream",
+				Description: "The media type of the asset.",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 checksum of the content of the asset. The asset is replaced when the checksum of the content to upload differs.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the asset in bytes.",
+			},
+			"browser_download_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL to download the asset.",
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "GraphQL global node id for use with v4 API.",
+			},
+		},
+	}
+}
+
+// releaseAssetContent returns the content to upload as a release asset.
+func releaseAssetContent(source, contentBase64 string) ([]byte, error) {
+	if source != "" {
+		return os.ReadFile(source)
+	}
+	return base64.StdEncoding.DecodeString(contentBase64)
+}
+
+// releaseAssetChecksum returns the SHA256 checksum of the content of a release
+// asset.
+func releaseAssetChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// resourceGithubReleaseAssetDiff plans the replacement of the asset when the
+// checksum of the content to upload differs from the one of the uploaded
+// asset, including when the local file changed or the asset was replaced
+// outside of Terraform.
+func resourceGithubReleaseAssetDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content_base64") {
+		return nil
+	}
+
+	content, err := releaseAssetContent(d.Get("source").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return fmt.Errorf("error reading the content of release asset %s: %w", d.Get("name").(string), err)
+	}
+	checksum := releaseAssetChecksum(content)
+	if d.Get("checksum").(string) == checksum {
+		return nil
+	}
+
+	if err := d.SetNew("checksum", checksum); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("checksum")
+}
+
+// republishReleaseAssetChecksums publishes the checksums asset of the release
+// of an asset again, when the release has one. A failure is only logged, as
+// the asset itself was written: the release shows the outdated checksums on
+// the next plan.
+func republishReleaseAssetChecksums(ctx context.Context, meta any, releaseID int64) {
+	if err := republishReleaseChecksums(ctx, meta, releaseID); err != nil {
+		log.Printf("[WARN] Error publishing the checksums of release %d: %s", releaseID, err)
+	}
+}
+
+func resourceGithubReleaseAssetCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	releaseID := int64(d.Get("release_id").(int))
+	name := d.Get("name").(string)
+	ctx := context.Background()
+
+	content, err := releaseAssetContent(d.Get("source").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return err
+	}
+
+	// The upload requires a file, the content is copied to a temporary one
+	file, err := os.CreateTemp("", "terraform-provider-github-asset-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err = file.Write(content); err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Uploading release asset %s to release %d of %s/%s", name, releaseID, owner, repoName)
+	asset, _, err := client.Repositories.UploadReleaseAsset(ctx, owner, repoName, releaseID, &github.UploadOptions{
+		Name:      name,
+		Label:     d.Get("label").(string),
+		MediaType: d.Get("content_type").(string),
+	}, file)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildThreePartID(repoName, strconv.FormatInt(releaseID, 10), strconv.FormatInt(asset.GetID(), 10)))
+	if err = d.Set("checksum", releaseAssetChecksum(content)); err != nil {
+		return err
+	}
+	republishReleaseAssetChecksums(ctx, meta, releaseID)
+	return resourceGithubReleaseAssetRead(d, meta)
+}
+
+func resourceGithubReleaseAssetRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, releaseIDString, assetIDString, err := parseThreePartID(d.Id(), "repository", "release_id", "asset_id")
+	if err != nil {
+		return err
+	}
+	releaseID, err := strconv.ParseInt(releaseIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(releaseIDString, err)
+	}
+	assetID, err := strconv.ParseInt(assetIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(assetIDString, err)
+	}
+
+	asset, _, err := client.Repositories.GetReleaseAsset(ctx, owner, repoName, assetID)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing release asset %s from state because it no longer exists in GitHub", d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("release_id", releaseID); err != nil {
+		return err
+	}
+	if err = d.Set("name", asset.GetName()); err != nil {
+		return err
+	}
+	if err = d.Set("label", asset.GetLabel()); err != nil {
+		return err
+	}
+	if err = d.Set("content_type", asset.GetContentType()); err != nil {
+		return err
+	}
+	if err = d.Set("size", asset.GetSize()); err != nil {
+		return err
+	}
+	if err = d.Set("browser_download_url", asset.GetBrowserDownloadURL()); err != nil {
+		return err
+	}
+	if err = d.Set("node_id", asset.GetNodeID()); err != nil {
+		return err
+	}
+	// The assets uploaded before GitHub recorded their digests keep the
+	// checksum of their upload.
+	if digest, ok := strings.CutPrefix(asset.GetDigest(), "sha256:"); ok {
+		if err = d.Set("checksum", digest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubReleaseAssetUpdate(d *schema.ResourceData, meta any) error {
+	if !d.HasChanges("name", "label") {
+		return resourceGithubReleaseAssetRead(d, meta)
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, _, assetIDString, err := parseThreePartID(d.Id(), "repository", "release_id", "asset_id")
+	if err != nil {
+		return err
+	}
+	assetID, err := strconv.ParseInt(assetIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(assetIDString, err)
+	}
+
+	if _, _, err = client.Repositories.EditReleaseAsset(ctx, owner, repoName, assetID, &github.ReleaseAsset{
+		Name:  github.Ptr(d.Get("name").(string)),
+		Label: github.Ptr(d.Get("label").(string)),
+	}); err != nil {
+		return err
+	}
+	if d.HasChange("name") {
+		republishReleaseAssetChecksums(ctx, meta, int64(d.Get("release_id").(int)))
+	}
+
+	return resourceGithubReleaseAssetRead(d, meta)
+}
+
+func resourceGithubReleaseAssetDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, releaseIDString, assetIDString, err := parseThreePartID(d.Id(), "repository", "release_id", "asset_id")
+	if err != nil {
+		return err
+	}
+	releaseID, err := strconv.ParseInt(releaseIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(releaseIDString, err)
+	}
+	assetID, err := strconv.ParseInt(assetIDString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(assetIDString, err)
+	}
+
+	_, err = client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, assetID)
+	if err == nil {
+		republishReleaseAssetChecksums(ctx, meta, releaseID)
+	}
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "release asset %s", d.Id())
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubReleaseAssetCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/acme/app/releases/1/assets", func(w http.ResponseWriter, req *http.Request) {
+		if name := req.URL.Query().Get("name"); name != "app.txt" {
+			t.Errorf("unexpected asset name %s", name)
+		}
+		if contentType := req.Header.Get("Content-Type"); contentType != "text/plain" {
+			t.Errorf("unexpected content type %s", contentType)
+		}
+		if body := mustRead(req.Body); body != "hello\n" {
+			t.Errorf("unexpected asset content %q", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 7, "name": "app.txt"}`)
+	})
+	mux.HandleFunc("GET /repos/acme/app/releases/assets/7", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 7, "name": "app.txt", "content_type": "text/plain", "size": 6,
+			"browser_download_url": "https://github.com/acme/app/releases/download/v1.0.0/app.txt"}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	client.UploadURL, _ = url.Parse("https://uploads.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubReleaseAsset().Schema, map[string]any{
+		"repository":     "app",
+		"release_id":     1,
+		"name":           "app.txt",
+		"content_base64": base64.StdEncoding.EncodeToString([]byte("hello\n")),
+		"content_type":   "text/plain",
+	})
+	if err := resourceGithubReleaseAssetCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "app:1:7" {
+		t.Errorf("expected the ID to be app:1:7, got %s", d.Id())
+	}
+	for key, expected := range map[string]any{
+		"checksum":             "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03",
+		"size":                 6,
+		"browser_download_url": "https://github.com/acme/app/releases/download/v1.0.0/app.txt",
+	} {
+		if got := d.Get(key); got != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, got)
+		}
+	}
+}
@@ -1,11 +1,17 @@
 package github
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
+	"net/http"
+	"net/url"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -206,3 +212,78 @@ func importReleaseByResourcePaths(repoLogicalName, releaseLogicalName string) re
 		return fmt.Sprintf("%s:%s", repoID, releaseID), nil
 	}
 }
+
+func TestReleaseAssetChecksums(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/tool/releases/1/assets", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"id": 10, "name": "tool-linux-amd64", "digest": "sha256:0123abcd"},
+			{"id": 11, "name": "tool-darwin-arm64"},
+			{"id": 12, "name": "SHA256SUMS", "digest": "sha256:ffff"}
+		]`)
+	})
+	mux.HandleFunc("GET /repos/acme/tool/releases/assets/11", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		mustWrite(w, "hello")
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	assets, checksumsAsset, err := listReleaseAssets(context.Background(), meta, "tool", 1, "SHA256SUMS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checksumsAsset.GetID() != 12 {
+		t.Errorf("expected the checksums asset to be found, got %v", checksumsAsset)
+	}
+	checksums, err := releaseAssetChecksums(context.Background(), meta, "tool", assets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  tool-darwin-arm64\n" +
+		"0123abcd  tool-linux-amd64\n"
+	if sums := formatReleaseChecksums(checksums); sums != expected {
+		t.Errorf("expected checksums\n%s\ngot\n%s", expected, sums)
+	}
+}
+
+func TestReleaseChecksumsOutdated(t *testing.T) {
+	assets := []*github.ReleaseAsset{
+		{Name: github.Ptr("tool-linux-amd64"), Digest: github.Ptr("sha256:0123abcd")},
+		{Name: github.Ptr("tool-darwin-arm64")},
+	}
+	published := map[string]string{"tool-darwin-arm64": "4567ef01"}
+	sum := sha256.Sum256([]byte("4567ef01  tool-darwin-arm64\n0123abcd  tool-linux-amd64\n"))
+	current := &github.ReleaseAsset{Name: github.Ptr("SHA256SUMS"), Digest: github.Ptr("sha256:" + hex.EncodeToString(sum[:]))}
+	stale := &github.ReleaseAsset{Name: github.Ptr("SHA256SUMS"), Digest: github.Ptr("sha256:ffff")}
+
+	for _, tc := range []struct {
+		name           string
+		checksumsAsset *github.ReleaseAsset
+		published      map[string]string
+		outdated       bool
+	}{
+		{"up to date", current, published, false},
+		{"assets changed", stale, published, true},
+		{"checksums asset deleted", nil, published, true},
+		{"asset without digest not published", current, map[string]string{}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			checksums, outdated := releaseChecksumsOutdated(assets, tc.checksumsAsset, tc.published)
+			if outdated != tc.outdated {
+				t.Fatalf("expected outdated to be %t, got %t", tc.outdated, outdated)
+			}
+			if !outdated && checksums["tool-linux-amd64"] != "0123abcd" {
+				t.Errorf("expected the checksum from the digest, got %v", checksums)
+			}
+		})
+	}
+
+	if _, outdated := releaseChecksumsOutdated(nil, nil, nil); outdated {
+		t.Error("expected a release without assets nor checksums asset to be up to date")
+	}
+}
@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,12 +26,7 @@ func resourceGithubRepository() *schema.Resource {
 		Update:      resourceGithubRepositoryUpdate,
 		Delete:      resourceGithubRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				if err := d.Set("auto_init", false); err != nil {
-					return nil, err
-				}
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceGithubRepositoryImport,
 		},
 
 		SchemaVersion: 1,
@@ -169,7 +168,7 @@ func resourceGithubRepository() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Set to 'false' to disable rebase merges on the repository.",
+				Description: "Set to 'false' to disable rebase merges on the repository. At least one of 'allow_merge_commit', 'allow_squash_merge' and 'allow_rebase_merge' must be true.",
 			},
 			"allow_auto_merge": {
 				Type:        schema.TypeBool,
@@ -181,25 +180,25 @@ func resourceGithubRepository() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "COMMIT_OR_PR_TITLE",
-				Description: "Can be 'PR_TITLE' or 'COMMIT_OR_PR_TITLE' for a default squash merge commit title.",
+				Description: "Can be 'PR_TITLE' or 'COMMIT_OR_PR_TITLE' for a default squash merge commit title. Can only be set when 'allow_squash_merge' is true.",
 			},
 			"squash_merge_commit_message": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "COMMIT_MESSAGES",
-				Description: "Can be 'PR_BODY', 'COMMIT_MESSAGES', or 'BLANK' for a default squash merge commit message.",
+				Description: "Can be 'PR_BODY', 'COMMIT_MESSAGES', or 'BLANK' for a default squash merge commit message. Must be 'COMMIT_MESSAGES' when 'squash_merge_commit_title' is 'COMMIT_OR_PR_TITLE'. Can only be set when 'allow_squash_merge' is true.",
 			},
 			"merge_commit_title": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "MERGE_MESSAGE",
-				Description: "Can be 'PR_TITLE' or 'MERGE_MESSAGE' for a default merge commit title.",
+				Description: "Can be 'PR_TITLE' or 'MERGE_MESSAGE' for a default merge commit title. Can only be set when 'allow_merge_commit' is true.",
 			},
 			"merge_commit_message": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "PR_TITLE",
-				Description: "Can be 'PR_BODY', 'PR_TITLE', or 'BLANK' for a default merge commit message.",
+				Description: "Can be 'PR_BODY', 'PR_TITLE', or 'BLANK' for a default merge commit message. Must be 'PR_TITLE' when 'merge_commit_title' is 'MERGE_MESSAGE'. Can only be set when 'allow_merge_commit' is true.",
 			},
 			"delete_branch_on_merge": {
 				Type:        schema.TypeBool,
@@ -286,6 +285,12 @@ func resourceGithubRepository() *schema.Resource {
 							Optional:    true,
 							Description: "The custom domain for the repository. This can only be set after the repository has been created.",
 						},
+						"https_enforced": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the rendered GitHub Pages site is only served over HTTPS.",
+						},
 						"custom_404": {
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -408,7 +413,10 @@ func resourceGithubRepository() *schema.Resource {
 				Description: " Set to 'true' to always suggest updating pull request branches.",
 			},
 		},
-		CustomizeDiff: customDiffFunction,
+		CustomizeDiff: customdiff.All(
+			customDiffFunction,
+			customizeDiffMergeSettings,
+		),
 	}
 }
 
@@ -525,6 +533,19 @@ func resourceGithubRepositoryObject(d *schema.ResourceData) *github.Repository {
 	return repository
 }
 
+// checkInternalVisibility rejects the 'internal' visibility when the owner of
+// the provider is a user, since only organizations can have internal
+// repositories.
+func checkInternalVisibility(d *schema.ResourceData, meta any) error {
+	if d.Get("visibility").(string) != "internal" {
+		return nil
+	}
+	if err := checkOrganization(meta); err != nil {
+		return fmt.Errorf("repository %s cannot be internal: %w", d.Get("name").(string), err)
+	}
+	return nil
+}
+
 func resourceGithubRepositoryCreate(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
@@ -553,6 +574,9 @@ func resourceGithubRepositoryCreate(d *schema.ResourceData, meta any) error {
 			isPrivate = true
 		}
 	}
+	if err := checkInternalVisibility(d, meta); err != nil {
+		return err
+	}
 
 	repoReq.Private = github.Ptr(isPrivate)
 
@@ -577,30 +601,40 @@ func resourceGithubRepositoryCreate(d *schema.ResourceData, meta any) error {
 				IncludeAllBranches: github.Ptr(includeAllBranches),
 			}
 
-			repo, _, err := client.Repositories.CreateFromTemplate(ctx,
-				templateRepoOwner,
-				templateRepo,
-				&templateRepoReq,
-			)
+			var repo *github.Repository
+			err := retryOnSubmittedTooQuickly(ctx, "repository "+repoName, func() error {
+				var err error
+				repo, _, err = client.Repositories.CreateFromTemplate(ctx,
+					templateRepoOwner,
+					templateRepo,
+					&templateRepoReq,
+				)
+				return err
+			})
 			if err != nil {
 				return err
 			}
 
+			recordRepositoryCreated(meta, repo)
 			d.SetId(*repo.Name)
 		}
 	} else {
 		// Create without a repository template
 		var repo *github.Repository
-		var err error
-		if meta.(*Owner).IsOrganization {
-			repo, _, err = client.Repositories.Create(ctx, owner, repoReq)
-		} else {
-			// Create repository within authenticated user's account
-			repo, _, err = client.Repositories.Create(ctx, "", repoReq)
-		}
+		err := retryOnSubmittedTooQuickly(ctx, "repository "+repoName, func() error {
+			var err error
+			if meta.(*Owner).IsOrganization {
+				repo, _, err = client.Repositories.Create(ctx, owner, repoReq)
+			} else {
+				// Create repository within authenticated user's account
+				repo, _, err = client.Repositories.Create(ctx, "", repoReq)
+			}
+			return err
+		})
 		if err != nil {
 			return err
 		}
+		recordRepositoryCreated(meta, repo)
 		d.SetId(repo.GetName())
 	}
 
@@ -624,17 +658,22 @@ func resourceGithubRepositoryCreate(d *schema.ResourceData, meta any) error {
 }
 
 func resourceGithubRepositoryRead(d *schema.ResourceData, meta any) error {
+	if batcher := meta.(*Owner).repositoryBatcher; batcher != nil && !d.IsNewResource() {
+		ctx := context.WithValue(context.Background(), ctxId, d.Id())
+		return resourceGithubRepositoryReadBatched(ctx, d, meta, batcher)
+	}
+	return resourceGithubRepositoryReadREST(d, meta)
+}
+
+// resourceGithubRepositoryReadREST reads the repository with the REST API. The
+// repository is always read this way after being created or updated, rather
+// than through the batcher, so that the state reflects the writes of the run.
+func resourceGithubRepositoryReadREST(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
-	owner := meta.(*Owner).name
+	owner := resourceGithubRepositoryOwner(d, meta)
 	repoName := d.Id()
 
-	// When the user has not authenticated the provider, AnonymousHTTPClient is used, therefore owner == "". In this
-	// case lookup the owner in the data, and use that, if present.
-	if explicitOwner, _, ok := resourceGithubParseFullName(d); ok && owner == "" {
-		owner = explicitOwner
-	}
-
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
@@ -657,6 +696,87 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta any) error {
 	}
 
 	_ = d.Set("etag", resp.Header.Get("ETag"))
+	if err = setRepositoryState(d, repoName, repo); err != nil {
+		return err
+	}
+
+	if repo.GetHasPages() {
+		pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("pages", flattenPages(pages)); err != nil {
+			return fmt.Errorf("error setting pages: %w", err)
+		}
+	}
+
+	if !d.Get("ignore_vulnerability_alerts_during_read").(bool) {
+		vulnerabilityAlerts, _, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repoName)
+		if err != nil {
+			return fmt.Errorf("error reading repository vulnerability alerts: %v", err)
+		}
+		if err = d.Set("vulnerability_alerts", vulnerabilityAlerts); err != nil {
+			return err
+		}
+	}
+
+	if err = d.Set("security_and_analysis", flattenSecurityAndAnalysis(repo.GetSecurityAndAnalysis())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceGithubRepositoryReadBatched reads the repository along with the
+// others being refreshed through batcher. The GraphQL API does not expose
+// has_downloads and security_and_analysis, which are kept as is, nor whether
+// GitHub Pages are enabled, so the pages are only read if configured.
+func resourceGithubRepositoryReadBatched(ctx context.Context, d *schema.ResourceData, meta any, batcher *repositoryBatcher) error {
+	client := meta.(*Owner).v3client
+
+	owner := resourceGithubRepositoryOwner(d, meta)
+	repoName := d.Id()
+
+	node, err := batcher.get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		log.Printf("[INFO] Removing repository %s/%s from state because it no longer exists in GitHub",
+			owner, repoName)
+		d.SetId("")
+		return nil
+	}
+
+	repo := node.repository()
+	repo.HasDownloads = github.Ptr(d.Get("has_downloads").(bool))
+	if err = setRepositoryState(d, repoName, repo); err != nil {
+		return err
+	}
+
+	if len(d.Get("pages").([]any)) > 0 {
+		pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+		if err := d.Set("pages", flattenPages(pages)); err != nil {
+			return fmt.Errorf("error setting pages: %w", err)
+		}
+	}
+
+	if !d.Get("ignore_vulnerability_alerts_during_read").(bool) {
+		if err = d.Set("vulnerability_alerts", node.HasVulnerabilityAlertsEnabled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setRepositoryState sets the attributes of the repository read from GitHub.
+func setRepositoryState(d *schema.ResourceData, repoName string, repo *github.Repository) error {
 	_ = d.Set("name", repoName)
 	_ = d.Set("description", repo.GetDescription())
 	_ = d.Set("primary_language", repo.GetLanguage())
@@ -696,18 +816,8 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta any) error {
 		_ = d.Set("squash_merge_commit_title", repo.GetSquashMergeCommitTitle())
 	}
 
-	if repo.GetHasPages() {
-		pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
-		if err != nil {
-			return err
-		}
-		if err := d.Set("pages", flattenPages(pages)); err != nil {
-			return fmt.Errorf("error setting pages: %w", err)
-		}
-	}
-
 	if repo.TemplateRepository != nil {
-		if err = d.Set("template", []any{
+		if err := d.Set("template", []any{
 			map[string]any{
 				"owner":      repo.TemplateRepository.Owner.Login,
 				"repository": repo.TemplateRepository.Name,
@@ -716,25 +826,11 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta any) error {
 			return err
 		}
 	} else {
-		if err = d.Set("template", []any{}); err != nil {
+		if err := d.Set("template", []any{}); err != nil {
 			return err
 		}
 	}
 
-	if !d.Get("ignore_vulnerability_alerts_during_read").(bool) {
-		vulnerabilityAlerts, _, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repoName)
-		if err != nil {
-			return fmt.Errorf("error reading repository vulnerability alerts: %v", err)
-		}
-		if err = d.Set("vulnerability_alerts", vulnerabilityAlerts); err != nil {
-			return err
-		}
-	}
-
-	if err = d.Set("security_and_analysis", flattenSecurityAndAnalysis(repo.GetSecurityAndAnalysis())); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -746,6 +842,12 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta any) error {
 		return nil
 	}
 
+	if d.HasChange("visibility") {
+		if err := checkInternalVisibility(d, meta); err != nil {
+			return err
+		}
+	}
+
 	client := meta.(*Owner).v3client
 
 	repoReq := resourceGithubRepositoryObject(d)
@@ -767,7 +869,7 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	repoName := d.Id()
-	owner := meta.(*Owner).name
+	owner := resourceGithubRepositoryOwner(d, meta)
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	repo, _, err := client.Repositories.Edit(ctx, owner, repoName, repoReq)
@@ -779,6 +881,11 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta any) error {
 	if d.HasChange("pages") && !d.IsNewResource() {
 		opts := expandPagesUpdate(d.Get("pages").([]any))
 		if opts != nil {
+			// HTTPS enforcement is only sent when changed, as it cannot be
+			// enabled before the certificate of a new custom domain is issued.
+			if d.HasChange("pages.0.https_enforced") {
+				opts.HTTPSEnforced = github.Ptr(d.Get("pages.0.https_enforced").(bool))
+			}
 			pages, res, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
 			if res.StatusCode != http.StatusNotFound && err != nil {
 				return err
@@ -857,13 +964,13 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta any) error {
 		log.Printf("[DEBUG] No privacy update required. private: %v", d.Get("private"))
 	}
 
-	return resourceGithubRepositoryRead(d, meta)
+	return resourceGithubRepositoryReadREST(d, meta)
 }
 
 func resourceGithubRepositoryDelete(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 	repoName := d.Id()
-	owner := meta.(*Owner).name
+	owner := resourceGithubRepositoryOwner(d, meta)
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	archiveOnDestroy := d.Get("archive_on_destroy").(bool)
@@ -968,6 +1075,7 @@ func flattenPages(pages *github.Pages) []any {
 	pagesMap["url"] = pages.GetURL()
 	pagesMap["status"] = pages.GetStatus()
 	pagesMap["cname"] = pages.GetCNAME()
+	pagesMap["https_enforced"] = pages.GetHTTPSEnforced()
 	pagesMap["custom_404"] = pages.GetCustom404()
 	pagesMap["html_url"] = pages.GetHTMLURL()
 
@@ -1035,6 +1143,45 @@ func flattenSecurityAndAnalysis(securityAndAnalysis *github.SecurityAndAnalysis)
 	return []any{securityAndAnalysisMap}
 }
 
+// resourceGithubRepositoryImport imports a repository by its name, owned by
+// the owner of the provider, or by its full name to import a repository of
+// another owner the provider has access to.
+func resourceGithubRepositoryImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	fullName := d.Id()
+	if owner, repoName, ok := strings.Cut(d.Id(), "/"); ok {
+		if owner == "" || repoName == "" || strings.Contains(repoName, "/") {
+			return nil, fmt.Errorf("invalid ID specified: supplied ID must be written as <name> or <owner>/<name>")
+		}
+		d.SetId(repoName)
+	} else if owner := meta.(*Owner).name; owner != "" {
+		fullName = owner + "/" + d.Id()
+	} else {
+		fullName = ""
+	}
+
+	if err := d.Set("full_name", fullName); err != nil {
+		return nil, err
+	}
+	if err := d.Set("auto_init", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceGithubRepositoryOwner returns the owner of the repository, which is
+// the owner of the provider unless the repository was imported from another
+// owner or the provider is not authenticated.
+func resourceGithubRepositoryOwner(d *schema.ResourceData, meta any) string {
+	// The prior full_name is used as it is unknown while the repository is
+	// renamed.
+	fullName, _ := d.GetChange("full_name")
+	if owner, _, ok := strings.Cut(fullName.(string), "/"); ok && owner != "" {
+		return owner
+	}
+	return meta.(*Owner).name
+}
+
 // In case full_name can be determined from the data, parses it into an org and repo name proper. For example,
 // resourceGithubParseFullName will return "myorg", "myrepo", true when full_name is "myorg/myrepo".
 func resourceGithubParseFullName(resourceDataLike interface {
@@ -1063,3 +1210,66 @@ func customDiffFunction(_ context.Context, diff *schema.ResourceDiff, v any) err
 	}
 	return nil
 }
+
+// validSquashMergeCommitMessages and validMergeCommitMessages are the commit
+// messages GitHub accepts for each commit title.
+var validSquashMergeCommitMessages = map[string][]string{
+	"PR_TITLE":           {"PR_BODY", "COMMIT_MESSAGES", "BLANK"},
+	"COMMIT_OR_PR_TITLE": {"COMMIT_MESSAGES"},
+}
+
+var validMergeCommitMessages = map[string][]string{
+	"PR_TITLE":      {"PR_BODY", "BLANK"},
+	"MERGE_MESSAGE": {"PR_TITLE"},
+}
+
+// customizeDiffMergeSettings rejects at plan time the combinations of merge
+// settings which the API would reject at apply time.
+func customizeDiffMergeSettings(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{
+		"allow_merge_commit", "allow_squash_merge", "allow_rebase_merge",
+		"merge_commit_title", "merge_commit_message", "squash_merge_commit_title", "squash_merge_commit_message",
+	} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	allowMergeCommit := diff.Get("allow_merge_commit").(bool)
+	allowSquashMerge := diff.Get("allow_squash_merge").(bool)
+	if !allowMergeCommit && !allowSquashMerge && !diff.Get("allow_rebase_merge").(bool) {
+		return fmt.Errorf("at least one of allow_merge_commit, allow_squash_merge and allow_rebase_merge must be true")
+	}
+
+	config := diff.GetRawConfig()
+	if err := validateCommitTitleAndMessage(config, "merge_commit", allowMergeCommit, "allow_merge_commit",
+		diff.Get("merge_commit_title").(string), diff.Get("merge_commit_message").(string), validMergeCommitMessages); err != nil {
+		return err
+	}
+	return validateCommitTitleAndMessage(config, "squash_merge_commit", allowSquashMerge, "allow_squash_merge",
+		diff.Get("squash_merge_commit_title").(string), diff.Get("squash_merge_commit_message").(string), validSquashMergeCommitMessages)
+}
+
+// validateCommitTitleAndMessage checks the <prefix>_title and <prefix>_message
+// settings: they can only be configured when the merge method is allowed, and
+// the message must be one of those valid for the title.
+func validateCommitTitleAndMessage(config cty.Value, prefix string, allowed bool, allowKey, title, message string, valid map[string][]string) error {
+	titleKey, messageKey := prefix+"_title", prefix+"_message"
+	if !allowed {
+		for _, key := range []string{titleKey, messageKey} {
+			if !config.IsNull() && !config.GetAttr(key).IsNull() {
+				return fmt.Errorf("%s cannot be set when %s is false", key, allowKey)
+			}
+		}
+		return nil
+	}
+
+	messages, ok := valid[title]
+	if !ok {
+		return fmt.Errorf("%s must be one of %s, got %q", titleKey, strings.Join(slices.Sorted(maps.Keys(valid)), ", "), title)
+	}
+	if !slices.Contains(messages, message) {
+		return fmt.Errorf("%s must be one of %s when %s is %q, got %q", messageKey, strings.Join(messages, ", "), titleKey, title, message)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryBypassRequestReview() *schema.Resource {
+	return &schema.Resource{
+		Description: "Approves or denies a request to bypass the secret scanning push protection of a repository.",
+		Create:      resourceGithubRepositoryBypassRequestReviewCreate,
+		Read:        resourceGithubRepositoryBypassRequestReviewRead,
+		Delete:      resourceGithubRepositoryBypassRequestReviewDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"bypass_request_number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the bypass request within the repository.",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"approve", "deny"}),
+				Description:      "The review of the bypass request, 'approve' or 'deny'.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The reason for the review, recorded with it.",
+			},
+			"response_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the review of the bypass request.",
+			},
+			"reviewer_login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user who reviewed the bypass request.",
+			},
+			"reviewed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the review.",
+			},
+			"request_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bypass request, e.g. 'approved' or 'denied'.",
+			},
+		},
+	}
+}
+
+func getGithubSecretScanningBypassRequest(ctx context.Context, client *github.Client, owner, repoName string, number int) (*bypassRequest, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/bypass-requests/secret-scanning/%d", owner, repoName, number), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request := new(bypassRequest)
+	if _, err := client.Do(ctx, req, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func resourceGithubRepositoryBypassRequestReviewCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	number := d.Get("bypass_request_number").(int)
+
+	review := map[string]string{
+		"status":  d.Get("status").(string),
+		"message": d.Get("message").(string),
+	}
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("repos/%s/%s/bypass-requests/secret-scanning/%d", owner, repoName, number), review)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Reviewing bypass request %s/%s#%d: %s", owner, repoName, number, review["status"])
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		return err
+	}
+
+	// The review is the most recent response to the bypass request.
+	request, err := getGithubSecretScanningBypassRequest(ctx, client, owner, repoName, number)
+	if err != nil {
+		return err
+	}
+	var responseID int64
+	for _, response := range request.Responses {
+		responseID = max(responseID, response.ID)
+	}
+	if responseID == 0 {
+		return fmt.Errorf("the review of bypass request %s/%s#%d was not recorded", owner, repoName, number)
+	}
+
+	d.SetId(buildTwoPartID(repoName, strconv.Itoa(number)))
+	if err = d.Set("response_id", responseID); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryBypassRequestReviewRead(d, meta)
+}
+
+func resourceGithubRepositoryBypassRequestReviewRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, numberString, err := parseTwoPartID(d.Id(), "repository", "bypass_request_number")
+	if err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(numberString)
+	if err != nil {
+		return unconvertibleIdErr(numberString, err)
+	}
+
+	request, err := getGithubSecretScanningBypassRequest(ctx, client, owner, repoName, number)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing bypass request review %s from state because the bypass request no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	responseID := int64(d.Get("response_id").(int))
+	var review *bypassResponse
+	for _, response := range request.Responses {
+		if response.ID == responseID {
+			review = response
+			break
+		}
+	}
+	if review == nil {
+		log.Printf("[INFO] Removing bypass request review %s from state because it was dismissed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("bypass_request_number", number); err != nil {
+		return err
+	}
+	if err = d.Set("reviewer_login", review.Reviewer.ActorName); err != nil {
+		return err
+	}
+	if err = d.Set("reviewed_at", review.CreatedAt.String()); err != nil {
+		return err
+	}
+	if err = d.Set("request_status", request.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryBypassRequestReviewDelete(d *schema.ResourceData, meta any) error {
+	// The review is part of the audit trail of the bypass request, destroying
+	// the resource only removes it from state.
+	log.Printf("[INFO] Removing bypass request review %s from state, the review is left unchanged", d.Id())
+	return nil
+}
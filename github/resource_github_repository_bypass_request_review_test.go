@@ -0,0 +1,108 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositoryBypassRequestReview(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("approves a bypass request without error", func(t *testing.T) {
+
+		// Bypass requests are made by pushing a secret to a repository with
+		// delegated bypass enabled, which cannot be done with Terraform.
+		repository := os.Getenv("GITHUB_TEST_BYPASS_REQUEST_REPOSITORY")
+		number := os.Getenv("GITHUB_TEST_BYPASS_REQUEST_NUMBER")
+		if repository == "" || number == "" {
+			t.Skip("Skipping because `GITHUB_TEST_BYPASS_REQUEST_REPOSITORY` or `GITHUB_TEST_BYPASS_REQUEST_NUMBER` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_repository_bypass_request_review" "test" {
+				repository            = "%s"
+				bypass_request_number = %s
+				status                = "approve"
+				message               = "Approved by Terraform"
+			}
+		`, repository, number)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("github_repository_bypass_request_review.test", "response_id"),
+			resource.TestCheckResourceAttrSet("github_repository_bypass_request_review.test", "reviewer_login"),
+			resource.TestCheckResourceAttr("github_repository_bypass_request_review.test", "request_status", "approved"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+
+	t.Run("fails to review a bypass request that does not exist", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_bypass_request_review" "test" {
+				repository            = github_repository.test.name
+				bypass_request_number = 1
+				status                = "deny"
+			}
+		`, randomID)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: regexp.MustCompile(`404`),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
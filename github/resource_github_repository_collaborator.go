@@ -63,6 +63,11 @@ func resourceGithubRepositoryCollaborator() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the invitation to be used in 'github_user_invitation_accepter'",
 			},
+			"invitation_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the invitation of the collaborator, 'pending' until the user accepts it and 'accepted' once the user is a collaborator.",
+			},
 		},
 	}
 }
@@ -77,7 +82,19 @@ func resourceGithubRepositoryCollaboratorCreate(d *schema.ResourceData, meta any
 
 	ctx := context.Background()
 
-	_, _, err := client.Repositories.AddCollaborator(ctx,
+	// A new invitation is only sent once the expired one is deleted.
+	invitation, err := findRepoInvitation(client, ctx, owner, repoNameWithoutOwner, username)
+	if err != nil {
+		return err
+	}
+	if invitation != nil && invitation.GetExpired() {
+		log.Printf("[DEBUG] Deleting expired invitation %d of %s to %s/%s", invitation.GetID(), username, owner, repoNameWithoutOwner)
+		if _, err = client.Repositories.DeleteInvitation(ctx, owner, repoNameWithoutOwner, invitation.GetID()); err != nil {
+			return err
+		}
+	}
+
+	_, _, err = client.Repositories.AddCollaborator(ctx,
 		owner,
 		repoNameWithoutOwner,
 		username,
@@ -120,6 +137,15 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta any)
 		return err
 	}
 	if invitation != nil {
+		// Expired invitations cannot be accepted anymore, the collaborator is
+		// removed from state so that a new invitation is sent.
+		if invitation.GetExpired() {
+			log.Printf("[INFO] Removing repository collaborator %s (%s/%s) from state because its invitation expired",
+				username, owner, repoName)
+			d.SetId("")
+			return nil
+		}
+
 		username = invitation.GetInvitee().GetLogin()
 
 		permissionName := getPermission(invitation.GetPermissions())
@@ -136,6 +162,9 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta any)
 		if err = d.Set("invitation_id", fmt.Sprintf("%d", invitation.GetID())); err != nil {
 			return err
 		}
+		if err = d.Set("invitation_status", "pending"); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -162,6 +191,9 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta any)
 				if err = d.Set("permission", getPermission(c.GetRoleName())); err != nil {
 					return err
 				}
+				if err = d.Set("invitation_status", "accepted"); err != nil {
+					return err
+				}
 				return nil
 			}
 		}
@@ -172,7 +204,8 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta any)
 		opt.Page = resp.NextPage
 	}
 
-	// The user is neither invited nor a collaborator
+	// The user is neither invited nor a collaborator, e.g. after declining
+	// the invitation, the collaborator is created again with a new invitation.
 	log.Printf("[INFO] Removing repository collaborator %s (%s/%s) from state because it no longer exists in GitHub",
 		username, owner, repoName)
 	d.SetId("")
@@ -2,11 +2,15 @@ package github
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubRepositoryCollaborator(t *testing.T) {
@@ -152,3 +156,41 @@ func TestParseRepoName(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceGithubRepositoryCollaboratorReadInvitations(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/org/repo/invitations", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"id": 1, "invitee": {"login": "pending"}, "permissions": "write", "expired": false},
+			{"id": 2, "invitee": {"login": "expired"}, "permissions": "write", "expired": true}
+		]`)
+	})
+	mux.HandleFunc("GET /repos/org/repo/collaborators", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"login": "accepted", "role_name": "write"}]`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "org"}
+
+	read := func(username string) *schema.ResourceData {
+		d := resourceGithubRepositoryCollaborator().TestResourceData()
+		d.SetId(buildTwoPartID("repo", username))
+		if err := resourceGithubRepositoryCollaboratorRead(d, meta); err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	if d := read("pending"); d.Get("invitation_status") != "pending" || d.Get("invitation_id") != "1" {
+		t.Errorf("expected a pending invitation, got %v %v", d.Get("invitation_status"), d.Get("invitation_id"))
+	}
+	if d := read("accepted"); d.Get("invitation_status") != "accepted" {
+		t.Errorf("expected an accepted invitation, got %v", d.Get("invitation_status"))
+	}
+	if d := read("expired"); d.Id() != "" {
+		t.Errorf("expected a collaborator with an expired invitation to be removed from state, got ID %q", d.Id())
+	}
+}
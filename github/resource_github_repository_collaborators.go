@@ -69,6 +69,25 @@ func resourceGithubRepositoryCollaborators() *schema.Resource {
 					},
 				},
 			},
+			"app": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of GitHub App installations of the organization granted access to the repository, with the permissions of their installation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:        schema.TypeString,
+							Description: "The slug of the GitHub App whose organization installation is granted access to the repository.",
+							Required:    true,
+						},
+						"installation_id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the GitHub App installation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"invitation_ids": {
 				Type:        schema.TypeMap,
 				Description: "Map of usernames to invitation ID for any users added",
@@ -477,6 +496,176 @@ func matchTeamCollaborators(repoName string, want []any, has []teamCollaborator,
 	return nil
 }
 
+type appCollaborator struct {
+	slug           string
+	installationID int64
+}
+
+func flattenAppCollaborators(objs []appCollaborator) []any {
+	if objs == nil {
+		return nil
+	}
+
+	sort.SliceStable(objs, func(i, j int) bool {
+		return objs[i].slug < objs[j].slug
+	})
+
+	items := make([]any, len(objs))
+	for i, obj := range objs {
+		items[i] = map[string]any{
+			"slug":            obj.slug,
+			"installation_id": strconv.FormatInt(obj.installationID, 10),
+		}
+	}
+
+	return items
+}
+
+func listAppInstallations(client *github.Client, ctx context.Context, owner string) (map[string]*github.Installation, error) {
+	installations := make(map[string]*github.Installation)
+
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		result, resp, err := client.Organizations.ListInstallations(ctx, owner, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range result.Installations {
+			installations[i.GetAppSlug()] = i
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return installations, nil
+}
+
+func installationHasRepository(client *github.Client, ctx context.Context, installation *github.Installation, repoName string) (bool, error) {
+	if installation.GetRepositorySelection() == "all" {
+		return true, nil
+	}
+
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		repos, resp, err := client.Apps.ListUserRepos(ctx, installation.GetID(), opt)
+		if err != nil {
+			return false, err
+		}
+
+		for _, r := range repos.Repositories {
+			if r.GetName() == repoName {
+				return true, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return false, nil
+}
+
+// listAppCollaborators returns the apps among slugs whose organization
+// installation has access to the repository. Unlike users and teams, apps
+// that are not managed by the resource are never listed, since access for
+// all repositories is granted at the organization level.
+func listAppCollaborators(client *github.Client, ctx context.Context, owner, repoName string, slugs []string) ([]appCollaborator, error) {
+	appCollaborators := make([]appCollaborator, 0)
+	if len(slugs) == 0 {
+		return appCollaborators, nil
+	}
+
+	installations, err := listAppInstallations(client, ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slug := range slugs {
+		installation, ok := installations[slug]
+		if !ok {
+			continue
+		}
+		hasRepository, err := installationHasRepository(client, ctx, installation, repoName)
+		if err != nil {
+			return nil, err
+		}
+		if hasRepository {
+			appCollaborators = append(appCollaborators, appCollaborator{slug: slug, installationID: installation.GetID()})
+		}
+	}
+
+	return appCollaborators, nil
+}
+
+func matchAppCollaborators(repoName string, want []any, remove []any, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	if len(want) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	installations, err := listAppInstallations(client, ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range remove {
+		slug := r.(map[string]any)["slug"].(string)
+		installation, ok := installations[slug]
+		if !ok {
+			continue
+		}
+		if installation.GetRepositorySelection() == "all" {
+			log.Printf("[WARN] Not removing app %s from repo: %s, its installation has access to all repositories.", slug, repoName)
+			continue
+		}
+		log.Printf("[DEBUG] Removing app %s from repo: %s.", slug, repoName)
+		_, err := client.Apps.RemoveRepository(ctx, installation.GetID(), repo.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, w := range want {
+		slug := w.(map[string]any)["slug"].(string)
+		installation, ok := installations[slug]
+		if !ok {
+			return fmt.Errorf("app %s is not installed on organization %s", slug, owner)
+		}
+		if installation.GetRepositorySelection() == "all" {
+			continue
+		}
+		log.Printf("[DEBUG] Adding app %s for repo: %s.", slug, repoName)
+		_, _, err := client.Apps.AddRepository(ctx, installation.GetID(), repo.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getAppSlugs(apps []any) []string {
+	slugs := make([]string, len(apps))
+	for i, a := range apps {
+		slugs[i] = a.(map[string]any)["slug"].(string)
+	}
+	return slugs
+}
+
 func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
@@ -524,6 +713,19 @@ func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta an
 		return err
 	}
 
+	oldApps, newApps := d.GetChange("app")
+	removedApps := oldApps.(*schema.Set).Difference(newApps.(*schema.Set)).List()
+	addedApps := newApps.(*schema.Set).Difference(oldApps.(*schema.Set)).List()
+	if len(addedApps) > 0 || len(removedApps) > 0 {
+		if err := checkOrganization(meta); err != nil {
+			return err
+		}
+	}
+	err = matchAppCollaborators(repoName, addedApps, removedApps, meta)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(repoName)
 
 	return resourceGithubRepositoryCollaboratorsRead(d, meta)
@@ -579,6 +781,17 @@ func resourceGithubRepositoryCollaboratorsRead(d *schema.ResourceData, meta any)
 		return err
 	}
 
+	if apps := d.Get("app").(*schema.Set).List(); len(apps) > 0 && isOrg {
+		appCollaborators, err := listAppCollaborators(client, ctx, owner, repoName, getAppSlugs(apps))
+		if err != nil {
+			return err
+		}
+		err = d.Set("app", flattenAppCollaborators(appCollaborators))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -614,7 +827,12 @@ func resourceGithubRepositoryCollaboratorsDelete(d *schema.ResourceData, meta an
 
 	// delete all teams
 	err = matchTeamCollaborators(repoName, nil, teamCollaborators, meta)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// delete managed apps
+	return matchAppCollaborators(repoName, nil, d.Get("app").(*schema.Set).List(), meta)
 }
 
 func getIgnoreTeamIds(d *schema.ResourceData, meta any) ([]int64, error) {
@@ -673,4 +673,48 @@ func TestAccGithubRepositoryCollaborators(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("manages app installations", func(t *testing.T) {
+		appSlug := os.Getenv("GITHUB_TEST_APP_SLUG")
+		if appSlug == "" {
+			t.Skip("GITHUB_TEST_APP_SLUG must be set to the slug of an app installed on selected repositories")
+		}
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("tf-acc-test-%s", randomID)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "%s"
+				auto_init = true
+				visibility = "private"
+			}
+
+			resource "github_repository_collaborators" "test_repo_collaborators" {
+				repository = github_repository.test.name
+
+				app {
+					slug = "%s"
+				}
+			}
+		`, repoName, appSlug)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { skipUnlessMode(t, organization) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_collaborators.test_repo_collaborators", "app.#", "1"),
+						resource.TestCheckResourceAttrSet("github_repository_collaborators.test_repo_collaborators", "app.0.installation_id"),
+					),
+				},
+				{
+					Config:             config,
+					ExpectNonEmptyPlan: false,
+				},
+			},
+		})
+	})
 }
@@ -0,0 +1,224 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryCustomProperties() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets the values of custom properties of a GitHub repository",
+		Create:      resourceGithubRepositoryCustomPropertiesCreateOrUpdate,
+		Read:        resourceGithubRepositoryCustomPropertiesRead,
+		Update:      resourceGithubRepositoryCustomPropertiesCreateOrUpdate,
+		Delete:      resourceGithubRepositoryCustomPropertiesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the repository which the custom properties should be on.",
+			},
+			"property": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The custom property values of the repository. Values of other custom properties are left unchanged.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the custom property, which must be defined by the organization.",
+						},
+						"property_value": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the custom property. Only custom properties of type 'multi_select' accept several values, those of type 'true_false' accept 'true' or 'false'.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandRepositoryCustomPropertyValue returns the value of a custom property
+// to send to GitHub, a string or a list of strings depending on the type of
+// the property defined by the organization. The values are checked against
+// the definition, as GitHub does not always report which one is invalid.
+func expandRepositoryCustomPropertyValue(property *github.CustomProperty, values []string) (any, error) {
+	name := property.GetPropertyName()
+
+	switch property.ValueType {
+	case STRING, SINGLE_SELECT, TRUE_FALSE:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("custom property %s of type %s accepts a single value, got %d", name, property.ValueType, len(values))
+		}
+	case MULTI_SELECT:
+	default:
+		return nil, fmt.Errorf("custom property %s has unsupported type: %s", name, property.ValueType)
+	}
+
+	for _, value := range values {
+		switch property.ValueType {
+		case TRUE_FALSE:
+			if value != "true" && value != "false" {
+				return nil, fmt.Errorf("custom property %s of type %s accepts 'true' or 'false', got %q", name, property.ValueType, value)
+			}
+		case SINGLE_SELECT, MULTI_SELECT:
+			if !slices.Contains(property.AllowedValues, value) {
+				return nil, fmt.Errorf("custom property %s does not allow value %q, allowed values are %v", name, value, property.AllowedValues)
+			}
+		}
+	}
+
+	if property.ValueType == MULTI_SELECT {
+		return values, nil
+	}
+	return values[0], nil
+}
+
+// expandRepositoryCustomProperties returns the custom property values of the
+// property blocks keyed by property name.
+func expandRepositoryCustomProperties(properties *schema.Set) map[string][]string {
+	values := make(map[string][]string, properties.Len())
+	for _, property := range properties.List() {
+		p := property.(map[string]any)
+		values[p["property_name"].(string)] = expandStringList(p["property_value"].(*schema.Set).List())
+	}
+	return values
+}
+
+func resourceGithubRepositoryCustomPropertiesCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoName := d.Get("repository").(string)
+
+	definitions, _, err := client.Organizations.GetAllCustomProperties(ctx, owner)
+	if err != nil {
+		return err
+	}
+	propertiesByName := make(map[string]*github.CustomProperty, len(definitions))
+	for _, definition := range definitions {
+		propertiesByName[definition.GetPropertyName()] = definition
+	}
+
+	o, n := d.GetChange("property")
+	oldValues := expandRepositoryCustomProperties(o.(*schema.Set))
+	newValues := expandRepositoryCustomProperties(n.(*schema.Set))
+
+	var customProperties []*github.CustomPropertyValue
+	for name, values := range newValues {
+		definition, ok := propertiesByName[name]
+		if !ok {
+			return fmt.Errorf("custom property %s is not defined by organization %s", name, owner)
+		}
+		value, err := expandRepositoryCustomPropertyValue(definition, values)
+		if err != nil {
+			return err
+		}
+		customProperties = append(customProperties, &github.CustomPropertyValue{PropertyName: name, Value: value})
+	}
+	// Properties no longer managed are unset.
+	for name := range oldValues {
+		if _, ok := newValues[name]; !ok {
+			customProperties = append(customProperties, &github.CustomPropertyValue{PropertyName: name, Value: nil})
+		}
+	}
+
+	log.Printf("[DEBUG] Setting %d custom property values of repository %s/%s", len(customProperties), owner, repoName)
+	if _, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, owner, repoName, customProperties); err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+	return resourceGithubRepositoryCustomPropertiesRead(d, meta)
+}
+
+func resourceGithubRepositoryCustomPropertiesRead(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	allCustomProperties, _, err := client.Repositories.GetAllCustomPropertyValues(ctx, owner, d.Id())
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository custom properties %s", d.Id())
+	}
+
+	// Only the properties of the state are managed, all the properties with a
+	// value are read on import.
+	managed := expandRepositoryCustomProperties(d.Get("property").(*schema.Set))
+
+	properties := make([]any, 0, len(allCustomProperties))
+	for _, customProperty := range allCustomProperties {
+		if _, ok := managed[customProperty.PropertyName]; !ok && len(managed) > 0 {
+			continue
+		}
+		if customProperty.Value == nil {
+			continue
+		}
+		values, err := parseRepositoryCustomPropertyValueToStringSlice(customProperty)
+		if err != nil {
+			return err
+		}
+		properties = append(properties, map[string]any{
+			"property_name":  customProperty.PropertyName,
+			"property_value": values,
+		})
+	}
+
+	if err = d.Set("repository", d.Id()); err != nil {
+		return err
+	}
+	if err = d.Set("property", properties); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryCustomPropertiesDelete(d *schema.ResourceData, meta any) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	var customProperties []*github.CustomPropertyValue
+	for name := range expandRepositoryCustomProperties(d.Get("property").(*schema.Set)) {
+		customProperties = append(customProperties, &github.CustomPropertyValue{PropertyName: name, Value: nil})
+	}
+
+	_, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, owner, d.Id(), customProperties)
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository custom properties %s", d.Id())
+}
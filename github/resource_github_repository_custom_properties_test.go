@@ -0,0 +1,132 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestExpandRepositoryCustomPropertyValue(t *testing.T) {
+	property := func(valueType string, allowedValues ...string) *github.CustomProperty {
+		return &github.CustomProperty{PropertyName: github.Ptr("test"), ValueType: valueType, AllowedValues: allowedValues}
+	}
+
+	cases := []struct {
+		property *github.CustomProperty
+		values   []string
+		want     any
+		wantErr  bool
+	}{
+		{property: property(STRING), values: []string{"anything"}, want: "anything"},
+		{property: property(STRING), values: []string{"one", "two"}, wantErr: true},
+		{property: property(TRUE_FALSE), values: []string{"true"}, want: "true"},
+		{property: property(TRUE_FALSE), values: []string{"yes"}, wantErr: true},
+		{property: property(SINGLE_SELECT, "option1", "option2"), values: []string{"option2"}, want: "option2"},
+		{property: property(SINGLE_SELECT, "option1"), values: []string{"option3"}, wantErr: true},
+		{property: property(MULTI_SELECT, "option1", "option2"), values: []string{"option1", "option2"}, want: []string{"option1", "option2"}},
+		{property: property(MULTI_SELECT, "option1"), values: []string{"option1", "option3"}, wantErr: true},
+		{property: property("unknown"), values: []string{"value"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		got, err := expandRepositoryCustomPropertyValue(c.property, c.values)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("expected values %v of type %s to be rejected", c.values, c.property.ValueType)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for values %v of type %s: %v", c.values, c.property.ValueType, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("expected %v for values %v of type %s, got %v", c.want, c.values, c.property.ValueType, got)
+		}
+	}
+}
+
+func TestAccGithubRepositoryCustomProperties(t *testing.T) {
+
+	t.Skip("You need an org with custom properties already setup as described in the variables below") // TODO: at the time of writing org_custom_properties are not supported by this terraform provider, so cant be setup in the test itself for now
+	multiSelectPropertyName := "multi-select"                                                          // Needs to be a of type multi_select, and have "option1" and "option2" as an options
+	stringPropertyName := "string"                                                                     // Needs to be a of type string
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("sets and updates custom property values without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%s"
+				auto_init = true
+			}
+			resource "github_repository_custom_properties" "test" {
+				repository = github_repository.test.name
+				property {
+					property_name  = "%s"
+					property_value = ["option1", "option2"]
+				}
+				property {
+					property_name  = "%s"
+					property_value = ["%%s"]
+				}
+			}
+		`, randomID, multiSelectPropertyName, stringPropertyName)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_custom_properties.test", "property.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("github_repository_custom_properties.test", "property.*", map[string]string{
+					"property_name":    stringPropertyName,
+					"property_value.0": "before",
+				}),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_custom_properties.test", "property.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("github_repository_custom_properties.test", "property.*", map[string]string{
+					"property_name":    stringPropertyName,
+					"property_value.0": "after",
+				}),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "before"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "after"),
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_repository_custom_properties.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
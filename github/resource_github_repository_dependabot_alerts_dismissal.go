@@ -0,0 +1,279 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The ecosystems and severities of Dependabot alerts.
+var (
+	dependabotAlertEcosystems = []string{"composer", "go", "maven", "npm", "nuget", "pip", "pub", "rubygems", "rust"}
+	dependabotAlertSeverities = []string{"low", "medium", "high", "critical"}
+)
+
+func resourceGithubRepositoryDependabotAlertsDismissal() *schema.Resource {
+	return &schema.Resource{
+		Description: "Dismisses the Dependabot alerts of a repository matching criteria, optionally until an expiry date.",
+		Create:      resourceGithubRepositoryDependabotAlertsDismissalApply,
+		Read:        resourceGithubRepositoryDependabotAlertsDismissalRead,
+		Update:      resourceGithubRepositoryDependabotAlertsDismissalApply,
+		Delete:      resourceGithubRepositoryDependabotAlertsDismissalDelete,
+
+		CustomizeDiff: resourceGithubRepositoryDependabotAlertsDismissalDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"ecosystem": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc(dependabotAlertEcosystems),
+				Description:      "The ecosystem of the alerts to dismiss, e.g. 'npm'. Alerts of any ecosystem are dismissed if not set.",
+			},
+			"severities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateValueFunc(dependabotAlertSeverities)},
+				Set:         schema.HashString,
+				Description: "The severities of the alerts to dismiss, 'low', 'medium', 'high' or 'critical'. Alerts of any severity are dismissed if not set.",
+			},
+			"manifest_paths": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The paths of the manifests of the alerts to dismiss, which may contain shell patterns such as 'services/*/package.json'. Alerts of any manifest are dismissed if not set.",
+			},
+			"dismissed_reason": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"}),
+				Description:      "The reason for dismissing the alerts, one of 'fix_started', 'inaccurate', 'no_bandwidth', 'not_used' or 'tolerable_risk'.",
+			},
+			"dismissed_comment": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringLenBetween(0, 280), "dismissed_comment"),
+				Description:      "A comment recorded with the dismissal of the alerts.",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IsRFC3339Time, "expires_at"),
+				Description:      "The date and time, in RFC 3339 format, after which the dismissed alerts are reopened by the next apply and no other alerts are dismissed.",
+			},
+			"dismissed_alerts": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The numbers of the alerts dismissed by this resource which are still dismissed.",
+			},
+			"open_alerts": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The numbers of the open alerts matching the criteria, as of the last refresh. They are dismissed by the next apply unless the dismissal expired.",
+			},
+		},
+	}
+}
+
+// dependabotAlertsDismissalExpired returns whether the expiry date of the
+// dismissal, if any, is past.
+func dependabotAlertsDismissalExpired(expiresAt string) bool {
+	if expiresAt == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && time.Now().After(t)
+}
+
+// matchDependabotAlertManifest returns whether the manifest of an alert
+// matches one of the patterns, or any manifest if there are none.
+func matchDependabotAlertManifest(manifestPath string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, manifestPath); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// listGithubDependabotAlerts returns the numbers of the alerts of the
+// repository in the state matching the criteria of the dismissal.
+func listGithubDependabotAlerts(ctx context.Context, d *schema.ResourceData, meta any, state string) ([]int, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	options := &github.ListAlertsOptions{State: github.Ptr(state)}
+	options.ListCursorOptions.PerPage = maxPerPage
+	if ecosystem, ok := d.GetOk("ecosystem"); ok {
+		options.Ecosystem = github.Ptr(ecosystem.(string))
+	}
+	if severities := expandStringList(d.Get("severities").(*schema.Set).List()); len(severities) > 0 {
+		sort.Strings(severities)
+		options.Severity = github.Ptr(strings.Join(severities, ","))
+	}
+	manifestPaths := expandStringList(d.Get("manifest_paths").(*schema.Set).List())
+
+	var numbers []int
+	for {
+		alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repoName, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, alert := range alerts {
+			if matchDependabotAlertManifest(alert.GetDependency().GetManifestPath(), manifestPaths) {
+				numbers = append(numbers, alert.GetNumber())
+			}
+		}
+		if resp.After == "" {
+			break
+		}
+		options.ListCursorOptions.After = resp.After
+	}
+
+	return numbers, nil
+}
+
+func resourceGithubRepositoryDependabotAlertsDismissalApply(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoName := d.Get("repository").(string)
+	// The dismissed alerts are unknown in the plan when an update is planned
+	// for new or expired alerts, those of the state are the tracked ones.
+	tracked, _ := d.GetChange("dismissed_alerts")
+	dismissed := schema.NewSet(schema.HashInt, tracked.(*schema.Set).List())
+
+	if dependabotAlertsDismissalExpired(d.Get("expires_at").(string)) {
+		for _, number := range dismissed.List() {
+			log.Printf("[INFO] Reopening Dependabot alert %s/%s#%d because its dismissal expired", owner, repoName, number.(int))
+			if _, _, err := client.Dependabot.UpdateAlert(ctx, owner, repoName, number.(int), &github.DependabotAlertState{State: "open"}); err != nil {
+				return err
+			}
+		}
+		dismissed = schema.NewSet(schema.HashInt, nil)
+	} else {
+		open, err := listGithubDependabotAlerts(ctx, d, meta, "open")
+		if err != nil {
+			return err
+		}
+
+		state := &github.DependabotAlertState{
+			State:           "dismissed",
+			DismissedReason: github.Ptr(d.Get("dismissed_reason").(string)),
+		}
+		if comment, ok := d.GetOk("dismissed_comment"); ok {
+			state.DismissedComment = github.Ptr(comment.(string))
+		}
+		for _, number := range open {
+			log.Printf("[INFO] Dismissing Dependabot alert %s/%s#%d: %s", owner, repoName, number, state.GetDismissedReason())
+			if _, _, err := client.Dependabot.UpdateAlert(ctx, owner, repoName, number, state); err != nil {
+				return err
+			}
+			dismissed.Add(number)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(buildTwoPartID(repoName, uuid.NewString()))
+	}
+	if err := d.Set("dismissed_alerts", dismissed); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryDependabotAlertsDismissalRead(d, meta)
+}
+
+func resourceGithubRepositoryDependabotAlertsDismissalRead(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// Alerts fixed or reopened since are no longer tracked.
+	stillDismissed, err := listGithubDependabotAlerts(ctx, d, meta, "dismissed")
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "Dependabot alerts dismissal %s", d.Id())
+	}
+	tracked := d.Get("dismissed_alerts").(*schema.Set)
+	dismissed := schema.NewSet(schema.HashInt, nil)
+	for _, number := range stillDismissed {
+		if tracked.Contains(number) {
+			dismissed.Add(number)
+		}
+	}
+
+	open, err := listGithubDependabotAlerts(ctx, d, meta, "open")
+	if err != nil {
+		return err
+	}
+	openAlerts := make([]any, 0, len(open))
+	for _, number := range open {
+		openAlerts = append(openAlerts, number)
+	}
+
+	if err = d.Set("dismissed_alerts", dismissed); err != nil {
+		return err
+	}
+	if err = d.Set("open_alerts", openAlerts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDependabotAlertsDismissalDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName := d.Get("repository").(string)
+	for _, number := range d.Get("dismissed_alerts").(*schema.Set).List() {
+		log.Printf("[INFO] Reopening Dependabot alert %s/%s#%d", owner, repoName, number.(int))
+		if _, _, err := client.Dependabot.UpdateAlert(ctx, owner, repoName, number.(int), &github.DependabotAlertState{State: "open"}); err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceGithubRepositoryDependabotAlertsDismissalDiff plans an update when
+// the last refresh found open alerts matching the criteria, so that they are
+// dismissed, or once the dismissal expired, so that the alerts it dismissed
+// are reopened.
+func resourceGithubRepositoryDependabotAlertsDismissalDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if dependabotAlertsDismissalExpired(diff.Get("expires_at").(string)) {
+		return planRefreshedDrift(diff, "dismissed_alerts")
+	}
+	return planRefreshedDrift(diff, "open_alerts", "dismissed_alerts")
+}
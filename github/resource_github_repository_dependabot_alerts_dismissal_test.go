@@ -0,0 +1,102 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMatchDependabotAlertManifest(t *testing.T) {
+	testCases := []struct {
+		manifestPath string
+		patterns     []string
+		matches      bool
+	}{
+		{manifestPath: "package.json", matches: true},
+		{manifestPath: "package.json", patterns: []string{"package.json"}, matches: true},
+		{manifestPath: "services/api/package.json", patterns: []string{"services/*/package.json"}, matches: true},
+		{manifestPath: "services/api/go.mod", patterns: []string{"services/*/package.json"}, matches: false},
+		{manifestPath: "services/api/go.mod", patterns: []string{"services/*/package.json", "services/*/go.mod"}, matches: true},
+	}
+
+	for _, tc := range testCases {
+		if matches := matchDependabotAlertManifest(tc.manifestPath, tc.patterns); matches != tc.matches {
+			t.Errorf("manifest %s and patterns %v: expected match=%v, got %v", tc.manifestPath, tc.patterns, tc.matches, matches)
+		}
+	}
+}
+
+func TestDependabotAlertsDismissalExpired(t *testing.T) {
+	testCases := map[string]bool{
+		"": false,
+		time.Now().Add(-time.Hour).Format(time.RFC3339): true,
+		time.Now().Add(time.Hour).Format(time.RFC3339):  false,
+	}
+
+	for expiresAt, expired := range testCases {
+		if got := dependabotAlertsDismissalExpired(expiresAt); got != expired {
+			t.Errorf("expiry %q: expected expired=%v, got %v", expiresAt, expired, got)
+		}
+	}
+}
+
+func TestAccGithubRepositoryDependabotAlertsDismissal(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("dismisses the alerts matching the criteria", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name                 = "tf-acc-test-dependabot-%s"
+				auto_init            = true
+				vulnerability_alerts = true
+			}
+
+			resource "github_repository_dependabot_alerts_dismissal" "test" {
+				repository        = github_repository.test.name
+				ecosystem         = "npm"
+				severities        = ["low", "medium"]
+				manifest_paths    = ["services/*/package.json"]
+				dismissed_reason  = "tolerable_risk"
+				dismissed_comment = "Accepted until the migration"
+				expires_at        = "%s"
+			}
+		`, randomID, time.Now().Add(24*time.Hour).Format(time.RFC3339))
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_dependabot_alerts_dismissal.test", "dismissed_alerts.#", "0"),
+			resource.TestCheckResourceAttr("github_repository_dependabot_alerts_dismissal.test", "open_alerts.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
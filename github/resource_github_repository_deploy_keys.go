@@ -0,0 +1,260 @@
+package github
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryDeployKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a set of GitHub repository deploy keys.",
+		Create:      resourceGithubRepositoryDeployKeysCreateOrUpdate,
+		Read:        resourceGithubRepositoryDeployKeysRead,
+		Update:      resourceGithubRepositoryDeployKeysCreateOrUpdate,
+		Delete:      resourceGithubRepositoryDeployKeysDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryDeployKeysImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the GitHub repository.",
+			},
+			"prune": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether deploy keys of the repository not managed by this resource are deleted. Default: 'false'.",
+			},
+			"deploy_key": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A deploy key of the repository.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "A title.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "A SSH key.",
+						},
+						"read_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "A boolean qualifying the key to be either read only or read/write.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// normalizeDeployKey strips the comment and surrounding whitespace of a SSH
+// key, as GitHub only returns the key type and material.
+func normalizeDeployKey(key string) string {
+	keyRe := regexp.MustCompile(`^([a-z0-9-]+ [^\s]+)( [^\s]+)?$`)
+	return keyRe.ReplaceAllString(strings.TrimSpace(key), "$1")
+}
+
+func listGithubRepositoryDeployKeys(ctx context.Context, client *github.Client, owner, repoName string) ([]*github.Key, error) {
+	options := &github.ListOptions{
+		PerPage: maxPerPage,
+	}
+
+	var keys []*github.Key
+	for {
+		ks, resp, err := client.Repositories.ListKeys(ctx, owner, repoName, options)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, ks...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return keys, nil
+}
+
+// deployKeysByKey indexes a set of deploy_key blocks by their normalized key.
+func deployKeysByKey(keys *schema.Set) map[string]map[string]any {
+	byKey := make(map[string]map[string]any, keys.Len())
+	for _, k := range keys.List() {
+		key := k.(map[string]any)
+		byKey[normalizeDeployKey(key["key"].(string))] = key
+	}
+	return byKey
+}
+
+func resourceGithubRepositoryDeployKeysCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	prune := d.Get("prune").(bool)
+	ctx := context.WithValue(context.Background(), ctxId, repoName)
+
+	o, n := d.GetChange("deploy_key")
+	previous := deployKeysByKey(o.(*schema.Set))
+	desired := deployKeysByKey(n.(*schema.Set))
+
+	// Reconcile against the remote keys rather than the prior state, so only
+	// the keys that actually differ result in API calls.
+	remote, err := listGithubRepositoryDeployKeys(ctx, client, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(remote))
+	for _, k := range remote {
+		key := normalizeDeployKey(k.GetKey())
+		want, ok := desired[key]
+		if ok && want["title"].(string) == k.GetTitle() && want["read_only"].(bool) == k.GetReadOnly() {
+			existing[key] = true
+			continue
+		}
+
+		// Deploy keys are immutable, a key whose title or access changed is
+		// deleted and created again. Keys no longer configured are only
+		// deleted if they were previously managed, unless pruning.
+		_, wasManaged := previous[key]
+		if !ok && !wasManaged && !prune {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting repository deploy key %s/%s/%d", owner, repoName, k.GetID())
+		if _, err := client.Repositories.DeleteKey(ctx, owner, repoName, k.GetID()); err != nil {
+			return err
+		}
+	}
+
+	for key, want := range desired {
+		if existing[key] {
+			continue
+		}
+		log.Printf("[DEBUG] Creating repository deploy key %s/%s/%s", owner, repoName, want["title"].(string))
+		_, _, err := client.Repositories.CreateKey(ctx, owner, repoName, &github.Key{
+			Key:      github.Ptr(want["key"].(string)),
+			Title:    github.Ptr(want["title"].(string)),
+			ReadOnly: github.Ptr(want["read_only"].(bool)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(repoName)
+	return resourceGithubRepositoryDeployKeysRead(d, meta)
+}
+
+func resourceGithubRepositoryDeployKeysRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Id()
+	prune := d.Get("prune").(bool)
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	remote, err := listGithubRepositoryDeployKeys(ctx, client, owner, repoName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository deploy keys %s", d.Id())
+	}
+
+	// Without pruning, only the keys managed by this resource are tracked so
+	// that other keys of the repository do not show up as changes.
+	managed := deployKeysByKey(d.Get("deploy_key").(*schema.Set))
+
+	keys := make([]any, 0, len(remote))
+	for _, k := range remote {
+		key := k.GetKey()
+		configured, ok := managed[normalizeDeployKey(key)]
+		if !ok && !prune {
+			continue
+		}
+		// GitHub does not return the comment of a key, keep the configured
+		// key to avoid perpetual diffs.
+		if ok {
+			key = configured["key"].(string)
+		}
+		keys = append(keys, map[string]any{
+			"title":     k.GetTitle(),
+			"key":       key,
+			"read_only": k.GetReadOnly(),
+		})
+	}
+
+	if err := d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err := d.Set("deploy_key", keys); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDeployKeysDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	remote, err := listGithubRepositoryDeployKeys(ctx, client, owner, repoName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository deploy keys %s", d.Id())
+	}
+
+	managed := deployKeysByKey(d.Get("deploy_key").(*schema.Set))
+	for _, k := range remote {
+		if _, ok := managed[normalizeDeployKey(k.GetKey())]; !ok {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting repository deploy key %s/%s/%d", owner, repoName, k.GetID())
+		if _, err := client.Repositories.DeleteKey(ctx, owner, repoName, k.GetID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDeployKeysImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	// An imported resource manages all the existing keys of the repository.
+	remote, err := listGithubRepositoryDeployKeys(ctx, client, owner, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]any, 0, len(remote))
+	for _, k := range remote {
+		keys = append(keys, map[string]any{
+			"title":     k.GetTitle(),
+			"key":       k.GetKey(),
+			"read_only": k.GetReadOnly(),
+		})
+	}
+	if err := d.Set("deploy_key", keys); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestNormalizeDeployKey(t *testing.T) {
+	testCases := []struct {
+		Key, Expected string
+	}{
+		{
+			"ssh-rsa AAAABB...cd+== terraform-acctest@hashicorp.com\n",
+			"ssh-rsa AAAABB...cd+==",
+		},
+		{
+			"ssh-ed25519 AAAAC3...xyz",
+			"ssh-ed25519 AAAAC3...xyz",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := normalizeDeployKey(tc.Key); got != tc.Expected {
+			t.Fatalf("expected %q to be normalized to %q, got %q", tc.Key, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccGithubRepositoryDeployKeys(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	keyPath := filepath.Join("test-fixtures", "id_rsa.pub")
+
+	t.Run("manages a set of deploy keys", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-deploy-keys-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_deploy_keys" "test" {
+				repository = github_repository.test.name
+				prune      = %%t
+
+				deploy_key {
+					title     = "%%s"
+					key       = file("%s")
+					read_only = %%t
+				}
+			}
+		`, randomID, keyPath)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, false, "title1", true),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.#", "1"),
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.0.title", "title1"),
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.0.read_only", "true"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, true, "title2", false),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.#", "1"),
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.0.title", "title2"),
+							resource.TestCheckResourceAttr("github_repository_deploy_keys.test", "deploy_key.0.read_only", "false"),
+						),
+					},
+					{
+						ResourceName:      "github_repository_deploy_keys.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateVerifyIgnore: []string{
+							"prune", "deploy_key",
+						},
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryDeploymentBranchPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the complete set of deployment branch and tag policies of a repository environment.",
+		Create:      resourceGithubRepositoryDeploymentBranchPoliciesCreateOrUpdate,
+		Read:        resourceGithubRepositoryDeploymentBranchPoliciesRead,
+		Update:      resourceGithubRepositoryDeploymentBranchPoliciesCreateOrUpdate,
+		Delete:      resourceGithubRepositoryDeploymentBranchPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryDeploymentBranchPoliciesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the environment.",
+			},
+			"branch_patterns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateDeploymentBranchPolicyPattern},
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{"branch_patterns", "tag_patterns"},
+				Description:  "The name patterns that branches must match in order to deploy to the environment.",
+			},
+			"tag_patterns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateDeploymentBranchPolicyPattern},
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{"branch_patterns", "tag_patterns"},
+				Description:  "The name patterns that tags must match in order to deploy to the environment.",
+			},
+		},
+	}
+}
+
+// validateDeploymentBranchPolicyPattern checks that a deployment branch policy
+// pattern is a valid wildcard pattern, as GitHub only reports invalid patterns
+// when they are created.
+func validateDeploymentBranchPolicyPattern(v any, k cty.Path) diag.Diagnostics {
+	pattern := v.(string)
+
+	var errs []error
+	if pattern == "" {
+		errs = append(errs, fmt.Errorf("the pattern of argument %s must not be empty", k))
+	}
+	if strings.HasPrefix(pattern, "refs/") {
+		errs = append(errs, fmt.Errorf("the pattern %q of argument %s must not start with 'refs/', the name of the branch or tag is matched", pattern, k))
+	}
+	if strings.IndexFunc(pattern, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		errs = append(errs, fmt.Errorf("the pattern %q of argument %s must not contain whitespace", pattern, k))
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		errs = append(errs, fmt.Errorf("the pattern %q of argument %s is not a valid wildcard pattern: %v", pattern, k, err))
+	}
+	return wrapErrors(errs)
+}
+
+// listGithubDeploymentBranchPolicies returns all the deployment branch policies
+// of the environment. The endpoint is paginated, which the go-github method
+// does not support.
+func listGithubDeploymentBranchPolicies(ctx context.Context, client *github.Client, owner, repoName, environment string) ([]*github.DeploymentBranchPolicy, error) {
+	var policies []*github.DeploymentBranchPolicy
+	for page := 1; page != 0; {
+		u := fmt.Sprintf("repos/%s/%s/environments/%s/deployment-branch-policies?per_page=%d&page=%d", owner, repoName, url.PathEscape(environment), maxPerPage, page)
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result := new(github.DeploymentBranchPolicyResponse)
+		resp, err := client.Do(ctx, req, result)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, result.BranchPolicies...)
+		page = resp.NextPage
+	}
+	return policies, nil
+}
+
+func resourceGithubRepositoryDeploymentBranchPoliciesCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoName := d.Get("repository").(string)
+	environment := d.Get("environment").(string)
+	escapedEnvironment := url.PathEscape(environment)
+
+	desired := map[string]*schema.Set{
+		"branch": d.Get("branch_patterns").(*schema.Set),
+		"tag":    d.Get("tag_patterns").(*schema.Set),
+	}
+
+	existing, err := listGithubDeploymentBranchPolicies(ctx, client, owner, repoName, environment)
+	if err != nil {
+		return err
+	}
+
+	// Policies are only deleted and created, as renaming one to a pattern
+	// also in use would fail.
+	found := map[string]map[string]bool{"branch": {}, "tag": {}}
+	for _, policy := range existing {
+		policyType := policy.GetType()
+		if policyType == "" {
+			policyType = "branch"
+		}
+		if patterns, ok := desired[policyType]; ok && patterns.Contains(policy.GetName()) {
+			found[policyType][policy.GetName()] = true
+			continue
+		}
+		log.Printf("[DEBUG] Deleting %s deployment policy %s of %s/%s/%s", policyType, policy.GetName(), owner, repoName, environment)
+		if _, err := client.Repositories.DeleteDeploymentBranchPolicy(ctx, owner, repoName, escapedEnvironment, policy.GetID()); err != nil {
+			return err
+		}
+	}
+
+	for policyType, patterns := range desired {
+		for _, pattern := range expandStringList(patterns.List()) {
+			if found[policyType][pattern] {
+				continue
+			}
+			log.Printf("[DEBUG] Creating %s deployment policy %s of %s/%s/%s", policyType, pattern, owner, repoName, environment)
+			request := &github.DeploymentBranchPolicyRequest{Name: github.Ptr(pattern), Type: github.Ptr(policyType)}
+			if _, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, owner, repoName, escapedEnvironment, request); err != nil {
+				return err
+			}
+		}
+	}
+
+	d.SetId(buildTwoPartID(repoName, environment))
+	return resourceGithubRepositoryDeploymentBranchPoliciesRead(d, meta)
+}
+
+func resourceGithubRepositoryDeploymentBranchPoliciesRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, environment, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+
+	policies, err := listGithubDeploymentBranchPolicies(ctx, client, owner, repoName, environment)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "deployment branch policies %s", d.Id())
+	}
+
+	branchPatterns := make([]string, 0)
+	tagPatterns := make([]string, 0)
+	for _, policy := range policies {
+		if policy.GetType() == "tag" {
+			tagPatterns = append(tagPatterns, policy.GetName())
+		} else {
+			branchPatterns = append(branchPatterns, policy.GetName())
+		}
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("environment", environment); err != nil {
+		return err
+	}
+	if err = d.Set("branch_patterns", branchPatterns); err != nil {
+		return err
+	}
+	if err = d.Set("tag_patterns", tagPatterns); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDeploymentBranchPoliciesDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName, environment, err := parseTwoPartID(d.Id(), "repository", "environment")
+	if err != nil {
+		return err
+	}
+
+	policies, err := listGithubDeploymentBranchPolicies(ctx, client, owner, repoName, environment)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "deployment branch policies %s", d.Id())
+	}
+	for _, policy := range policies {
+		log.Printf("[DEBUG] Deleting deployment policy %s of %s/%s/%s", policy.GetName(), owner, repoName, environment)
+		if _, err := client.Repositories.DeleteDeploymentBranchPolicy(ctx, owner, repoName, url.PathEscape(environment), policy.GetID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDeploymentBranchPoliciesImport(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, _, err := parseTwoPartID(d.Id(), "repository", "environment"); err != nil {
+		return nil, err
+	}
+
+	if err := resourceGithubRepositoryDeploymentBranchPoliciesRead(d, meta); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateDeploymentBranchPolicyPattern(t *testing.T) {
+	testCases := map[string]bool{
+		"main":          true,
+		"release/*":     true,
+		"release/**/*":  true,
+		"v[0-9]*":       true,
+		"":              false,
+		"refs/heads/*":  false,
+		"feature /*":    false,
+		"release/[0-9":  false,
+		"hotfix/\t*":    false,
+		"releases/v1.*": true,
+	}
+
+	for pattern, valid := range testCases {
+		diags := validateDeploymentBranchPolicyPattern(pattern, cty.Path{})
+		if diags.HasError() == valid {
+			t.Errorf("pattern %q: expected valid=%v, got %v", pattern, valid, diags)
+		}
+	}
+}
+
+func TestListGithubDeploymentBranchPolicies(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/tf-acc-org/repo/environments/production/deployment-branch-policies", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("page") == "1" {
+			w.Header().Set("Link", `<https://api.github.com/repos/tf-acc-org/repo/environments/production/deployment-branch-policies?per_page=100&page=2>; rel="next"`)
+			mustWrite(w, `{"total_count": 2, "branch_policies": [{"id": 1, "name": "main", "type": "branch"}]}`)
+			return
+		}
+		mustWrite(w, `{"total_count": 2, "branch_policies": [{"id": 2, "name": "v*", "type": "tag"}]}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	policies, err := listGithubDeploymentBranchPolicies(context.Background(), client, "tf-acc-org", "repo", "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(policies) != 2 || policies[0].GetName() != "main" || policies[1].GetType() != "tag" {
+		t.Errorf("expected the policies of both pages, got %v", policies)
+	}
+}
+
+func TestAccGithubRepositoryDeploymentBranchPoliciesResource(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages the deployment branch policies of an environment", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_environment" "env" {
+				repository  = github_repository.test.name
+				environment = "my_env"
+				deployment_branch_policy {
+					protected_branches     = false
+					custom_branch_policies = true
+				}
+			}
+
+			resource "github_repository_deployment_branch_policies" "test" {
+				repository      = github_repository.test.name
+				environment     = github_repository_environment.env.environment
+				branch_patterns = [%s]
+				tag_patterns    = ["v*"]
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_deployment_branch_policies.test", "branch_patterns.#", "2"),
+				resource.TestCheckResourceAttr("github_repository_deployment_branch_policies.test", "tag_patterns.#", "1"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_deployment_branch_policies.test", "branch_patterns.#", "3"),
+				resource.TestCheckTypeSetElemAttr("github_repository_deployment_branch_policies.test", "branch_patterns.*", "hotfix/*"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, `"main", "release/*"`),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, `"main", "release/*", "hotfix/*"`),
+						Check:  checks["after"],
+					},
+					{
+						ResourceName:      "github_repository_deployment_branch_policies.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
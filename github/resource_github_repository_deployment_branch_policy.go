@@ -35,9 +35,10 @@ func resourceGithubRepositoryDeploymentBranchPolicy() *schema.Resource {
 				Description: "The target environment name.",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the branch",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateDeploymentBranchPolicyPattern,
+				Description:      "The name of the branch",
 			},
 			"etag": {
 				Type:        schema.TypeString,
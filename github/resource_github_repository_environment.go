@@ -2,9 +2,11 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +23,7 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceGithubRepositoryEnvironmentCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:        schema.TypeString,
@@ -34,6 +37,11 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 				ForceNew:    true,
 				Description: "The name of the environment.",
 			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to 'true' to mark the deployments to the environment inactive and delete them on destroy, so that the environment can be deleted.",
+			},
 			"can_admins_bypass": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -56,7 +64,7 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    6,
-				Description: "The environment reviewers configuration.",
+				Description: "The environment reviewers configuration. The reviewers of all blocks are combined.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"teams": {
@@ -71,6 +79,12 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeInt},
 							Description: "Up to 6 IDs for users who may review jobs that reference the environment. Reviewers must have at least read access to the repository. Only one of the required reviewers needs to approve the job for it to proceed.",
 						},
+						"apps": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Up to 6 IDs for GitHub Apps who must approve jobs that reference the environment, through custom deployment protection rules. The apps must be installed on the repository.",
+						},
 					},
 				},
 			},
@@ -117,6 +131,10 @@ func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta any)
 
 	d.SetId(buildTwoPartID(repoName, envName))
 
+	if err := updateEnvironmentAppReviewers(ctx, d, meta); err != nil {
+		return err
+	}
+
 	return resourceGithubRepositoryEnvironmentRead(d, meta)
 }
 
@@ -150,6 +168,13 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta any) e
 	_ = d.Set("wait_timer", nil)
 	_ = d.Set("can_admins_bypass", env.CanAdminsBypass)
 
+	apps, err := readEnvironmentAppReviewers(ctx, d, meta, owner, repoName, escapedEnvName)
+	if err != nil {
+		return err
+	}
+	teams := make([]int64, 0)
+	users := make([]int64, 0)
+
 	for _, pr := range env.ProtectionRules {
 		switch *pr.Type {
 		case "wait_timer":
@@ -158,9 +183,6 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta any) e
 			}
 
 		case "required_reviewers":
-			teams := make([]int64, 0)
-			users := make([]int64, 0)
-
 			for _, r := range pr.Reviewers {
 				switch *r.Type {
 				case "Team":
@@ -173,14 +195,6 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta any) e
 					}
 				}
 			}
-			if err = d.Set("reviewers", []any{
-				map[string]any{
-					"teams": teams,
-					"users": users,
-				},
-			}); err != nil {
-				return err
-			}
 
 			if err = d.Set("prevent_self_review", pr.PreventSelfReview); err != nil {
 				return err
@@ -188,6 +202,27 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta any) e
 		}
 	}
 
+	// The configured blocks are kept as long as they combine to the reviewers
+	// of the environment. Otherwise teams, users and apps are combined in a
+	// single block, which is only absent when the environment has no
+	// reviewers and none are configured.
+	current := d.Get("reviewers")
+	reviewers := make([]any, 0)
+	if sameReviewers(expandReviewers(current, "teams"), teams) &&
+		sameReviewers(expandReviewers(current, "users"), users) &&
+		sameReviewers(expandReviewers(current, "apps"), apps) {
+		reviewers = current.([]any)
+	} else if len(teams) > 0 || len(users) > 0 || len(apps) > 0 || len(current.([]any)) > 0 {
+		reviewers = append(reviewers, map[string]any{
+			"teams": teams,
+			"users": users,
+			"apps":  apps,
+		})
+	}
+	if err = d.Set("reviewers", reviewers); err != nil {
+		return err
+	}
+
 	if env.DeploymentBranchPolicy != nil {
 		if err = d.Set("deployment_branch_policy", []any{
 			map[string]any{
@@ -222,6 +257,10 @@ func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta any)
 
 	d.SetId(buildTwoPartID(repoName, resultKey.GetName()))
 
+	if err := updateEnvironmentAppReviewers(ctx, d, meta); err != nil {
+		return err
+	}
+
 	return resourceGithubRepositoryEnvironmentRead(d, meta)
 }
 
@@ -237,10 +276,53 @@ func resourceGithubRepositoryEnvironmentDelete(d *schema.ResourceData, meta any)
 
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
+	if d.Get("force_destroy").(bool) {
+		if err = deleteEnvironmentDeployments(ctx, meta, repoName, envName); err != nil {
+			return err
+		}
+	}
+
 	_, err = client.Repositories.DeleteEnvironment(ctx, owner, repoName, escapedEnvName)
 	return err
 }
 
+// deleteEnvironmentDeployments deletes the deployments to an environment,
+// marking them inactive first as active deployments cannot be deleted.
+func deleteEnvironmentDeployments(ctx context.Context, meta any, repoName, envName string) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	var deployments []*github.Deployment
+	opts := &github.DeploymentsListOptions{
+		Environment: envName,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	for {
+		page, resp, err := client.Repositories.ListDeployments(ctx, owner, repoName, opts)
+		if err != nil {
+			return err
+		}
+		deployments = append(deployments, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	for _, deployment := range deployments {
+		log.Printf("[DEBUG] Deleting deployment %d to environment %s of %s/%s", deployment.GetID(), envName, owner, repoName)
+		if _, _, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repoName, deployment.GetID(), &github.DeploymentStatusRequest{
+			State: github.Ptr("inactive"),
+		}); err != nil {
+			return err
+		}
+		if _, err := client.Repositories.DeleteDeployment(ctx, owner, repoName, deployment.GetID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createUpdateEnvironmentData(d *schema.ResourceData, meta any) github.CreateUpdateEnvironment {
 	data := github.CreateUpdateEnvironment{}
 
@@ -283,16 +365,147 @@ func createUpdateEnvironmentData(d *schema.ResourceData, meta any) github.Create
 	return data
 }
 
+// expandReviewers returns the IDs of the given kind of reviewers of all the
+// reviewers blocks, without duplicates.
 func expandReviewers(v any, target string) []int64 {
 	res := make([]int64, 0)
-	m := v.([]any)[0]
-	if m != nil {
+	l, ok := v.([]any)
+	if !ok {
+		return res
+	}
+	for _, m := range l {
+		if m == nil {
+			continue
+		}
 		if v, ok := m.(map[string]any)[target]; ok {
-			vL := v.(*schema.Set).List()
-			for _, v := range vL {
-				res = append(res, int64(v.(int)))
+			for _, v := range v.(*schema.Set).List() {
+				if id := int64(v.(int)); !slices.Contains(res, id) {
+					res = append(res, id)
+				}
 			}
 		}
 	}
 	return res
 }
+
+// sameReviewers reports whether both lists hold the same reviewer IDs,
+// regardless of their order.
+func sameReviewers(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// listEnvironmentAppReviewers returns the custom deployment protection rules
+// of an environment, keyed by app ID.
+func listEnvironmentAppReviewers(ctx context.Context, client *github.Client, owner, repoName, escapedEnvName string) (map[int64]*github.CustomDeploymentProtectionRule, error) {
+	list, _, err := client.Repositories.GetAllDeploymentProtectionRules(ctx, owner, repoName, escapedEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[int64]*github.CustomDeploymentProtectionRule)
+	for _, rule := range list.ProtectionRules {
+		rules[rule.GetApp().GetID()] = rule
+	}
+	return rules, nil
+}
+
+// readEnvironmentAppReviewers returns the app reviewers of the environment
+// managed by the resource. Custom deployment protection rules of other apps
+// are ignored, so that rules added outside of Terraform do not cause diffs.
+func readEnvironmentAppReviewers(ctx context.Context, d *schema.ResourceData, meta any, owner, repoName, escapedEnvName string) ([]int64, error) {
+	managed := expandReviewers(d.Get("reviewers"), "apps")
+	if len(managed) == 0 {
+		return []int64{}, nil
+	}
+
+	rules, err := listEnvironmentAppReviewers(ctx, meta.(*Owner).v3client, owner, repoName, escapedEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := make([]int64, 0, len(managed))
+	for _, app := range managed {
+		if _, ok := rules[app]; ok {
+			apps = append(apps, app)
+		}
+	}
+	return apps, nil
+}
+
+// updateEnvironmentAppReviewers creates the custom deployment protection rules
+// of the configured apps, and disables the ones of apps no longer configured.
+func updateEnvironmentAppReviewers(ctx context.Context, d *schema.ResourceData, meta any) error {
+	if !d.HasChange("reviewers") {
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	escapedEnvName := url.PathEscape(d.Get("environment").(string))
+
+	o, n := d.GetChange("reviewers")
+	previous := expandReviewers(o, "apps")
+	desired := expandReviewers(n, "apps")
+	if len(previous) == 0 && len(desired) == 0 {
+		return nil
+	}
+
+	rules, err := listEnvironmentAppReviewers(ctx, client, owner, repoName, escapedEnvName)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range desired {
+		if _, ok := rules[app]; ok {
+			continue
+		}
+		log.Printf("[DEBUG] Adding app %d as reviewer of environment %s", app, d.Id())
+		_, _, err := client.Repositories.CreateCustomDeploymentProtectionRule(ctx, owner, repoName, escapedEnvName, &github.CustomDeploymentProtectionRuleRequest{
+			IntegrationID: github.Ptr(app),
+		})
+		if err != nil {
+			return fmt.Errorf("could not add app %d as reviewer of environment %s: %w", app, d.Id(), err)
+		}
+	}
+
+	for _, app := range previous {
+		rule, ok := rules[app]
+		if !ok || slices.Contains(desired, app) {
+			continue
+		}
+		log.Printf("[DEBUG] Removing app %d as reviewer of environment %s", app, d.Id())
+		if _, err := client.Repositories.DisableCustomDeploymentProtectionRule(ctx, owner, repoName, escapedEnvName, rule.GetID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceGithubRepositoryEnvironmentCustomizeDiff validates the limit GitHub
+// puts on the combined number of user and team reviewers.
+func resourceGithubRepositoryEnvironmentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	v, ok := d.GetOk("reviewers")
+	if !ok {
+		return nil
+	}
+
+	count := len(expandReviewers(v, "teams")) + len(expandReviewers(v, "users"))
+	if count > 6 {
+		return fmt.Errorf("an environment can have at most 6 team and user reviewers combined, got %d", count)
+	}
+	if apps := len(expandReviewers(v, "apps")); apps > 6 {
+		return fmt.Errorf("an environment can have at most 6 app reviewers, got %d", apps)
+	}
+
+	return nil
+}
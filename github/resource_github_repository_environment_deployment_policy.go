@@ -36,18 +36,20 @@ func resourceGithubRepositoryEnvironmentDeploymentPolicy() *schema.Resource {
 				Description: "The name of the environment.",
 			},
 			"branch_pattern": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      false,
-				ConflictsWith: []string{"tag_pattern"},
-				Description:   "The name pattern that branches must match in order to deploy to the environment.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ConflictsWith:    []string{"tag_pattern"},
+				ValidateDiagFunc: validateDeploymentBranchPolicyPattern,
+				Description:      "The name pattern that branches must match in order to deploy to the environment.",
 			},
 			"tag_pattern": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      false,
-				ConflictsWith: []string{"branch_pattern"},
-				Description:   "The name pattern that tags must match in order to deploy to the environment.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ConflictsWith:    []string{"branch_pattern"},
+				ValidateDiagFunc: validateDeploymentBranchPolicyPattern,
+				Description:      "The name pattern that tags must match in order to deploy to the environment.",
 			},
 		},
 		CustomizeDiff: customDeploymentPolicyDiffFunction,
@@ -2,10 +2,16 @@ package github
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccGithubRepositoryEnvironment(t *testing.T) {
@@ -75,4 +81,198 @@ func TestAccGithubRepositoryEnvironment(t *testing.T) {
 		})
 
 	})
+
+	t.Run("updates reviewers and wait timer in place", func(t *testing.T) {
+
+		config := `
+			data "github_user" "current" {
+				username = ""
+			}
+
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-reviewers-%s"
+				visibility = "public"
+			}
+
+			resource "github_repository_environment" "test" {
+				repository  = github_repository.test.name
+				environment = "test"
+				wait_timer  = %d
+				%s
+			}
+		`
+		reviewers := `
+				reviewers {
+					users = [data.github_user.current.id]
+				}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_environment.test", "wait_timer", "10"),
+				resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.#", "0"),
+			),
+			"after": resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_environment.test", "wait_timer", "20"),
+				resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.0.users.#", "1"),
+				resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.0.teams.#", "0"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, 10, ""),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, 20, reviewers),
+						Check:  checks["after"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, 10, ""),
+						Check:  checks["before"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+	t.Run("manages app reviewers", func(t *testing.T) {
+		appID := os.Getenv("GITHUB_TEST_DEPLOYMENT_PROTECTION_APP_ID")
+		if appID == "" {
+			t.Skip("Skipping because `GITHUB_TEST_DEPLOYMENT_PROTECTION_APP_ID` is not set")
+		}
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-app-reviewers-%s"
+				visibility = "public"
+			}
+
+			resource "github_repository_environment" "test" {
+				repository  = github_repository.test.name
+				environment = "test"
+				reviewers {
+					apps = [%s]
+				}
+			}
+		`, randomID, appID)
+
+		check := resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_environment.test", "reviewers.0.apps.#", "1"),
+			resource.TestCheckTypeSetElemAttr("github_repository_environment.test", "reviewers.0.apps.*", appID),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
+
+func TestResourceGithubRepositoryEnvironmentForceDestroy(t *testing.T) {
+	var calls []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/deployments", func(w http.ResponseWriter, req *http.Request) {
+		if env := req.URL.Query().Get("environment"); env != "prod eu" {
+			t.Errorf("unexpected environment %s", env)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"id": 1}, {"id": 2}]`)
+	})
+	mux.HandleFunc("POST /repos/acme/app/deployments/{id}/statuses", func(w http.ResponseWriter, req *http.Request) {
+		if body := mustRead(req.Body); body != `{"state":"inactive"}`+"\n" {
+			t.Errorf("unexpected deployment status %s", body)
+		}
+		calls = append(calls, "inactive "+req.PathValue("id"))
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"state": "inactive"}`)
+	})
+	mux.HandleFunc("DELETE /repos/acme/app/deployments/{id}", func(w http.ResponseWriter, req *http.Request) {
+		calls = append(calls, "delete "+req.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("DELETE /repos/acme/app/environments/{name}", func(w http.ResponseWriter, req *http.Request) {
+		calls = append(calls, "delete environment "+req.PathValue("name"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryEnvironment().Schema, map[string]any{
+		"repository":    "app",
+		"environment":   "prod eu",
+		"force_destroy": true,
+	})
+	d.SetId("app:prod eu")
+	if err := resourceGithubRepositoryEnvironmentDelete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"inactive 1", "delete 1", "inactive 2", "delete 2", "delete environment prod eu"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestExpandReviewers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryEnvironment().Schema, map[string]any{
+		"repository":  "app",
+		"environment": "prod",
+		"reviewers": []any{
+			map[string]any{"teams": []any{1, 2}, "users": []any{10}},
+			map[string]any{"teams": []any{2, 3}, "apps": []any{100}},
+		},
+	})
+
+	if teams := expandReviewers(d.Get("reviewers"), "teams"); !sameReviewers(teams, []int64{1, 2, 3}) {
+		t.Errorf("expected teams [1 2 3], got %v", teams)
+	}
+	if users := expandReviewers(d.Get("reviewers"), "users"); !reflect.DeepEqual(users, []int64{10}) {
+		t.Errorf("expected users [10], got %v", users)
+	}
+	if apps := expandReviewers(d.Get("reviewers"), "apps"); !reflect.DeepEqual(apps, []int64{100}) {
+		t.Errorf("expected apps [100], got %v", apps)
+	}
 }
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -68,8 +69,7 @@ func resourceGithubRepositoryFile() *schema.Resource {
 			"file": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "The file path to manage",
+				Description: "The file path to manage. Changing only the path renames the file in a single commit.",
 			},
 			"content": {
 				Type:        schema.TypeString,
@@ -146,6 +146,14 @@ func resourceGithubRepositoryFile() *schema.Resource {
 				DiffSuppressFunc: autoBranchDiffSuppressFunc,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// A file whose path and content both change is recreated, only a
+			// pure rename is performed in place.
+			customdiff.ForceNewIf("file", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("content")
+			}),
+		),
 	}
 }
 
@@ -433,6 +441,10 @@ func resourceGithubRepositoryFileUpdate(d *schema.ResourceData, meta any) error
 		return err
 	}
 
+	if d.HasChange("file") {
+		return resourceGithubRepositoryFileRename(d, meta, opts)
+	}
+
 	if *opts.Message == fmt.Sprintf("Add %s", file) {
 		m := fmt.Sprintf("Update %s", file)
 		opts.Message = &m
@@ -512,6 +524,138 @@ func resourceGithubRepositoryFileDelete(d *schema.ResourceData, meta any) error
 	return nil
 }
 
+// getTreeEntry returns the entry at filePath within the tree treeSHA, or nil
+// if there is none. The tree is walked one directory at a time, as the
+// recursive listing of large trees is truncated.
+func getTreeEntry(ctx context.Context, client *github.Client, owner, repo, treeSHA, filePath string) (*github.TreeEntry, error) {
+	parts := strings.Split(filePath, "/")
+	for i, part := range parts {
+		tree, _, err := client.Git.GetTree(ctx, owner, repo, treeSHA, false)
+		if err != nil {
+			return nil, err
+		}
+
+		var entry *github.TreeEntry
+		for _, e := range tree.Entries {
+			if e.GetPath() == part {
+				entry = e
+				break
+			}
+		}
+		if entry == nil {
+			return nil, nil
+		}
+		if i == len(parts)-1 {
+			return entry, nil
+		}
+		if entry.GetType() != "tree" {
+			return nil, nil
+		}
+		treeSHA = entry.GetSHA()
+	}
+
+	return nil, nil
+}
+
+// resourceGithubRepositoryFileRename moves the file to its new path in a single
+// commit through the Git Data API, since the contents API can only create and
+// delete files in separate commits.
+func resourceGithubRepositoryFileRename(d *schema.ResourceData, meta any, opts *github.RepositoryContentFileOptions) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repo := d.Get("repository").(string)
+	o, n := d.GetChange("file")
+	oldFile, newFile := o.(string), n.(string)
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		repository, _, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return err
+		}
+		branch = repository.GetDefaultBranch()
+	}
+	branchRefName := "refs/heads/" + branch
+
+	ref, _, err := client.Git.GetRef(ctx, owner, repo, branchRefName)
+	if err != nil {
+		return fmt.Errorf("error querying GitHub branch reference %s/%s (%s): %s",
+			owner, repo, branchRefName, err)
+	}
+	parent, _, err := client.Git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	oldEntry, err := getTreeEntry(ctx, client, owner, repo, parent.GetTree().GetSHA(), oldFile)
+	if err != nil {
+		return err
+	}
+	if oldEntry == nil {
+		return fmt.Errorf("file %s does not exist in %s/%s (%s)", oldFile, owner, repo, branch)
+	}
+	newEntry, err := getTreeEntry(ctx, client, owner, repo, parent.GetTree().GetSHA(), newFile)
+	if err != nil {
+		return err
+	}
+	if newEntry != nil {
+		return fmt.Errorf("cannot rename %s to %s in %s/%s (%s), a file already exists at that path",
+			oldFile, newFile, owner, repo, branch)
+	}
+
+	// An entry without SHA nor content deletes the file at that path. The
+	// mode of the file is kept, e.g. for executables.
+	tree, _, err := client.Git.CreateTree(ctx, owner, repo, parent.GetTree().GetSHA(), []*github.TreeEntry{
+		{
+			Path: github.Ptr(oldFile),
+			Mode: oldEntry.Mode,
+			Type: github.Ptr("blob"),
+		},
+		{
+			Path: github.Ptr(newFile),
+			Mode: oldEntry.Mode,
+			Type: github.Ptr("blob"),
+			SHA:  github.Ptr(d.Get("sha").(string)),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Rename %s to %s", oldFile, newFile)
+	if !d.GetRawConfig().GetAttr("commit_message").IsNull() {
+		message = opts.GetMessage()
+	}
+
+	commit, _, err := client.Git.CreateCommit(ctx, owner, repo, &github.Commit{
+		Message:   github.Ptr(message),
+		Tree:      tree,
+		Parents:   []*github.Commit{{SHA: parent.SHA}},
+		Author:    opts.Author,
+		Committer: opts.Committer,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Renaming repository file %s/%s/%s to %s in commit %s", owner, repo, oldFile, newFile, commit.GetSHA())
+	if _, _, err := client.Git.UpdateRef(ctx, owner, repo, &github.Reference{
+		Ref:    github.Ptr(branchRefName),
+		Object: &github.GitObject{SHA: commit.SHA},
+	}, false); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", repo, newFile))
+	if err = d.Set("commit_sha", commit.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryFileRead(d, meta)
+}
+
 func autoBranchDiffSuppressFunc(k, _, _ string, d *schema.ResourceData) bool {
 	if !d.Get("autocreate_branch").(bool) {
 		switch k {
@@ -1,11 +1,15 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -344,4 +348,104 @@ func TestAccGithubRepositoryFile(t *testing.T) {
 		})
 
 	})
+
+	t.Run("renames files in place", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_file" "test" {
+				repository = github_repository.test.name
+				branch     = "main"
+				file       = "%%s"
+				content    = "bar"
+			}
+		`, randomID)
+
+		var commitSHA string
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "before"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_file.test", "file", "before"),
+							resource.TestCheckResourceAttrWith("github_repository_file.test", "commit_sha", func(value string) error {
+								commitSHA = value
+								return nil
+							}),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, "after"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_file.test", "file", "after"),
+							resource.TestCheckResourceAttr("github_repository_file.test", "commit_message", "Rename before to after"),
+							resource.TestCheckResourceAttrWith("github_repository_file.test", "commit_sha", func(value string) error {
+								if value == commitSHA {
+									return fmt.Errorf("expected a new commit for the rename, got %s", value)
+								}
+								return nil
+							}),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
+
+func TestGetTreeEntry(t *testing.T) {
+	trees := map[string]string{
+		"root": `{"sha": "root", "tree": [
+			{"path": "README.md", "mode": "100644", "type": "blob", "sha": "readme"},
+			{"path": "scripts", "mode": "040000", "type": "tree", "sha": "scripts"}
+		]}`,
+		"scripts": `{"sha": "scripts", "tree": [
+			{"path": "build.sh", "mode": "100755", "type": "blob", "sha": "build"}
+		]}`,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/git/trees/{sha}", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, trees[req.PathValue("sha")])
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+
+	for path, mode := range map[string]string{
+		"README.md":         "100644",
+		"scripts/build.sh":  "100755",
+		"scripts/deploy.sh": "",
+		"README.md/nested":  "",
+		"docs/index.md":     "",
+	} {
+		entry, err := getTreeEntry(context.Background(), client, "acme", "app", "root", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry.GetMode() != mode {
+			t.Errorf("expected mode %q for %s, got %q", mode, path, entry.GetMode())
+		}
+	}
 }
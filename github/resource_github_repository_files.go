@@ -0,0 +1,382 @@
+package github
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages a set of files within a GitHub repository, written in a single commit",
+		Create:      resourceGithubRepositoryFilesCreate,
+		Read:        resourceGithubRepositoryFilesRead,
+		Update:      resourceGithubRepositoryFilesUpdate,
+		Delete:      resourceGithubRepositoryFilesDelete,
+
+		CustomizeDiff: resourceGithubRepositoryFilesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The repository name.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to the repository's default branch.",
+			},
+			"files": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"files", "source_directory"},
+				Description:  "The content of the files to manage, keyed by their path in the repository.",
+			},
+			"source_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"files", "source_directory"},
+				Description:  "A local directory whose files are managed, at the same relative paths in the repository.",
+			},
+			"target_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_directory"},
+				Description:  "The directory of the repository to write the files of 'source_directory' to, defaults to the root of the repository.",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the files.",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_email"},
+				Description:  "The commit author name, defaults to the authenticated user's name. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_author"},
+				Description:  "The commit author email address, defaults to the authenticated user's email address. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last modified the files, empty if the files never differed.",
+			},
+			"file_shas": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The blob SHA of the files, keyed by their path in the repository.",
+			},
+		},
+	}
+}
+
+// gitBlobSHA returns the SHA git computes for a blob of the content, which
+// allows to tell whether a file changed without downloading it.
+func gitBlobSHA(content string) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readRepositoryFilesDirectory returns the content of the files of a local
+// directory, keyed by their path in the repository below targetDirectory.
+func readRepositoryFilesDirectory(sourceDirectory, targetDirectory string) (map[string]any, error) {
+	files := make(map[string]any)
+	err := filepath.WalkDir(sourceDirectory, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDirectory, p)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[path.Join(strings.Trim(targetDirectory, "/"), filepath.ToSlash(rel))] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading source directory %s: %w", sourceDirectory, err)
+	}
+	return files, nil
+}
+
+// repositoryFilesTreeEntries returns the tree entries writing the files of
+// the new map and deleting the files only in the old map, skipping the files
+// that are already identical. Existing files keep their mode, e.g. executable.
+func repositoryFilesTreeEntries(blobs map[string]*github.TreeEntry, oldFiles, newFiles map[string]any) []*github.TreeEntry {
+	paths := make([]string, 0, len(oldFiles)+len(newFiles))
+	for p := range newFiles {
+		paths = append(paths, p)
+	}
+	for p := range oldFiles {
+		if _, ok := newFiles[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	entries := make([]*github.TreeEntry, 0)
+	for _, p := range paths {
+		blob, exists := blobs[p]
+		content, managed := newFiles[p]
+		mode := "100644"
+		if exists && blob.GetMode() != "" {
+			mode = blob.GetMode()
+		}
+		switch {
+		case !managed && exists:
+			// An entry without SHA nor content deletes the file at that path.
+			entries = append(entries, &github.TreeEntry{
+				Path: github.Ptr(p),
+				Mode: github.Ptr(mode),
+				Type: github.Ptr("blob"),
+			})
+		case managed && (!exists || blob.GetSHA() != gitBlobSHA(content.(string))):
+			entries = append(entries, &github.TreeEntry{
+				Path:    github.Ptr(p),
+				Mode:    github.Ptr(mode),
+				Type:    github.Ptr("blob"),
+				Content: github.Ptr(content.(string)),
+			})
+		}
+	}
+	return entries
+}
+
+// writeGithubRepositoryFiles writes the files of the new map and deletes the
+// files only in the old map in a single commit on the branch, skipping the
+// files that are already identical.
+func writeGithubRepositoryFiles(ctx context.Context, d *schema.ResourceData, meta any, oldFiles, newFiles map[string]any, defaultMessage string) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	headSHA, err := getGithubBranchHeadSHA(ctx, client, owner, repoName, branch)
+	if err != nil {
+		return err
+	}
+	parent, _, err := client.Git.GetCommit(ctx, owner, repoName, headSHA)
+	if err != nil {
+		return err
+	}
+	blobs, err := listGithubTreeBlobs(ctx, client, owner, repoName, parent.GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	entries := repositoryFilesTreeEntries(blobs, oldFiles, newFiles)
+	if len(entries) == 0 {
+		log.Printf("[DEBUG] Repository files of %s/%s (%s) are already up to date", owner, repoName, branch)
+		return nil
+	}
+
+	tree, _, err := client.Git.CreateTree(ctx, owner, repoName, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return err
+	}
+
+	message := defaultMessage
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+	commit := &github.Commit{
+		Message: github.Ptr(message),
+		Tree:    tree,
+		Parents: []*github.Commit{{SHA: parent.SHA}},
+	}
+	if commitAuthor, ok := d.GetOk("commit_author"); ok {
+		author := &github.CommitAuthor{
+			Name:  github.Ptr(commitAuthor.(string)),
+			Email: github.Ptr(d.Get("commit_email").(string)),
+		}
+		commit.Author = author
+		commit.Committer = author
+	}
+
+	result, _, err := client.Git.CreateCommit(ctx, owner, repoName, commit, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Writing %d repository files to %s/%s (%s) in commit %s", len(entries), owner, repoName, branch, result.GetSHA())
+	if _, _, err := client.Git.UpdateRef(ctx, owner, repoName, &github.Reference{
+		Ref:    github.Ptr("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: result.SHA},
+	}, false); err != nil {
+		return err
+	}
+
+	return d.Set("commit_sha", result.GetSHA())
+}
+
+func resourceGithubRepositoryFilesCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	if d.Get("branch").(string) == "" {
+		repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("branch", repo.GetDefaultBranch()); err != nil {
+			return err
+		}
+	}
+
+	files := d.Get("files").(map[string]any)
+	if err := writeGithubRepositoryFiles(ctx, d, meta, nil, files, fmt.Sprintf("Add %d files", len(files))); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(repoName, d.Get("branch").(string)))
+
+	return resourceGithubRepositoryFilesRead(d, meta)
+}
+
+func resourceGithubRepositoryFilesRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, branch, err := parseTwoPartID(d.Id(), "repository", "branch")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	headSHA, err := getGithubBranchHeadSHA(ctx, client, owner, repoName, branch)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing repository files %s from state because the branch no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	head, _, err := client.Git.GetCommit(ctx, owner, repoName, headSHA)
+	if err != nil {
+		return err
+	}
+	blobs, err := listGithubTreeBlobs(ctx, client, owner, repoName, head.GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	// Only the files which changed since they were written are downloaded,
+	// the blob SHAs tell the others apart.
+	files := make(map[string]any)
+	fileSHAs := make(map[string]any)
+	for p, content := range d.Get("files").(map[string]any) {
+		blob, ok := blobs[p]
+		if !ok {
+			log.Printf("[INFO] Repository file %s/%s/%s no longer exists in GitHub", owner, repoName, p)
+			continue
+		}
+		fileSHAs[p] = blob.GetSHA()
+		if blob.GetSHA() == gitBlobSHA(content.(string)) {
+			files[p] = content
+			continue
+		}
+
+		log.Printf("[INFO] Repository file %s/%s/%s changed in GitHub", owner, repoName, p)
+		actual, _, err := client.Git.GetBlob(ctx, owner, repoName, blob.GetSHA())
+		if err != nil {
+			return err
+		}
+		decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(actual.GetContent(), "\n", ""))
+		if err != nil {
+			return err
+		}
+		files[p] = string(decoded)
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("branch", branch); err != nil {
+		return err
+	}
+	if err = d.Set("files", files); err != nil {
+		return err
+	}
+	if err = d.Set("file_shas", fileSHAs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryFilesUpdate(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	o, n := d.GetChange("files")
+	files := n.(map[string]any)
+	if err := writeGithubRepositoryFiles(ctx, d, meta, o.(map[string]any), files, fmt.Sprintf("Update %d files", len(files))); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryFilesRead(d, meta)
+}
+
+func resourceGithubRepositoryFilesDelete(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	files := d.Get("files").(map[string]any)
+	return writeGithubRepositoryFiles(ctx, d, meta, files, nil, fmt.Sprintf("Delete %d files", len(files)))
+}
+
+// resourceGithubRepositoryFilesCustomizeDiff plans the files of the source
+// directory, if set, so that local changes show up like those of 'files'.
+func resourceGithubRepositoryFilesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	sourceDirectory := d.Get("source_directory").(string)
+	if sourceDirectory == "" {
+		return nil
+	}
+
+	files, err := readRepositoryFilesDirectory(sourceDirectory, d.Get("target_directory").(string))
+	if err != nil {
+		return err
+	}
+	return d.SetNew("files", files)
+}
@@ -0,0 +1,160 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestGitBlobSHA(t *testing.T) {
+	testCases := map[string]string{
+		"":        "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391",
+		"hello\n": "ce013625030ba8dba906f756967f9e9ca394464a",
+	}
+
+	for content, expected := range testCases {
+		if sha := gitBlobSHA(content); sha != expected {
+			t.Errorf("blob SHA of %q: expected %s, got %s", content, expected, sha)
+		}
+	}
+}
+
+func TestReadRepositoryFilesDirectory(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"README.md":          "# Test",
+		"docs/index.md":      "Index",
+		".git/HEAD":          "ref: refs/heads/main",
+		".github/CODEOWNERS": "* @octocat",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := readRepositoryFilesDirectory(dir, "/config/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"config/README.md":          "# Test",
+		"config/docs/index.md":      "Index",
+		"config/.github/CODEOWNERS": "* @octocat",
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected %d files, got %v", len(expected), files)
+	}
+	for p, content := range expected {
+		if files[p] != content {
+			t.Errorf("file %s: expected %q, got %q", p, content, files[p])
+		}
+	}
+}
+
+func TestRepositoryFilesTreeEntries(t *testing.T) {
+	blobs := map[string]*github.TreeEntry{
+		"README.md":      {Path: github.Ptr("README.md"), Mode: github.Ptr("100644"), SHA: github.Ptr(gitBlobSHA("# Test"))},
+		"scripts/run.sh": {Path: github.Ptr("scripts/run.sh"), Mode: github.Ptr("100755"), SHA: github.Ptr(gitBlobSHA("exit 1"))},
+		"old.txt":        {Path: github.Ptr("old.txt"), Mode: github.Ptr("100644"), SHA: github.Ptr(gitBlobSHA("old"))},
+	}
+	oldFiles := map[string]any{"README.md": "# Test", "scripts/run.sh": "exit 1", "old.txt": "old"}
+	newFiles := map[string]any{"README.md": "# Test", "scripts/run.sh": "exit 0", "new.txt": "new"}
+
+	entries := repositoryFilesTreeEntries(blobs, oldFiles, newFiles)
+
+	expected := map[string]string{
+		"new.txt":        "100644",
+		"old.txt":        "100644",
+		"scripts/run.sh": "100755",
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(entries))
+	}
+	for _, entry := range entries {
+		if mode, ok := expected[entry.GetPath()]; !ok || entry.GetMode() != mode {
+			t.Errorf("unexpected entry %s with mode %s", entry.GetPath(), entry.GetMode())
+		}
+	}
+	if entries[1].Content != nil {
+		t.Errorf("expected old.txt to be deleted, got content %q", entries[1].GetContent())
+	}
+}
+
+func TestAccGithubRepositoryFiles(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("writes files in a single commit", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-files-%[1]s"
+				auto_init = true
+			}
+
+			resource "github_repository_files" "test" {
+				repository = github_repository.test.name
+				files = {
+					"README.md"          = "%[2]s"
+					".github/CODEOWNERS" = "* @octocat"
+				}
+				commit_message = "Managed by Terraform"
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_files.test", "branch", "main"),
+				resource.TestCheckResourceAttr("github_repository_files.test", "files.%", "2"),
+				resource.TestCheckResourceAttr("github_repository_files.test", "file_shas.%", "2"),
+				resource.TestCheckResourceAttr("github_repository_files.test", "file_shas..github/CODEOWNERS", gitBlobSHA("* @octocat")),
+				resource.TestCheckResourceAttrSet("github_repository_files.test", "commit_sha"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_files.test", "files.README.md", "# Updated"),
+				resource.TestCheckResourceAttr("github_repository_files.test", "file_shas.README.md", gitBlobSHA("# Updated")),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "# Test"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "# Updated"),
+						Check:  checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
@@ -0,0 +1,573 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// issueFormDirectory is the directory GitHub reads the issue templates and
+// forms of a repository from.
+const issueFormDirectory = ".github/ISSUE_TEMPLATE"
+
+// issueFormElementFields are the attributes supported by each type of form
+// element, and whether they are required.
+var issueFormElementFields = map[string]map[string]bool{
+	"markdown":   {"value": true},
+	"input":      {"label": true, "description": false, "placeholder": false, "value": false, "required": false},
+	"textarea":   {"label": true, "description": false, "placeholder": false, "value": false, "render": false, "required": false},
+	"dropdown":   {"label": true, "description": false, "options": true, "multiple": false, "required": false},
+	"checkboxes": {"label": true, "description": false, "checkbox": true},
+}
+
+var issueFormElementIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func resourceGithubRepositoryIssueForm() *schema.Resource {
+	return &schema.Resource{
+		Description: "Creates and manages an issue form of a GitHub repository",
+		Create:      resourceGithubRepositoryIssueFormCreate,
+		Read:        resourceGithubRepositoryIssueFormRead,
+		Update:      resourceGithubRepositoryIssueFormUpdate,
+		Delete:      resourceGithubRepositoryIssueFormDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryIssueFormImport,
+		},
+
+		CustomizeDiff: resourceGithubRepositoryIssueFormDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The repository name",
+			},
+			"file_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringMatch(regexp.MustCompile(`^[\w.-]+$`), "must be a file name without extension"), "file_name"),
+				Description:      "The name of the form file in '.github/ISSUE_TEMPLATE', without the '.yml' extension.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the form, shown in the template chooser.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The description of the form, shown in the template chooser.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The default title of the issues created with the form.",
+			},
+			"labels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels added to the issues created with the form.",
+			},
+			"assignees": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The users assigned to the issues created with the form.",
+			},
+			"body": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The elements of the form, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateValueFunc([]string{"markdown", "input", "textarea", "dropdown", "checkboxes"}),
+							Description:      "The type of the element. Can be one of 'markdown', 'input', 'textarea', 'dropdown' or 'checkboxes'.",
+						},
+						"id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: toDiagFunc(validation.StringMatch(issueFormElementIDRegexp, "must only contain alphanumeric characters, '-' and '_'"), "id"),
+							Description:      "The identifier of the element, unique in the form.",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The label of the element, required unless of type 'markdown'.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the element.",
+						},
+						"placeholder": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The placeholder of 'input' and 'textarea' elements.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The text of 'markdown' elements, required for them, or the default value of 'input' and 'textarea' elements.",
+						},
+						"render": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The language the value of 'textarea' elements is rendered as a code block of, e.g. 'shell'.",
+						},
+						"multiple": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether several options of 'dropdown' elements can be selected.",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The options of 'dropdown' elements, required for them.",
+						},
+						"checkbox": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The checkboxes of 'checkboxes' elements, required for them.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The label of the checkbox.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the checkbox must be checked to submit the form.",
+									},
+								},
+							},
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the element must be filled in to submit the form, not supported by 'markdown' and 'checkboxes' elements.",
+						},
+					},
+				},
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to the repository's default branch",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the file",
+			},
+			"commit_author": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit author name, defaults to the authenticated user's name.",
+			},
+			"commit_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit author email address, defaults to the authenticated user's email address.",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable overwriting an existing form with the same file name, defaults to \"false\"",
+			},
+			"file": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the form file in the repository.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The YAML content of the form file.",
+			},
+			"sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blob SHA of the file",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last modified the file",
+			},
+		},
+	}
+}
+
+// issueForm is the YAML syntax of issue forms, see
+// https://docs.github.com/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-issue-forms
+type issueForm struct {
+	Name        string             `yaml:"name"`
+	Description string             `yaml:"description"`
+	Title       string             `yaml:"title,omitempty"`
+	Labels      []string           `yaml:"labels,omitempty"`
+	Assignees   []string           `yaml:"assignees,omitempty"`
+	Body        []issueFormElement `yaml:"body"`
+}
+
+type issueFormElement struct {
+	Type        string                `yaml:"type"`
+	ID          string                `yaml:"id,omitempty"`
+	Attributes  issueFormAttributes   `yaml:"attributes"`
+	Validations *issueFormValidations `yaml:"validations,omitempty"`
+}
+
+type issueFormAttributes struct {
+	Label       string `yaml:"label,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Placeholder string `yaml:"placeholder,omitempty"`
+	Value       string `yaml:"value,omitempty"`
+	Render      string `yaml:"render,omitempty"`
+	Multiple    bool   `yaml:"multiple,omitempty"`
+	// Options are strings for dropdowns and issueFormCheckbox for checkboxes.
+	Options []any `yaml:"options,omitempty"`
+}
+
+type issueFormCheckbox struct {
+	Label    string `yaml:"label"`
+	Required bool   `yaml:"required,omitempty"`
+}
+
+type issueFormValidations struct {
+	Required bool `yaml:"required"`
+}
+
+// issueFormFile returns the path of an issue form in the repository.
+func issueFormFile(fileName string) string {
+	return fmt.Sprintf("%s/%s.yml", issueFormDirectory, fileName)
+}
+
+// validateIssueFormElement returns an error when an element of the form sets
+// an attribute its type does not support or misses a required one, as GitHub
+// does not report invalid forms but silently ignores them.
+func validateIssueFormElement(index int, element map[string]any) error {
+	elementType := element["type"].(string)
+	fields, ok := issueFormElementFields[elementType]
+	if !ok {
+		return fmt.Errorf("body.%d: unsupported element type %q", index, elementType)
+	}
+
+	for _, field := range []string{"label", "description", "placeholder", "value", "render", "multiple", "options", "checkbox", "required"} {
+		var set bool
+		switch v := element[field].(type) {
+		case string:
+			set = v != ""
+		case bool:
+			set = v
+		case []any:
+			set = len(v) > 0
+		}
+
+		required, supported := fields[field]
+		if set && !supported {
+			return fmt.Errorf("body.%d: %q is not supported by elements of type %q", index, field, elementType)
+		}
+		if !set && required {
+			return fmt.Errorf("body.%d: %q is required by elements of type %q", index, field, elementType)
+		}
+	}
+
+	return nil
+}
+
+// expandIssueForm returns the issue form of the resource, validated.
+func expandIssueForm(d interface{ Get(string) any }) (*issueForm, error) {
+	form := &issueForm{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Title:       d.Get("title").(string),
+		Labels:      expandStringList(d.Get("labels").([]any)),
+		Assignees:   expandStringList(d.Get("assignees").([]any)),
+	}
+
+	ids := make(map[string]bool)
+	for i, raw := range d.Get("body").([]any) {
+		element := raw.(map[string]any)
+		if err := validateIssueFormElement(i, element); err != nil {
+			return nil, err
+		}
+
+		id := element["id"].(string)
+		if id != "" {
+			if ids[id] {
+				return nil, fmt.Errorf("body.%d: id %q is not unique", i, id)
+			}
+			ids[id] = true
+		}
+
+		e := issueFormElement{
+			Type: element["type"].(string),
+			ID:   id,
+			Attributes: issueFormAttributes{
+				Label:       element["label"].(string),
+				Description: element["description"].(string),
+				Placeholder: element["placeholder"].(string),
+				Value:       element["value"].(string),
+				Render:      element["render"].(string),
+				Multiple:    element["multiple"].(bool),
+			},
+		}
+		for _, option := range element["options"].([]any) {
+			e.Attributes.Options = append(e.Attributes.Options, option.(string))
+		}
+		for _, raw := range element["checkbox"].([]any) {
+			checkbox := raw.(map[string]any)
+			e.Attributes.Options = append(e.Attributes.Options, issueFormCheckbox{
+				Label:    checkbox["label"].(string),
+				Required: checkbox["required"].(bool),
+			})
+		}
+		if element["required"].(bool) {
+			e.Validations = &issueFormValidations{Required: true}
+		}
+
+		form.Body = append(form.Body, e)
+	}
+
+	return form, nil
+}
+
+// renderIssueForm returns the YAML content of the form file.
+func renderIssueForm(form *issueForm) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(form); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// resourceGithubRepositoryIssueFormDiff validates the form and plans the
+// content of the form file, which is updated when it differs from the file
+// in the repository.
+func resourceGithubRepositoryIssueFormDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{"name", "description", "title", "labels", "assignees", "body"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("content")
+		}
+	}
+
+	form, err := expandIssueForm(diff)
+	if err != nil {
+		return err
+	}
+	content, err := renderIssueForm(form)
+	if err != nil {
+		return err
+	}
+
+	if content != diff.Get("content").(string) {
+		return diff.SetNew("content", content)
+	}
+	return nil
+}
+
+func resourceGithubRepositoryIssueFormCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repo := d.Get("repository").(string)
+	file := issueFormFile(d.Get("file_name").(string))
+
+	opts, err := resourceGithubRepositoryFileOptions(d)
+	if err != nil {
+		return err
+	}
+	if opts.Message == nil {
+		opts.Message = github.Ptr(fmt.Sprintf("Add %s", file))
+	}
+
+	if err = waitForRepositoryVisible(ctx, meta, repo); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Checking if overwriting an issue form: %s/%s/%s", owner, repo, file)
+	getOpts := &github.RepositoryContentGetOptions{Ref: opts.GetBranch()}
+	fc, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, file, getOpts)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+	if fc != nil {
+		if !d.Get("overwrite_on_create").(bool) {
+			return fmt.Errorf("refusing to overwrite existing file: configure `overwrite_on_create` to `true` to override")
+		}
+		opts.SHA = fc.SHA
+	}
+
+	result, _, err := client.Repositories.CreateFile(ctx, owner, repo, file, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(repo, d.Get("file_name").(string)))
+	if err = d.Set("commit_sha", result.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryIssueFormRead(d, meta)
+}
+
+func resourceGithubRepositoryIssueFormRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo, fileName, err := parseTwoPartID(d.Id(), "repository", "file_name")
+	if err != nil {
+		return err
+	}
+	file := issueFormFile(fileName)
+
+	opts := &github.RepositoryContentGetOptions{}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Ref = branch.(string)
+	}
+
+	fc, _, _, err := client.Repositories.GetContents(ctx, owner, repo, file, opts)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "issue form %s/%s/%s", owner, repo, file)
+	}
+	if fc == nil {
+		return fmt.Errorf("%s is not a file in the %s/%s repository", file, owner, repo)
+	}
+
+	content, err := fc.GetContent()
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("repository", repo); err != nil {
+		return err
+	}
+	if err = d.Set("file_name", fileName); err != nil {
+		return err
+	}
+	if err = d.Set("file", file); err != nil {
+		return err
+	}
+	if err = d.Set("content", content); err != nil {
+		return err
+	}
+	if err = d.Set("sha", fc.GetSHA()); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("commit_sha"); !ok {
+		commit, err := getFileCommit(client, owner, repo, file, opts.Ref)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("commit_sha", commit.GetSHA()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryIssueFormUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	if !d.HasChange("content") {
+		return resourceGithubRepositoryIssueFormRead(d, meta)
+	}
+
+	repo := d.Get("repository").(string)
+	file := issueFormFile(d.Get("file_name").(string))
+
+	opts, err := resourceGithubRepositoryFileOptions(d)
+	if err != nil {
+		return err
+	}
+	if opts.Message == nil {
+		opts.Message = github.Ptr(fmt.Sprintf("Update %s", file))
+	}
+
+	result, _, err := client.Repositories.CreateFile(ctx, owner, repo, file, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("commit_sha", result.GetSHA()); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryIssueFormRead(d, meta)
+}
+
+func resourceGithubRepositoryIssueFormDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repo := d.Get("repository").(string)
+	file := issueFormFile(d.Get("file_name").(string))
+
+	message := fmt.Sprintf("Delete %s", file)
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.Ptr(message),
+		SHA:     github.Ptr(d.Get("sha").(string)),
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opts.Branch = github.Ptr(branch.(string))
+	}
+
+	_, _, err := client.Repositories.DeleteFile(ctx, owner, repo, file, opts)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "issue form %s/%s/%s", owner, repo, file)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryIssueFormImport(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	repo, fileName, err := parseTwoPartID(d.Id(), "repository", "file_name")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set("repository", repo); err != nil {
+		return nil, err
+	}
+	if err = d.Set("file_name", fileName); err != nil {
+		return nil, err
+	}
+	if err = d.Set("overwrite_on_create", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
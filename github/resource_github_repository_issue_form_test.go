@@ -0,0 +1,153 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRenderIssueForm(t *testing.T) {
+	d := resourceGithubRepositoryIssueForm().TestResourceData()
+	_ = d.Set("name", "Bug report")
+	_ = d.Set("description", "File a bug report")
+	_ = d.Set("labels", []any{"bug"})
+	_ = d.Set("body", []any{
+		map[string]any{"type": "markdown", "value": "Thanks!"},
+		map[string]any{"type": "input", "id": "version", "label": "Version", "required": true},
+		map[string]any{"type": "dropdown", "id": "os", "label": "OS", "options": []any{"Linux", "macOS"}},
+		map[string]any{"type": "checkboxes", "label": "Terms", "checkbox": []any{map[string]any{"label": "I agree", "required": true}}},
+	})
+
+	form, err := expandIssueForm(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := renderIssueForm(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `name: Bug report
+description: File a bug report
+labels:
+  - bug
+body:
+  - type: markdown
+    attributes:
+      value: Thanks!
+  - type: input
+    id: version
+    attributes:
+      label: Version
+    validations:
+      required: true
+  - type: dropdown
+    id: os
+    attributes:
+      label: OS
+      options:
+        - Linux
+        - macOS
+  - type: checkboxes
+    attributes:
+      label: Terms
+      options:
+        - label: I agree
+          required: true
+`
+	if content != expected {
+		t.Errorf("unexpected content:\n%s", content)
+	}
+}
+
+func TestExpandIssueFormValidation(t *testing.T) {
+	for message, body := range map[string][]any{
+		`"label" is required by elements of type "input"`: {
+			map[string]any{"type": "input"},
+		},
+		`"options" is not supported by elements of type "textarea"`: {
+			map[string]any{"type": "textarea", "label": "Logs", "options": []any{"a"}},
+		},
+		`"options" is required by elements of type "dropdown"`: {
+			map[string]any{"type": "dropdown", "label": "OS"},
+		},
+		`id "logs" is not unique`: {
+			map[string]any{"type": "textarea", "id": "logs", "label": "Logs"},
+			map[string]any{"type": "input", "id": "logs", "label": "More logs"},
+		},
+	} {
+		d := resourceGithubRepositoryIssueForm().TestResourceData()
+		_ = d.Set("body", body)
+		_, err := expandIssueForm(d)
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("expected error %q, got %v", message, err)
+		}
+	}
+}
+
+func TestAccGithubRepositoryIssueForm(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages an issue form without error", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_issue_form" "test" {
+				repository  = github_repository.test.name
+				file_name   = "bug_report"
+				name        = "Bug report"
+				description = "%s"
+				labels      = ["bug"]
+
+				body {
+					type     = "textarea"
+					id       = "what-happened"
+					label    = "What happened?"
+					required = true
+				}
+			}
+		`
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, "File a bug report"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_issue_form.test", "file", ".github/ISSUE_TEMPLATE/bug_report.yml"),
+							resource.TestCheckResourceAttrSet("github_repository_issue_form.test", "sha"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, "Report a defect"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttrSet("github_repository_issue_form.test", "commit_sha"),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
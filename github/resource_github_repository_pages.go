@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryPages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the GitHub Pages site of a repository",
+		Create:      resourceGithubRepositoryPagesCreate,
+		Read:        resourceGithubRepositoryPagesRead,
+		Update:      resourceGithubRepositoryPagesUpdate,
+		Delete:      resourceGithubRepositoryPagesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"build_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "legacy",
+				ValidateDiagFunc: validateValueFunc([]string{"legacy", "workflow"}),
+				Description:      "How the site is built, either `legacy` to publish the files of the source branch or `workflow` to publish the artifact of a GitHub Actions workflow.",
+			},
+			"source": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "The source branch and directory of the site, required by the `legacy` build type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The branch of the source files of the site, e.g. `main` or `gh-pages`.",
+						},
+						"path": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "/",
+							ValidateDiagFunc: validateValueFunc([]string{"/", "/docs"}),
+							Description:      "The directory of the source files of the site, either `/` or `/docs`.",
+						},
+					},
+				},
+			},
+			"cname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The custom domain of the site.",
+			},
+			"https_enforced": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the site is only served over HTTPS. It can only be enabled once the certificate of the custom domain, if any, has been issued.",
+			},
+			"custom_404": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the site has a custom 404 page.",
+			},
+			"html_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the site.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build status of the site, e.g. `building` or `built`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API URL of the site.",
+			},
+		},
+	}
+}
+
+// expandRepositoryPagesSource returns the source of the site, or nil if it
+// is not configured.
+func expandRepositoryPagesSource(d *schema.ResourceData) *github.PagesSource {
+	sources := d.Get("source").([]any)
+	if len(sources) == 0 || sources[0] == nil {
+		return nil
+	}
+	source := sources[0].(map[string]any)
+	return &github.PagesSource{
+		Branch: github.Ptr(source["branch"].(string)),
+		Path:   github.Ptr(source["path"].(string)),
+	}
+}
+
+func resourceGithubRepositoryPagesCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+
+	pages := &github.Pages{
+		BuildType: github.Ptr(d.Get("build_type").(string)),
+		Source:    expandRepositoryPagesSource(d),
+	}
+	if _, _, err := client.Repositories.EnablePages(ctx, owner, repoName, pages); err != nil {
+		return err
+	}
+	d.SetId(repoName)
+
+	// The custom domain and HTTPS enforcement can only be set once the site
+	// is enabled.
+	_, cnameOk := d.GetOk("cname")
+	if cnameOk || !d.GetRawConfig().GetAttr("https_enforced").IsNull() {
+		return resourceGithubRepositoryPagesUpdate(d, meta)
+	}
+
+	return resourceGithubRepositoryPagesRead(d, meta)
+}
+
+func resourceGithubRepositoryPagesRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing GitHub Pages of repository %s/%s from state because they are no longer enabled in GitHub",
+				owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	_ = d.Set("repository", repoName)
+	_ = d.Set("build_type", pages.GetBuildType())
+	_ = d.Set("cname", pages.GetCNAME())
+	_ = d.Set("https_enforced", pages.GetHTTPSEnforced())
+	_ = d.Set("custom_404", pages.GetCustom404())
+	_ = d.Set("html_url", pages.GetHTMLURL())
+	_ = d.Set("status", pages.GetStatus())
+	_ = d.Set("url", pages.GetURL())
+	if pages.Source != nil {
+		if err = d.Set("source", []any{map[string]any{
+			"branch": pages.Source.GetBranch(),
+			"path":   pages.Source.GetPath(),
+		}}); err != nil {
+			return err
+		}
+	} else if err = d.Set("source", []any{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryPagesUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	update := &github.PagesUpdate{
+		BuildType: github.Ptr(d.Get("build_type").(string)),
+	}
+	// Leaving the custom domain unset removes it.
+	if cname := d.Get("cname").(string); cname != "" {
+		update.CNAME = github.Ptr(cname)
+	}
+	// The source only applies to the legacy build type.
+	if d.Get("build_type").(string) == "legacy" {
+		update.Source = expandRepositoryPagesSource(d)
+	}
+	// HTTPS enforcement is left as is unless configured.
+	if !d.GetRawConfig().GetAttr("https_enforced").IsNull() {
+		update.HTTPSEnforced = github.Ptr(d.Get("https_enforced").(bool))
+	}
+
+	if _, err := client.Repositories.UpdatePages(ctx, owner, repoName, update); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryPagesRead(d, meta)
+}
+
+func resourceGithubRepositoryPagesDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	_, err := client.Repositories.DisablePages(ctx, owner, repoName)
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "GitHub Pages of repository %s", repoName)
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceGithubRepositoryPagesRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/site/pages", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"build_type": "legacy", "cname": "docs.acme.com", "https_enforced": true,
+			"source": {"branch": "gh-pages", "path": "/docs"}, "status": "built", "html_url": "https://docs.acme.com/"}`)
+	})
+	mux.HandleFunc("GET /repos/acme/disabled/pages", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{v3client: client, name: "acme", IsOrganization: true}
+
+	r := resourceGithubRepositoryPages()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId("site")
+	if err := resourceGithubRepositoryPagesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string]any{
+		"repository":      "site",
+		"cname":           "docs.acme.com",
+		"https_enforced":  true,
+		"source.0.branch": "gh-pages",
+		"source.0.path":   "/docs",
+		"html_url":        "https://docs.acme.com/",
+	} {
+		if got := d.Get(key); got != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, got)
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId("disabled")
+	if err := resourceGithubRepositoryPagesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected disabled pages to be removed from state, got ID %q", d.Id())
+	}
+}
+
+func TestAccGithubRepositoryPagesResource(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("manages the pages of a repository", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-%s"
+				visibility = "public"
+				auto_init  = true
+
+				lifecycle {
+					ignore_changes = [pages]
+				}
+			}
+
+			resource "github_repository_pages" "test" {
+				repository     = github_repository.test.name
+				https_enforced = true
+
+				source {
+					branch = "main"
+				}
+			}
+		`, randomID)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_repository_pages.test", "source.0.branch", "main"),
+							resource.TestCheckResourceAttr("github_repository_pages.test", "https_enforced", "true"),
+							resource.TestCheckResourceAttrSet("github_repository_pages.test", "html_url"),
+						),
+					},
+					{
+						ResourceName:      "github_repository_pages.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
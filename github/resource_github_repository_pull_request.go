@@ -5,11 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
 )
 
 func resourceGithubRepositoryPullRequest() *schema.Resource {
@@ -77,25 +83,82 @@ func resourceGithubRepositoryPullRequest() *schema.Resource {
 				Default:     false,
 				Description: "Controls whether the base repository maintainers can modify the Pull Request. Default: 'false'.",
 			},
+			"wait_for_checks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Block on create and update until the status checks of the Pull Request head commit have completed, failing the apply if any of them does not succeed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout_minutes": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          30,
+							ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "timeout_minutes"),
+							Description:      "Maximum time in minutes to wait for the checks to complete. Default: '30'.",
+						},
+					},
+				},
+			},
 			"base_sha": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Head commit SHA of the Pull Request base.",
 			},
 			"draft": {
-				// The "draft" field is an interesting corner case because while
-				// you can create a draft PR through the API, the documentation
-				// does not indicate that you can change this field during
-				// update:
-				//
-				// https://docs.github.com/en/rest/reference/pulls#update-a-pull-request
-				//
-				// And since you cannot manage the lifecycle of this field to
-				// reconcile the actual state with the desired one, this field
-				// cannot be managed by Terraform.
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
-				Description: "Indicates Whether this Pull Request is a draft.",
+				Description: "Whether the Pull Request is a draft. If not set, the Pull Request is opened ready for review and its draft state is left untouched.",
+			},
+			"reviewers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Usernames of the users requested to review the Pull Request. Reviews requested outside of Terraform, e.g. of code owners, are ignored.",
+			},
+			"team_reviewers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Slugs of the teams requested to review the Pull Request. Reviews requested outside of Terraform are ignored.",
+			},
+			"milestone": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of the milestone of the Pull Request. Set to '0' to remove the milestone.",
+			},
+			"auto_merge": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enable auto-merge, merging the Pull Request once all its requirements are met. The repository must allow auto-merge.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_method": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "merge",
+							ValidateDiagFunc: validateValueFunc([]string{"merge", "squash", "rebase"}),
+							Description:      "The merge method to use, one of 'merge', 'squash' or 'rebase'. Default: 'merge'.",
+						},
+						"commit_headline": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The headline of the merge commit. GitHub's default is used when not set.",
+						},
+						"commit_body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The body of the merge commit. GitHub's default is used when not set.",
+						},
+					},
+				},
 			},
 			"head_sha": {
 				Type:        schema.TypeString,
@@ -103,10 +166,12 @@ func resourceGithubRepositoryPullRequest() *schema.Resource {
 				Description: "Head commit SHA of the Pull Request head.",
 			},
 			"labels": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
 				Computed:    true,
-				Description: "List of names of labels on the PR",
+				Description: "Names of the labels of the Pull Request. Labels added outside of Terraform are kept unless this is set.",
 			},
 			"number": {
 				Type:        schema.TypeInt,
@@ -166,6 +231,7 @@ func resourceGithubRepositoryPullRequestCreate(d *schema.ResourceData, meta any)
 		Base:                github.Ptr(d.Get("base_ref").(string)),
 		Body:                github.Ptr(d.Get("body").(string)),
 		MaintainerCanModify: github.Ptr(d.Get("maintainer_can_modify").(bool)),
+		Draft:               github.Ptr(d.Get("draft").(bool)),
 	})
 
 	if err != nil {
@@ -174,6 +240,36 @@ func resourceGithubRepositoryPullRequestCreate(d *schema.ResourceData, meta any)
 
 	d.SetId(buildThreePartID(baseOwner, baseRepository, strconv.Itoa(pullRequest.GetNumber())))
 
+	// Labels, milestone and reviewers cannot be set when creating the Pull
+	// Request.
+	issueRequest := &github.IssueRequest{}
+	if v, ok := d.GetOk("labels"); ok {
+		labels := expandStringList(v.(*schema.Set).List())
+		issueRequest.Labels = &labels
+	}
+	if v, ok := d.GetOk("milestone"); ok {
+		issueRequest.Milestone = github.Ptr(v.(int))
+	}
+	if issueRequest.Labels != nil || issueRequest.Milestone != nil {
+		if _, _, err := client.Issues.Edit(ctx, baseOwner, baseRepository, pullRequest.GetNumber(), issueRequest); err != nil {
+			return err
+		}
+	}
+
+	if err := updatePullRequestReviewers(ctx, d, meta); err != nil {
+		return err
+	}
+
+	if autoMerge := d.Get("auto_merge").([]any); len(autoMerge) > 0 {
+		if err := enablePullRequestAutoMerge(ctx, meta, pullRequest.GetNodeID(), autoMerge[0].(map[string]any)); err != nil {
+			return err
+		}
+	}
+
+	if err := waitForPullRequestChecks(ctx, d, meta, pullRequest); err != nil {
+		return err
+	}
+
 	return resourceGithubRepositoryPullRequestRead(d, meta)
 }
 
@@ -262,6 +358,46 @@ func resourceGithubRepositoryPullRequestRead(d *schema.ResourceData, meta any) e
 	if err = d.Set("labels", labels); err != nil {
 		return err
 	}
+	if err = d.Set("milestone", pullRequest.GetMilestone().GetNumber()); err != nil {
+		return err
+	}
+
+	// Reviews are also requested outside of Terraform, e.g. of code owners,
+	// and requests are removed once fulfilled: only the reviewers in state
+	// that are neither requested nor have reviewed are removed from it. Team
+	// requests cannot be told apart from fulfilled ones, they are kept.
+	reviewed, err := listPullRequestReviewers(ctx, client, owner, repository, number)
+	if err != nil {
+		return err
+	}
+	for _, user := range pullRequest.RequestedReviewers {
+		reviewed[strings.ToLower(user.GetLogin())] = true
+	}
+	reviewers := []string{}
+	for _, reviewer := range expandStringList(d.Get("reviewers").(*schema.Set).List()) {
+		if reviewed[strings.ToLower(reviewer)] {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	if err = d.Set("reviewers", reviewers); err != nil {
+		return err
+	}
+
+	// Auto-merge is disabled once the Pull Request is merged or closed, its
+	// configuration is only read while it is open.
+	if pullRequest.GetState() == "open" {
+		autoMerge := []any{}
+		if pullRequest.AutoMerge != nil {
+			autoMerge = append(autoMerge, map[string]any{
+				"merge_method":    pullRequest.AutoMerge.GetMergeMethod(),
+				"commit_headline": pullRequest.AutoMerge.GetCommitTitle(),
+				"commit_body":     pullRequest.AutoMerge.GetCommitMessage(),
+			})
+		}
+		if err = d.Set("auto_merge", autoMerge); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -287,8 +423,14 @@ func resourceGithubRepositoryPullRequestUpdate(d *schema.ResourceData, meta any)
 		}
 	}
 
-	_, _, err = client.PullRequests.Edit(ctx, owner, repository, number, update)
+	pullRequest, _, err := client.PullRequests.Edit(ctx, owner, repository, number, update)
 	if err == nil {
+		err = updatePullRequestExtras(ctx, d, meta, pullRequest)
+	}
+	if err == nil {
+		if err := waitForPullRequestChecks(ctx, d, meta, pullRequest); err != nil {
+			return err
+		}
 		return resourceGithubRepositoryPullRequestRead(d, meta)
 	}
 
@@ -328,6 +470,319 @@ func resourceGithubRepositoryPullRequestDelete(d *schema.ResourceData, meta any)
 	return nil
 }
 
+// updatePullRequestExtras applies the changes to the attributes of the Pull
+// Request that are not updated along with its title and body.
+func updatePullRequestExtras(ctx context.Context, d *schema.ResourceData, meta any, pullRequest *github.PullRequest) error {
+	client := meta.(*Owner).v3client
+	owner, repository, number, err := parsePullRequestID(d)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("draft") {
+		if err := setPullRequestDraft(ctx, meta, pullRequest.GetNodeID(), d.Get("draft").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("labels") {
+		labels := expandStringList(d.Get("labels").(*schema.Set).List())
+		if _, _, err := client.Issues.ReplaceLabelsForIssue(ctx, owner, repository, number, labels); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("milestone") {
+		if milestone := d.Get("milestone").(int); milestone == 0 {
+			_, _, err = client.Issues.RemoveMilestone(ctx, owner, repository, number)
+		} else {
+			_, _, err = client.Issues.Edit(ctx, owner, repository, number, &github.IssueRequest{Milestone: github.Ptr(milestone)})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("reviewers", "team_reviewers") {
+		if err := updatePullRequestReviewers(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auto_merge") {
+		oldAutoMerge, newAutoMerge := d.GetChange("auto_merge")
+		if len(oldAutoMerge.([]any)) > 0 {
+			if err := disablePullRequestAutoMerge(ctx, meta, pullRequest.GetNodeID()); err != nil {
+				return err
+			}
+		}
+		if autoMerge := newAutoMerge.([]any); len(autoMerge) > 0 {
+			if err := enablePullRequestAutoMerge(ctx, meta, pullRequest.GetNodeID(), autoMerge[0].(map[string]any)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updatePullRequestReviewers requests the reviews of the users and teams added
+// to `reviewers` and `team_reviewers` and removes the requests of those
+// removed from them.
+func updatePullRequestReviewers(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner, repository, number, err := parsePullRequestID(d)
+	if err != nil {
+		return err
+	}
+
+	oldReviewers, newReviewers := d.GetChange("reviewers")
+	oldTeamReviewers, newTeamReviewers := d.GetChange("team_reviewers")
+
+	removed := github.ReviewersRequest{
+		Reviewers:     expandStringList(oldReviewers.(*schema.Set).Difference(newReviewers.(*schema.Set)).List()),
+		TeamReviewers: expandStringList(oldTeamReviewers.(*schema.Set).Difference(newTeamReviewers.(*schema.Set)).List()),
+	}
+	if len(removed.Reviewers) > 0 || len(removed.TeamReviewers) > 0 {
+		log.Printf("[DEBUG] Removing review requests of %v and teams %v from Pull Request %s", removed.Reviewers, removed.TeamReviewers, d.Id())
+		if _, err := client.PullRequests.RemoveReviewers(ctx, owner, repository, number, removed); err != nil {
+			return err
+		}
+	}
+
+	added := github.ReviewersRequest{
+		Reviewers:     expandStringList(newReviewers.(*schema.Set).Difference(oldReviewers.(*schema.Set)).List()),
+		TeamReviewers: expandStringList(newTeamReviewers.(*schema.Set).Difference(oldTeamReviewers.(*schema.Set)).List()),
+	}
+	if len(added.Reviewers) > 0 || len(added.TeamReviewers) > 0 {
+		log.Printf("[DEBUG] Requesting reviews of %v and teams %v on Pull Request %s", added.Reviewers, added.TeamReviewers, d.Id())
+		if _, _, err := client.PullRequests.RequestReviewers(ctx, owner, repository, number, added); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listPullRequestReviewers returns the lowercase logins of the users who have
+// reviewed the Pull Request.
+func listPullRequestReviewers(ctx context.Context, client *github.Client, owner, repository string, number int) (map[string]bool, error) {
+	reviewers := make(map[string]bool)
+
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repository, number, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range reviews {
+			reviewers[strings.ToLower(review.GetUser().GetLogin())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	return reviewers, nil
+}
+
+// setPullRequestDraft converts the Pull Request to a draft or marks it as
+// ready for review, which the REST API does not support.
+func setPullRequestDraft(ctx context.Context, meta any, nodeID string, draft bool) error {
+	client := meta.(*Owner).v4client
+
+	if draft {
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				ClientMutationId githubv4.ID `graphql:"clientMutationId"`
+			} `graphql:"convertPullRequestToDraft(input:$input)"`
+		}
+		return client.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{PullRequestID: nodeID}, nil)
+	}
+
+	var mutation struct {
+		MarkPullRequestReadyForReview struct {
+			ClientMutationId githubv4.ID `graphql:"clientMutationId"`
+		} `graphql:"markPullRequestReadyForReview(input:$input)"`
+	}
+	return client.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{PullRequestID: nodeID}, nil)
+}
+
+func enablePullRequestAutoMerge(ctx context.Context, meta any, nodeID string, autoMerge map[string]any) error {
+	client := meta.(*Owner).v4client
+
+	mergeMethod := githubv4.PullRequestMergeMethod(strings.ToUpper(autoMerge["merge_method"].(string)))
+	input := githubv4.EnablePullRequestAutoMergeInput{
+		PullRequestID: nodeID,
+		MergeMethod:   &mergeMethod,
+	}
+	if headline := autoMerge["commit_headline"].(string); headline != "" {
+		input.CommitHeadline = githubv4.NewString(githubv4.String(headline))
+	}
+	if body := autoMerge["commit_body"].(string); body != "" {
+		input.CommitBody = githubv4.NewString(githubv4.String(body))
+	}
+
+	var mutation struct {
+		EnablePullRequestAutoMerge struct {
+			ClientMutationId githubv4.ID `graphql:"clientMutationId"`
+		} `graphql:"enablePullRequestAutoMerge(input:$input)"`
+	}
+	return client.Mutate(ctx, &mutation, input, nil)
+}
+
+func disablePullRequestAutoMerge(ctx context.Context, meta any, nodeID string) error {
+	client := meta.(*Owner).v4client
+
+	var mutation struct {
+		DisablePullRequestAutoMerge struct {
+			ClientMutationId githubv4.ID `graphql:"clientMutationId"`
+		} `graphql:"disablePullRequestAutoMerge(input:$input)"`
+	}
+	return client.Mutate(ctx, &mutation, githubv4.DisablePullRequestAutoMergeInput{PullRequestID: nodeID}, nil)
+}
+
+// waitForPullRequestChecks blocks until the checks reported on the head commit
+// of the Pull Request have completed, when `wait_for_checks` is configured.
+// Only the checks required by the base branch protection are considered when
+// there are any; otherwise every check reported on the head commit is.
+func waitForPullRequestChecks(ctx context.Context, d *schema.ResourceData, meta any, pullRequest *github.PullRequest) error {
+	waitForChecks := d.Get("wait_for_checks").([]any)
+	if len(waitForChecks) == 0 || waitForChecks[0] == nil {
+		return nil
+	}
+	timeout := time.Duration(waitForChecks[0].(map[string]any)["timeout_minutes"].(int)) * time.Minute
+
+	client := meta.(*Owner).v3client
+	owner, repository, _, err := parsePullRequestID(d)
+	if err != nil {
+		return err
+	}
+	headSHA := pullRequest.GetHead().GetSHA()
+
+	required := []string{}
+	requiredChecks, _, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repository, pullRequest.GetBase().GetRef())
+	if err != nil {
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	} else {
+		for _, check := range requiredChecks.GetChecks() {
+			required = append(required, check.Context)
+		}
+		if len(required) == 0 {
+			required = append(required, requiredChecks.GetContexts()...)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting up to %s for checks on %s/%s@%s", timeout, owner, repository, headSHA)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"success"},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (any, string, error) {
+			results, err := getPullRequestCheckResults(ctx, client, owner, repository, headSHA)
+			if err != nil {
+				return nil, "", err
+			}
+			state, err := evaluatePullRequestChecks(results, required)
+			return results, state, err
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for checks on Pull Request %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getPullRequestCheckResults returns the state of every check run and commit
+// status reported on ref, keyed by check name. Check run conclusions are
+// normalized to the commit status vocabulary: "pending", "success" or
+// "failure".
+func getPullRequestCheckResults(ctx context.Context, client *github.Client, owner, repository, ref string) (map[string]string, error) {
+	results := make(map[string]string)
+
+	checkRunsOpts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	for {
+		checkRuns, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repository, ref, checkRunsOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range checkRuns.CheckRuns {
+			switch {
+			case run.GetStatus() != "completed":
+				results[run.GetName()] = "pending"
+			case slices.Contains([]string{"success", "neutral", "skipped"}, run.GetConclusion()):
+				results[run.GetName()] = "success"
+			default:
+				results[run.GetName()] = "failure"
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		checkRunsOpts.Page = resp.NextPage
+	}
+
+	statusOpts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repository, ref, statusOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range status.Statuses {
+			switch s.GetState() {
+			case "pending", "success":
+				results[s.GetContext()] = s.GetState()
+			default:
+				results[s.GetContext()] = "failure"
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	return results, nil
+}
+
+// evaluatePullRequestChecks reduces the check results to a single state. When
+// required is empty, every reported check is considered, and no check being
+// reported yet, as right after a push, is considered pending. A required check
+// that has not been reported yet is considered pending.
+func evaluatePullRequestChecks(results map[string]string, required []string) (string, error) {
+	names := required
+	if len(names) == 0 {
+		if len(results) == 0 {
+			return "pending", nil
+		}
+		for name := range results {
+			names = append(names, name)
+		}
+	}
+
+	state := "success"
+	for _, name := range names {
+		switch results[name] {
+		case "success":
+		case "failure":
+			return "", fmt.Errorf("check %q did not succeed", name)
+		default:
+			state = "pending"
+		}
+	}
+
+	return state, nil
+}
+
 func parsePullRequestID(d *schema.ResourceData) (owner, repository string, number int, err error) {
 	var strNumber string
 
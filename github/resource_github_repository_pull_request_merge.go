@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryPullRequestMerge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Merges an existing GitHub Pull Request.",
+		Create:      resourceGithubRepositoryPullRequestMergeCreate,
+		Read:        resourceGithubRepositoryPullRequestMergeRead,
+		Delete:      resourceGithubRepositoryPullRequestMergeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Owner of the repository. If not provided, the provider's default owner is used.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the repository of the Pull Request.",
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of the Pull Request within the repository.",
+			},
+			"merge_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "merge",
+				ValidateDiagFunc: validateValueFunc([]string{"merge", "squash", "rebase"}),
+				Description:      "The merge method to use. Can be 'merge', 'squash' or 'rebase'. Default: 'merge'.",
+			},
+			"commit_title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Title for the merge commit. If not provided, GitHub's default title is used.",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Extra detail to append to the merge commit message. If not provided, GitHub's default message is used.",
+			},
+			"sha": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "SHA that the Pull Request head must match to allow the merge.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will attempt to merge the Pull Request again.",
+			},
+			"merged": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the Pull Request has been merged.",
+			},
+			"merge_commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA of the commit resulting from the merge.",
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryPullRequestMergeCreate(d *schema.ResourceData, meta any) error {
+	ctx := context.TODO()
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	if explicitOwner, ok := d.GetOk("owner"); ok {
+		owner = explicitOwner.(string)
+	}
+	repository := d.Get("repository").(string)
+	number := d.Get("number").(int)
+
+	pullRequest, _, err := client.PullRequests.Get(ctx, owner, repository, number)
+	if err != nil {
+		return err
+	}
+
+	// Merging is a one-off action, a Pull Request that has already been
+	// merged, e.g. by a previous apply or manually, is not an error.
+	if pullRequest.GetMerged() {
+		log.Printf("[INFO] Pull Request %s/%s#%d is already merged", owner, repository, number)
+	} else {
+		options := &github.PullRequestOptions{
+			CommitTitle: d.Get("commit_title").(string),
+			SHA:         d.Get("sha").(string),
+			MergeMethod: d.Get("merge_method").(string),
+		}
+
+		result, _, err := client.PullRequests.Merge(ctx, owner, repository, number, d.Get("commit_message").(string), options)
+		if err != nil {
+			return fmt.Errorf("could not merge the Pull Request %s/%s#%d: %w", owner, repository, number, err)
+		}
+		if !result.GetMerged() {
+			return fmt.Errorf("could not merge the Pull Request %s/%s#%d: %s", owner, repository, number, result.GetMessage())
+		}
+	}
+
+	d.SetId(buildThreePartID(owner, repository, strconv.Itoa(number)))
+
+	return resourceGithubRepositoryPullRequestMergeRead(d, meta)
+}
+
+func resourceGithubRepositoryPullRequestMergeRead(d *schema.ResourceData, meta any) error {
+	ctx := context.TODO()
+	client := meta.(*Owner).v3client
+
+	owner, repository, strNumber, err := parseThreePartID(d.Id(), "owner", "repository", "number")
+	if err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(strNumber)
+	if err != nil {
+		return fmt.Errorf("invalid PR number %s: %w", strNumber, err)
+	}
+
+	pullRequest, _, err := client.PullRequests.Get(ctx, owner, repository, number)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing Pull Request merge %s from state because the Pull Request no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if err = d.Set("repository", repository); err != nil {
+		return err
+	}
+	if err = d.Set("number", number); err != nil {
+		return err
+	}
+	if err = d.Set("merged", pullRequest.GetMerged()); err != nil {
+		return err
+	}
+	if err = d.Set("merge_commit_sha", pullRequest.GetMergeCommitSHA()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryPullRequestMergeDelete(d *schema.ResourceData, meta any) error {
+	// A merge cannot be undone, destroying this resource only removes it from
+	// the state.
+	d.SetId("")
+	return nil
+}
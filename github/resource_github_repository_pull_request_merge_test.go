@@ -0,0 +1,81 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositoryPullRequestMerge(t *testing.T) {
+	t.Run("merges a pull request", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_branch" "test" {
+				repository    = github_repository.test.name
+				branch        = "test"
+				source_branch = github_repository.test.default_branch
+			}
+
+			resource "github_repository_file" "test" {
+				repository     = github_repository.test.name
+				branch         = github_branch.test.branch
+				file           = "test"
+				content        = "bar"
+			}
+
+			resource "github_repository_pull_request" "test" {
+				base_repository = github_repository_file.test.repository
+				base_ref        = github_repository.test.default_branch
+				head_ref        = github_branch.test.branch
+				title           = "test title"
+			}
+
+			resource "github_repository_pull_request_merge" "test" {
+				repository   = github_repository_pull_request.test.base_repository
+				number       = github_repository_pull_request.test.number
+				merge_method = "squash"
+				sha          = github_repository_pull_request.test.head_sha
+			}
+		`, randomID)
+
+		const resourceName = "github_repository_pull_request_merge.test"
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(resourceName, "merged", "true"),
+			resource.TestCheckResourceAttrSet(resourceName, "merge_commit_sha"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
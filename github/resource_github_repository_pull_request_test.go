@@ -93,4 +93,144 @@ func TestAccGithubRepositoryPullRequest(t *testing.T) {
 			testCase(t, organization)
 		})
 	})
+
+	t.Run("manages drafts, labels and milestones", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := `
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_branch" "test" {
+				repository    = github_repository.test.name
+				branch        = "test"
+				source_branch = github_repository.test.default_branch
+			}
+
+			resource "github_repository_file" "test" {
+				repository     = github_repository.test.name
+				branch         = github_branch.test.branch
+				file           = "test"
+				content        = "bar"
+			}
+
+			resource "github_issue_label" "test" {
+				repository = github_repository.test.name
+				name       = "release"
+				color      = "0e8a16"
+			}
+
+			resource "github_repository_milestone" "test" {
+				owner      = split("/", github_repository.test.full_name)[0]
+				repository = github_repository.test.name
+				title      = "v1.0.0"
+			}
+
+			resource "github_repository_pull_request" "test" {
+				base_repository = github_repository_file.test.repository
+				base_ref        = github_repository.test.default_branch
+				head_ref        = github_branch.test.branch
+				title           = "test title"
+				draft           = %t
+				labels          = [github_issue_label.test.name]
+				milestone       = %s
+			}
+		`
+
+		const resourceName = "github_repository_pull_request.test"
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, true, "github_repository_milestone.test.number"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "draft", "true"),
+							resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+							resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "release"),
+							resource.TestCheckResourceAttrPair(resourceName, "milestone", "github_repository_milestone.test", "number"),
+						),
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, false, "0"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "draft", "false"),
+							resource.TestCheckResourceAttr(resourceName, "milestone", "0"),
+						),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
+
+func TestEvaluatePullRequestChecks(t *testing.T) {
+	tests := []struct {
+		name      string
+		results   map[string]string
+		required  []string
+		wantState string
+		wantErr   bool
+	}{
+		{
+			name:      "All reported checks succeeded",
+			results:   map[string]string{"build": "success", "lint": "success"},
+			wantState: "success",
+		},
+		{
+			name:      "No check has been reported yet",
+			results:   map[string]string{},
+			wantState: "pending",
+		},
+		{
+			name:      "A reported check is still running",
+			results:   map[string]string{"build": "success", "lint": "pending"},
+			wantState: "pending",
+		},
+		{
+			name:    "A reported check failed",
+			results: map[string]string{"build": "failure", "lint": "pending"},
+			wantErr: true,
+		},
+		{
+			name:      "A required check has not been reported yet",
+			results:   map[string]string{"lint": "success"},
+			required:  []string{"build"},
+			wantState: "pending",
+		},
+		{
+			name:      "Only required checks are considered",
+			results:   map[string]string{"build": "success", "lint": "failure"},
+			required:  []string{"build"},
+			wantState: "success",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotState, err := evaluatePullRequestChecks(tt.results, tt.required)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("evaluatePullRequestChecks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotState != tt.wantState {
+				t.Errorf("evaluatePullRequestChecks() = %q, want %q", gotState, tt.wantState)
+			}
+		})
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -16,7 +17,7 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates a GitHub repository ruleset.",
 		Create:      resourceGithubRepositoryRulesetCreate,
-		Read:        resourceGithubRepositoryRulesetRead,
+		ReadContext: resourceGithubRepositoryRulesetReadContext,
 		Update:      resourceGithubRepositoryRulesetUpdate,
 		Delete:      resourceGithubRepositoryRulesetDelete,
 		Importer: &schema.ResourceImporter{
@@ -35,8 +36,8 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 			"target": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"branch", "tag"}, false),
-				Description:  "Possible values are `branch` and `tag`.",
+				ValidateFunc: validation.StringInSlice([]string{"branch", "tag", "push"}, false),
+				Description:  "Possible values are `branch`, `tag` and `push`. The `push` target requires GitHub.com, GitHub Enterprise Cloud or GitHub Enterprise Server 3.15 or later.",
 			},
 			"repository": {
 				Type:        schema.TypeString,
@@ -59,13 +60,13 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 						"actor_id": {
 							Type:        schema.TypeInt,
 							Required:    true,
-							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin`, this should be set to `1`.",
+							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `OrganizationAdmin` or `EnterpriseOwner`, this should be set to `1`.",
 						},
 						"actor_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"RepositoryRole", "Team", "Integration", "OrganizationAdmin", "DeployKey"}, false),
-							Description:  "The type of actor that can bypass a ruleset. Can be one of: `RepositoryRole`, `Team`, `Integration`, `OrganizationAdmin`, `DeployKey`.",
+							ValidateFunc: validation.StringInSlice(bypassActorTypes, false),
+							Description:  "The type of actor that can bypass a ruleset. Can be one of: `RepositoryRole`, `Team`, `Integration`, `OrganizationAdmin`, `DeployKey`, `EnterpriseOwner`, `EnterpriseTeam`.",
 						},
 						"bypass_mode": {
 							Type:         schema.TypeString,
@@ -164,7 +165,16 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 									"required_deployment_environments": {
 										Type:        schema.TypeList,
 										Required:    true,
-										Description: "The environments that must be successfully deployed to before branches can be merged.",
+										Description: "The environments that must be successfully deployed to before branches can be merged. Patterns such as `prod-*` are expanded to the matching environments of the repository when applying.",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateEnvironmentPattern,
+										},
+									},
+									"expanded_environments": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The environments required by the rule, with the patterns of `required_deployment_environments` expanded.",
 										Elem: &schema.Schema{
 											Type: schema.TypeString,
 										},
@@ -534,6 +544,76 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 								},
 							},
 						},
+						"file_path_restriction": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent commits that include changes in specified file paths from being pushed to the commit graph. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"restricted_file_paths": {
+										Type:        schema.TypeList,
+										MinItems:    1,
+										Required:    true,
+										Description: "The file paths that are restricted from being pushed to the commit graph.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"max_file_size": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file size. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_file_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+										Description:  "The maximum allowed size, in megabytes (MB), of a file. Valid range is 1-100 MB.",
+									},
+								},
+							},
+						},
+						"max_file_path_length": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file path length. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_file_path_length": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 256),
+										Description:  "The maximum number of characters allowed in file paths.",
+									},
+								},
+							},
+						},
+						"file_extension_restriction": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Prevent pushes based on file extensions. Only applies to rulesets with target `push`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"restricted_file_extensions": {
+										Type:        schema.TypeSet,
+										MinItems:    1,
+										Required:    true,
+										Description: "The file extensions that are restricted from being pushed to the commit graph, e.g. `*.jar`.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -542,6 +622,8 @@ func resourceGithubRepositoryRuleset() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffPushRuleset,
 	}
 }
 
@@ -558,6 +640,13 @@ func resourceGithubRepositoryRulesetCreate(d *schema.ResourceData, meta any) err
 	var ruleset *github.RepositoryRuleset
 	var err error
 
+	if err = waitForRepositoryVisible(ctx, meta, repoName); err != nil {
+		return err
+	}
+	if err = expandRulesetRequiredDeploymentEnvironments(ctx, meta, repoName, rulesetReq); err != nil {
+		return err
+	}
+
 	ruleset, _, err = client.Repositories.CreateRuleset(ctx, owner, repoName, *rulesetReq)
 	if err != nil {
 		return err
@@ -607,13 +696,32 @@ func resourceGithubRepositoryRulesetRead(d *schema.ResourceData, meta any) error
 	_ = d.Set("enforcement", ruleset.Enforcement)
 	_ = d.Set("bypass_actors", flattenBypassActors(ruleset.BypassActors))
 	_ = d.Set("conditions", flattenConditions(ruleset.GetConditions(), false))
-	_ = d.Set("rules", flattenRules(ruleset.Rules, false))
+	rules := flattenRules(ruleset.Rules, false)
+	keepRequiredDeploymentEnvironmentPatterns(context.Background(), meta, repoName, rules, d.Get(requiredDeploymentEnvironmentsKey).([]any))
+	_ = d.Set("rules", rules)
 	_ = d.Set("node_id", ruleset.GetNodeID())
 	_ = d.Set("ruleset_id", ruleset.ID)
 
 	return nil
 }
 
+// resourceGithubRepositoryRulesetReadContext reads the ruleset and warns
+// about its required deployment environments which do not exist and its
+// required code scanning tools which are not enabled, so that refreshing
+// rulesets reveals the rules which cannot be satisfied.
+func resourceGithubRepositoryRulesetReadContext(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := resourceGithubRepositoryRulesetRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	repo := d.Get("repository").(string)
+	diags := requiredDeploymentEnvironmentsWarnings(ctx, meta, repo, d.Get(requiredDeploymentEnvironmentsKey).([]any))
+	return append(diags, requiredCodeScanningToolsWarnings(ctx, meta, repo, d.Get(requiredCodeScanningToolsKey).(*schema.Set))...)
+}
+
 func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta any) error {
 	client := meta.(*Owner).v3client
 
@@ -629,6 +737,10 @@ func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta any) err
 
 	ctx := context.WithValue(context.Background(), ctxId, rulesetID)
 
+	if err = expandRulesetRequiredDeploymentEnvironments(ctx, meta, repoName, rulesetReq); err != nil {
+		return err
+	}
+
 	ruleset, _, err := client.Repositories.UpdateRuleset(ctx, owner, repoName, rulesetID, *rulesetReq)
 	if err != nil {
 		return err
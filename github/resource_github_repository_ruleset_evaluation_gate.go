@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubRepositoryRulesetEvaluationGate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Checks the failures recorded by a GitHub ruleset in evaluate mode.",
+		Create:      resourceGithubRepositoryRulesetEvaluationGateCreate,
+		Read:        resourceGithubRepositoryRulesetEvaluationGateRead,
+		Delete:      resourceGithubRepositoryRulesetEvaluationGateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the ruleset, either a repository or an organization ruleset.",
+			},
+			"days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          7,
+				ValidateDiagFunc: toDiagFunc(validation.IntBetween(1, 30), "days"),
+				Description:      "The number of days of evaluations to check, up to 30. Defaults to 7.",
+			},
+			"max_failures": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          0,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(0), "max_failures"),
+				Description:      "The number of pushes failing the ruleset above which the check fails. Defaults to 0.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will run the check again.",
+			},
+			"failures": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of pushes that failed the ruleset in evaluate mode when the check last ran.",
+			},
+		},
+	}
+}
+
+// ruleSuite is a rule suite, the evaluation of the rulesets of a repository
+// for a push, as returned by the rule suites API, which is not supported by
+// go-github.
+type ruleSuite struct {
+	ID               int64            `json:"id"`
+	PushedAt         github.Timestamp `json:"pushed_at"`
+	EvaluationResult string           `json:"evaluation_result"`
+	RuleEvaluations  []struct {
+		RuleSource struct {
+			Type string `json:"type"`
+			ID   int64  `json:"id"`
+		} `json:"rule_source"`
+		Enforcement string `json:"enforcement"`
+		Result      string `json:"result"`
+	} `json:"rule_evaluations,omitempty"`
+}
+
+// ruleSuitesTimePeriod returns the shortest time period of the rule suites API
+// covering the given number of days.
+func ruleSuitesTimePeriod(days int) string {
+	switch {
+	case days <= 1:
+		return "day"
+	case days <= 7:
+		return "week"
+	default:
+		return "month"
+	}
+}
+
+// countRulesetEvaluationFailures returns the number of pushes to a repository
+// since the given time that failed the ruleset in evaluate mode.
+func countRulesetEvaluationFailures(ctx context.Context, client *github.Client, owner, repoName string, rulesetID int64, since time.Time, days int) (int, error) {
+	suitesURL := fmt.Sprintf("repos/%s/%s/rulesets/rule-suites", owner, repoName)
+
+	// Only the suites failing when including the rulesets in evaluate mode
+	// can have failed the ruleset, the details of those are fetched to find
+	// the failing rulesets.
+	var failedSuites []int64
+	page := 1
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?time_period=%s&per_page=%d&page=%d",
+			suitesURL, ruleSuitesTimePeriod(days), maxPerPage, page), nil)
+		if err != nil {
+			return 0, err
+		}
+
+		var suites []*ruleSuite
+		resp, err := client.Do(ctx, req, &suites)
+		if err != nil {
+			return 0, err
+		}
+		for _, suite := range suites {
+			if suite.EvaluationResult == "fail" && !suite.PushedAt.Before(since) {
+				failedSuites = append(failedSuites, suite.ID)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	failures := 0
+	for _, suiteID := range failedSuites {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s/%d", suitesURL, suiteID), nil)
+		if err != nil {
+			return 0, err
+		}
+
+		suite := new(ruleSuite)
+		if _, err := client.Do(ctx, req, suite); err != nil {
+			return 0, err
+		}
+		for _, evaluation := range suite.RuleEvaluations {
+			if evaluation.RuleSource.Type == "ruleset" && evaluation.RuleSource.ID == rulesetID &&
+				evaluation.Enforcement == "evaluate" && evaluation.Result == "fail" {
+				failures++
+				break
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+func resourceGithubRepositoryRulesetEvaluationGateCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	repoName := d.Get("repository").(string)
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	days := d.Get("days").(int)
+	maxFailures := d.Get("max_failures").(int)
+
+	since := time.Now().AddDate(0, 0, -days)
+	failures, err := countRulesetEvaluationFailures(ctx, client, owner, repoName, rulesetID, since, days)
+	if err != nil {
+		return fmt.Errorf("error listing the rule suites of %s/%s: %w", owner, repoName, err)
+	}
+
+	// Failing the apply leaves nothing in the state, so that the check runs
+	// again on the next apply.
+	if failures > maxFailures {
+		return fmt.Errorf("ruleset %d failed on %d pushes to %s/%s in the last %d days, more than the %d allowed",
+			rulesetID, failures, owner, repoName, days, maxFailures)
+	}
+	log.Printf("[INFO] Ruleset %d failed on %d pushes to %s/%s in the last %d days", rulesetID, failures, owner, repoName, days)
+
+	d.SetId(buildTwoPartID(repoName, strconv.FormatInt(rulesetID, 10)))
+	if err = d.Set("failures", failures); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryRulesetEvaluationGateRead(d, meta)
+}
+
+func resourceGithubRepositoryRulesetEvaluationGateRead(d *schema.ResourceData, meta any) error {
+	// The check is a one-off action, its result is kept until it runs again.
+	return nil
+}
+
+func resourceGithubRepositoryRulesetEvaluationGateDelete(d *schema.ResourceData, meta any) error {
+	// Destroying this resource only removes it from the state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestCountRulesetEvaluationFailures(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/acme/app/rulesets/rule-suites", func(w http.ResponseWriter, req *http.Request) {
+		if period := req.URL.Query().Get("time_period"); period != "week" {
+			t.Errorf("unexpected time period %s", period)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"id": 1, "pushed_at": "2026-10-16T10:00:00Z", "evaluation_result": "fail"},
+			{"id": 2, "pushed_at": "2026-10-15T10:00:00Z", "evaluation_result": "fail"},
+			{"id": 3, "pushed_at": "2026-10-14T10:00:00Z", "evaluation_result": "pass"},
+			{"id": 4, "pushed_at": "2026-10-13T10:00:00Z", "evaluation_result": "fail"},
+			{"id": 5, "pushed_at": "2026-10-01T10:00:00Z", "evaluation_result": "fail"}
+		]`)
+	})
+	mux.HandleFunc("GET /repos/acme/app/rulesets/rule-suites/{id}", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.PathValue("id") {
+		case "1":
+			mustWrite(w, `{"id": 1, "rule_evaluations": [
+				{"rule_source": {"type": "ruleset", "id": 42}, "enforcement": "evaluate", "result": "fail"},
+				{"rule_source": {"type": "ruleset", "id": 42}, "enforcement": "evaluate", "result": "fail"}
+			]}`)
+		case "2":
+			mustWrite(w, `{"id": 2, "rule_evaluations": [
+				{"rule_source": {"type": "ruleset", "id": 7}, "enforcement": "evaluate", "result": "fail"},
+				{"rule_source": {"type": "ruleset", "id": 42}, "enforcement": "evaluate", "result": "pass"}
+			]}`)
+		case "4":
+			mustWrite(w, `{"id": 4, "rule_evaluations": [
+				{"rule_source": {"type": "ruleset", "id": 42}, "enforcement": "evaluate", "result": "fail"}
+			]}`)
+		default:
+			t.Errorf("unexpected rule suite %s", req.PathValue("id"))
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+
+	since := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	failures, err := countRulesetEvaluationFailures(context.Background(), client, "acme", "app", 42, since, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failures != 2 {
+		t.Errorf("expected 2 failures, got %d", failures)
+	}
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubRepositorySecretScanningAlertsResolution() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resolves the secret scanning alerts of a repository matching criteria.",
+		Create:      resourceGithubRepositorySecretScanningAlertsResolutionApply,
+		Read:        resourceGithubRepositorySecretScanningAlertsResolutionRead,
+		Update:      resourceGithubRepositorySecretScanningAlertsResolutionApply,
+		Delete:      resourceGithubRepositorySecretScanningAlertsResolutionDelete,
+
+		CustomizeDiff: resourceGithubRepositorySecretScanningAlertsResolutionDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"secret_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The secret types of the alerts to resolve, e.g. 'github_personal_access_token'. Alerts of any secret type are resolved if not set.",
+			},
+			"validities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateValueFunc([]string{"active", "inactive", "unknown"})},
+				Set:         schema.HashString,
+				Description: "The validities of the secrets of the alerts to resolve, 'active', 'inactive' or 'unknown'. Alerts of any validity are resolved if not set.",
+			},
+			"alert_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The numbers of the alerts to resolve. Alerts of any number matching the other criteria are resolved if not set.",
+			},
+			"resolution": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"false_positive", "revoked", "used_in_tests", "wont_fix"}),
+				Description:      "The reason for resolving the alerts, one of 'false_positive', 'revoked', 'used_in_tests' or 'wont_fix'.",
+			},
+			"resolution_comment": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringLenBetween(0, 280), "resolution_comment"),
+				Description:      "A comment recorded with the resolution of the alerts. Changing it only applies to the alerts resolved afterwards.",
+			},
+			"resolved_alerts": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The numbers of the alerts resolved by this resource which are still resolved.",
+			},
+			"open_alerts": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The numbers of the open alerts matching the criteria, as of the last refresh. They are resolved by the next apply.",
+			},
+		},
+	}
+}
+
+// listGithubSecretScanningAlerts returns the numbers of the alerts of the
+// repository in the state matching the criteria of the resolution.
+func listGithubSecretScanningAlerts(ctx context.Context, d *schema.ResourceData, meta any, state string) ([]int, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	options := &github.SecretScanningAlertListOptions{
+		State:       state,
+		ListOptions: github.ListOptions{PerPage: maxPerPage},
+	}
+	if secretTypes := expandStringList(d.Get("secret_types").(*schema.Set).List()); len(secretTypes) > 0 {
+		sort.Strings(secretTypes)
+		options.SecretType = strings.Join(secretTypes, ",")
+	}
+	if validities := expandStringList(d.Get("validities").(*schema.Set).List()); len(validities) > 0 {
+		sort.Strings(validities)
+		options.Validity = strings.Join(validities, ",")
+	}
+	alertNumbers := d.Get("alert_numbers").(*schema.Set)
+
+	var numbers []int
+	for {
+		alerts, resp, err := client.SecretScanning.ListAlertsForRepo(ctx, owner, repoName, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, alert := range alerts {
+			if alertNumbers.Len() == 0 || alertNumbers.Contains(alert.GetNumber()) {
+				numbers = append(numbers, alert.GetNumber())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		options.ListOptions.Page = resp.NextPage
+	}
+
+	return numbers, nil
+}
+
+func resourceGithubRepositorySecretScanningAlertsResolutionApply(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoName := d.Get("repository").(string)
+	// The resolved alerts are unknown in the plan when an update is planned
+	// for new alerts, those of the state are the tracked ones.
+	tracked, _ := d.GetChange("resolved_alerts")
+	resolved := schema.NewSet(schema.HashInt, tracked.(*schema.Set).List())
+
+	open, err := listGithubSecretScanningAlerts(ctx, d, meta, "open")
+	if err != nil {
+		return err
+	}
+
+	update := &github.SecretScanningAlertUpdateOptions{
+		State:      "resolved",
+		Resolution: github.Ptr(d.Get("resolution").(string)),
+	}
+	if comment, ok := d.GetOk("resolution_comment"); ok {
+		update.ResolutionComment = github.Ptr(comment.(string))
+	}
+	for _, number := range open {
+		log.Printf("[INFO] Resolving secret scanning alert %s/%s#%d: %s", owner, repoName, number, update.GetResolution())
+		if _, _, err := client.SecretScanning.UpdateAlert(ctx, owner, repoName, int64(number), update); err != nil {
+			return err
+		}
+		resolved.Add(number)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(buildTwoPartID(repoName, uuid.NewString()))
+	}
+	if err := d.Set("resolved_alerts", resolved); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositorySecretScanningAlertsResolutionRead(d, meta)
+}
+
+func resourceGithubRepositorySecretScanningAlertsResolutionRead(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// Alerts reopened since are no longer tracked.
+	stillResolved, err := listGithubSecretScanningAlerts(ctx, d, meta, "resolved")
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "secret scanning alerts resolution %s", d.Id())
+	}
+	tracked := d.Get("resolved_alerts").(*schema.Set)
+	resolved := schema.NewSet(schema.HashInt, nil)
+	for _, number := range stillResolved {
+		if tracked.Contains(number) {
+			resolved.Add(number)
+		}
+	}
+
+	open, err := listGithubSecretScanningAlerts(ctx, d, meta, "open")
+	if err != nil {
+		return err
+	}
+	openAlerts := make([]any, 0, len(open))
+	for _, number := range open {
+		openAlerts = append(openAlerts, number)
+	}
+
+	if err = d.Set("resolved_alerts", resolved); err != nil {
+		return err
+	}
+	if err = d.Set("open_alerts", openAlerts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositorySecretScanningAlertsResolutionDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	repoName := d.Get("repository").(string)
+	for _, number := range d.Get("resolved_alerts").(*schema.Set).List() {
+		log.Printf("[INFO] Reopening secret scanning alert %s/%s#%d", owner, repoName, number.(int))
+		if _, _, err := client.SecretScanning.UpdateAlert(ctx, owner, repoName, int64(number.(int)), &github.SecretScanningAlertUpdateOptions{State: "open"}); err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceGithubRepositorySecretScanningAlertsResolutionDiff plans an update
+// when the last refresh found open alerts of the selected secret types,
+// validities or numbers, so that they are resolved with the resolution.
+func resourceGithubRepositorySecretScanningAlertsResolutionDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	return planRefreshedDrift(diff, "open_alerts", "resolved_alerts")
+}
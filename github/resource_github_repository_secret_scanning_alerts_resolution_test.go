@@ -0,0 +1,71 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositorySecretScanningAlertsResolution(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("resolves the alerts matching the criteria", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-secret-scanning-%s"
+				auto_init  = true
+				visibility = "public"
+
+				security_and_analysis {
+					secret_scanning {
+						status = "enabled"
+					}
+				}
+			}
+
+			resource "github_repository_secret_scanning_alerts_resolution" "test" {
+				repository         = github_repository.test.name
+				secret_types       = ["github_personal_access_token"]
+				validities         = ["inactive"]
+				resolution         = "revoked"
+				resolution_comment = "Revoked during the incident cleanup"
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_secret_scanning_alerts_resolution.test", "resolved_alerts.#", "0"),
+			resource.TestCheckResourceAttr("github_repository_secret_scanning_alerts_resolution.test", "open_alerts.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
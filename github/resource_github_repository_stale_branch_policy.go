@@ -0,0 +1,332 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubRepositoryStaleBranchPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reports and optionally deletes the unmerged branches of a repository whose last commit is older than a number of days.",
+		Create:      resourceGithubRepositoryStaleBranchPolicyApply,
+		Read:        resourceGithubRepositoryStaleBranchPolicyRead,
+		Update:      resourceGithubRepositoryStaleBranchPolicyApply,
+		Delete:      resourceGithubRepositoryStaleBranchPolicyDelete,
+
+		CustomizeDiff: resourceGithubRepositoryStaleBranchPolicyDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"pattern": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "**",
+				ValidateDiagFunc: toDiagFunc(validateStaleBranchPattern, "pattern"),
+				Description:      "A glob pattern the name of the branches to clean up must match, such as 'feature/**'. '*' does not match '/' while '**' does. All branches are considered by default.",
+			},
+			"older_than_days": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: toDiagFunc(validation.IntAtLeast(1), "older_than_days"),
+				Description:      "The number of days since the last commit of a branch after which it is stale.",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to only report the stale branches. Once set to 'false', the stale branches found by the refresh of a plan are deleted by its apply. A plan changing 'pattern', 'older_than_days' or 'dry_run' deletes nothing, the branches it selects are deleted by the next apply.",
+			},
+			"stale_branches": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The stale branches, as of the last refresh.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the branch.",
+						},
+						"sha": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SHA of the last commit of the branch.",
+						},
+						"last_commit_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date of the last commit of the branch.",
+						},
+					},
+				},
+			},
+			"deleted_branches": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the branches deleted by the last apply, planned from the stale branches found by the refresh.",
+			},
+		},
+	}
+}
+
+// staleBranch is an unmerged branch whose last commit is older than the
+// policy allows.
+type staleBranch struct {
+	name           string
+	sha            string
+	lastCommitDate time.Time
+}
+
+// isStaleBranch returns whether a branch whose last commit is of the given
+// date is stale at the given time.
+func isStaleBranch(lastCommitDate time.Time, olderThanDays int, now time.Time) bool {
+	return lastCommitDate.Before(now.AddDate(0, 0, -olderThanDays))
+}
+
+// validateStaleBranchPattern checks the pattern of a stale branch policy is a
+// valid glob pattern.
+func validateStaleBranchPattern(v any, k string) ([]string, []error) {
+	if !doublestar.ValidatePattern(v.(string)) {
+		return nil, []error{fmt.Errorf("%s: %q is not a valid glob pattern", k, v)}
+	}
+	return nil, nil
+}
+
+// listGithubStaleBranches returns the branches of the repository without a
+// branch protection rule matching the pattern of the policy, whose last commit
+// is older than allowed and which have commits missing from the default
+// branch. The branches and their last commit date are listed through GraphQL,
+// and only the stale branches not already known to be unmerged at the same
+// commit are compared to the default branch.
+func listGithubStaleBranches(ctx context.Context, d *schema.ResourceData, meta any) ([]*staleBranch, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	pattern := d.Get("pattern").(string)
+	olderThanDays := d.Get("older_than_days").(int)
+
+	unmerged := make(map[string]string)
+	for _, v := range d.Get("stale_branches").([]any) {
+		branch := v.(map[string]any)
+		unmerged[branch["name"].(string)] = branch["sha"].(string)
+	}
+
+	var query struct {
+		Repository struct {
+			DefaultBranchRef struct {
+				Name string
+			}
+			Refs struct {
+				Nodes []struct {
+					Name                 string
+					BranchProtectionRule *struct {
+						ID githubv4.ID
+					}
+					Target struct {
+						Commit struct {
+							OID           string `graphql:"oid"`
+							CommittedDate githubv4.DateTime
+						} `graphql:"... on Commit"`
+					}
+				}
+				PageInfo PageInfo
+			} `graphql:"refs(refPrefix: \"refs/heads/\", first: 100, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repoName),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	now := time.Now()
+	var branches []*staleBranch
+	for {
+		if err := meta.(*Owner).v4client.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+
+		defaultBranch := query.Repository.DefaultBranchRef.Name
+		for _, ref := range query.Repository.Refs.Nodes {
+			name := ref.Name
+			if name == defaultBranch || ref.BranchProtectionRule != nil {
+				continue
+			}
+			if matched, err := doublestar.Match(pattern, name); err != nil || !matched {
+				continue
+			}
+
+			sha := ref.Target.Commit.OID
+			lastCommitDate := ref.Target.Commit.CommittedDate.Time
+			if !isStaleBranch(lastCommitDate, olderThanDays, now) {
+				continue
+			}
+
+			if unmerged[name] != sha {
+				comparison, _, err := client.Repositories.CompareCommits(ctx, owner, repoName, defaultBranch, sha, &github.ListOptions{PerPage: 1})
+				if err != nil {
+					return nil, err
+				}
+				if comparison.GetAheadBy() == 0 {
+					log.Printf("[DEBUG] Skipping branch %s/%s/%s because it is merged into %s", owner, repoName, name, defaultBranch)
+					continue
+				}
+			}
+
+			branches = append(branches, &staleBranch{name: name, sha: sha, lastCommitDate: lastCommitDate})
+		}
+
+		if !query.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Refs.PageInfo.EndCursor)
+	}
+
+	sort.Slice(branches, func(i, j int) bool { return branches[i].name < branches[j].name })
+	return branches, nil
+}
+
+// resourceGithubRepositoryStaleBranchPolicyApply deletes the branches planned
+// in deleted_branches, unless they moved to another commit since the refresh
+// which found them stale.
+func resourceGithubRepositoryStaleBranchPolicyApply(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	repoName := d.Get("repository").(string)
+	deleted := make([]string, 0)
+
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] Not deleting the stale branches of %s/%s in dry-run mode", owner, repoName)
+	} else {
+		stale, _ := d.GetChange("stale_branches")
+		shas := make(map[string]string)
+		for _, v := range stale.([]any) {
+			branch := v.(map[string]any)
+			shas[branch["name"].(string)] = branch["sha"].(string)
+		}
+
+		for _, v := range d.Get("deleted_branches").([]any) {
+			name := v.(string)
+			ref, _, err := client.Git.GetRef(ctx, owner, repoName, "heads/"+name)
+			if err != nil {
+				if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[WARN] Branch %s/%s/%s no longer exists", owner, repoName, name)
+					continue
+				}
+				return err
+			}
+			if sha := ref.GetObject().GetSHA(); sha != shas[name] {
+				log.Printf("[WARN] Not deleting branch %s/%s/%s because it moved from %s to %s since the plan", owner, repoName, name, shas[name], sha)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting stale branch %s/%s/%s at %s", owner, repoName, name, shas[name])
+			if _, err := client.Git.DeleteRef(ctx, owner, repoName, "heads/"+name); err != nil {
+				if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[WARN] Branch %s/%s/%s no longer exists", owner, repoName, name)
+					continue
+				}
+				return fmt.Errorf("error deleting stale branch %s/%s/%s: %w", owner, repoName, name, err)
+			}
+			deleted = append(deleted, name)
+		}
+	}
+
+	d.SetId(repoName)
+	if err := d.Set("deleted_branches", deleted); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryStaleBranchPolicyRead(d, meta)
+}
+
+func resourceGithubRepositoryStaleBranchPolicyRead(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	branches, err := listGithubStaleBranches(ctx, d, meta)
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a Repository") {
+			log.Printf("[INFO] Removing stale branch policy %s from state because the repository no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "stale branch policy %s", d.Id())
+	}
+
+	stale := make([]any, 0, len(branches))
+	for _, branch := range branches {
+		stale = append(stale, map[string]any{
+			"name":             branch.name,
+			"sha":              branch.sha,
+			"last_commit_date": branch.lastCommitDate.Format(time.RFC3339),
+		})
+	}
+	if err = d.Set("stale_branches", stale); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryStaleBranchPolicyDelete(d *schema.ResourceData, meta any) error {
+	// Deleted branches cannot be restored, destroying the resource only stops
+	// cleaning them up.
+	log.Printf("[INFO] Removing stale branch policy %s from state, the branches are left unchanged", d.Id())
+	return nil
+}
+
+// resourceGithubRepositoryStaleBranchPolicyDiff plans the deletion of the
+// stale branches found by the refresh outside of dry-run mode, so that the
+// apply deletes the branches shown by the plan rather than listing them again.
+// The stale branches were found with the settings of the state: when the plan
+// changes them, nothing is deleted and the apply lists the stale branches with
+// the new settings, to be deleted by the next apply.
+func resourceGithubRepositoryStaleBranchPolicyDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChanges("pattern", "older_than_days", "dry_run") {
+		if err := diff.SetNew("deleted_branches", []string{}); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("stale_branches")
+	}
+	if diff.Get("dry_run").(bool) {
+		return nil
+	}
+
+	stale := diff.Get("stale_branches").([]any)
+	if len(stale) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(stale))
+	for _, v := range stale {
+		names = append(names, v.(map[string]any)["name"].(string))
+	}
+	if err := diff.SetNew("deleted_branches", names); err != nil {
+		return err
+	}
+	return planRefreshedDrift(diff, "stale_branches")
+}
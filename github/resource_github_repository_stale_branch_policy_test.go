@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestIsStaleBranch(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		lastCommitDate time.Time
+		olderThanDays  int
+		stale          bool
+	}{
+		{lastCommitDate: now, olderThanDays: 1, stale: false},
+		{lastCommitDate: now.AddDate(0, 0, -1).Add(time.Minute), olderThanDays: 1, stale: false},
+		{lastCommitDate: now.AddDate(0, 0, -1).Add(-time.Minute), olderThanDays: 1, stale: true},
+		{lastCommitDate: now.AddDate(0, -1, 0), olderThanDays: 90, stale: false},
+		{lastCommitDate: now.AddDate(-1, 0, 0), olderThanDays: 90, stale: true},
+	}
+
+	for _, tc := range testCases {
+		if stale := isStaleBranch(tc.lastCommitDate, tc.olderThanDays, now); stale != tc.stale {
+			t.Errorf("last commit on %s and %d days: expected stale=%v, got %v", tc.lastCommitDate, tc.olderThanDays, tc.stale, stale)
+		}
+	}
+}
+
+func TestListGithubStaleBranches(t *testing.T) {
+	var compared []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"repository": {
+			"defaultBranchRef": {"name": "main"},
+			"refs": {
+				"nodes": [
+					{"name": "main", "target": {"oid": "a0", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/old", "target": {"oid": "a1", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/known", "target": {"oid": "a2", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/merged", "target": {"oid": "a3", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/deep/old", "target": {"oid": "a4", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/protected", "branchProtectionRule": {"id": "BPR_1"}, "target": {"oid": "a5", "committedDate": "2020-01-01T00:00:00Z"}},
+					{"name": "feature/recent", "target": {"oid": "a6", "committedDate": "2999-01-01T00:00:00Z"}},
+					{"name": "release/old", "target": {"oid": "a7", "committedDate": "2020-01-01T00:00:00Z"}}
+				],
+				"pageInfo": {"endCursor": "", "hasNextPage": false}
+			}
+		}}}`)
+	})
+	mux.HandleFunc("GET /repos/acme/app/compare/{basehead}", func(w http.ResponseWriter, req *http.Request) {
+		basehead := req.PathValue("basehead")
+		compared = append(compared, basehead)
+		w.Header().Set("Content-Type", "application/json")
+		if basehead == "main...a3" {
+			mustWrite(w, `{"ahead_by": 0}`)
+			return
+		}
+		mustWrite(w, `{"ahead_by": 1}`)
+	})
+
+	httpClient := &http.Client{Transport: localRoundTripper{handler: mux}}
+	client := github.NewClient(httpClient)
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{
+		v3client: client,
+		v4client: githubv4.NewEnterpriseClient("https://api.github.com/graphql", httpClient),
+		name:     "acme",
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryStaleBranchPolicy().Schema, map[string]any{
+		"repository":      "app",
+		"pattern":         "feature/*",
+		"older_than_days": 30,
+	})
+	if err := d.Set("stale_branches", []any{
+		map[string]any{"name": "feature/known", "sha": "a2", "last_commit_date": "2020-01-01T00:00:00Z"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	branches, err := listGithubStaleBranches(context.Background(), d, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		names = append(names, branch.name)
+	}
+	if expected := []string{"feature/known", "feature/old"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected stale branches %v, got %v", expected, names)
+	}
+	if expected := []string{"main...a1", "main...a3"}; !reflect.DeepEqual(compared, expected) {
+		t.Errorf("expected comparisons %v, got %v", expected, compared)
+	}
+}
+
+func TestAccGithubRepositoryStaleBranchPolicy(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("reports stale branches in dry-run mode", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-stale-branches-%s"
+				auto_init = true
+			}
+
+			resource "github_branch" "test" {
+				repository = github_repository.test.name
+				branch     = "feature/test"
+			}
+
+			resource "github_repository_stale_branch_policy" "test" {
+				repository      = github_repository.test.name
+				pattern         = "feature/*"
+				older_than_days = 30
+
+				depends_on = [github_branch.test]
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_stale_branch_policy.test", "dry_run", "true"),
+			resource.TestCheckResourceAttr("github_repository_stale_branch_policy.test", "stale_branches.#", "0"),
+			resource.TestCheckResourceAttr("github_repository_stale_branch_policy.test", "deleted_branches.#", "0"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagProtectionBypassRoles are the repository roles which could create and
+// delete protected tags, the IDs of the maintain and admin roles.
+var tagProtectionBypassRoles = []int64{2, 5}
+
+func resourceGithubRepositoryTagProtection() *schema.Resource {
+	return &schema.Resource{
+		Description:        "Protects the tags of a repository matching a pattern with an equivalent tag ruleset, as tag protections were replaced by rulesets.",
+		DeprecationMessage: "Tag protections were replaced by rulesets. This resource manages an equivalent tag ruleset, migrate to github_repository_ruleset to customize it.",
+		Create:             resourceGithubRepositoryTagProtectionCreate,
+		Read:               resourceGithubRepositoryTagProtectionRead,
+		Delete:             resourceGithubRepositoryTagProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryTagProtectionImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGithubRepositoryTagProtectionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGithubRepositoryTagProtectionUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the repository to add the tag protection to.",
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The pattern of the names of the tags to protect, e.g. 'v*'.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the tag ruleset protecting the tags.",
+			},
+		},
+	}
+}
+
+// tagProtectionRuleset returns the tag ruleset equivalent to a tag protection:
+// only the maintainers and admins of the repository can create, update or
+// delete the tags matching the pattern.
+func tagProtectionRuleset(pattern string) *github.RepositoryRuleset {
+	bypassActors := make([]*github.BypassActor, 0, len(tagProtectionBypassRoles))
+	for _, role := range tagProtectionBypassRoles {
+		bypassActors = append(bypassActors, &github.BypassActor{
+			ActorID:    github.Ptr(role),
+			ActorType:  github.Ptr(github.BypassActorTypeRepositoryRole),
+			BypassMode: github.Ptr(github.BypassModeAlways),
+		})
+	}
+
+	return &github.RepositoryRuleset{
+		Name:         fmt.Sprintf("Tag protection: %s", pattern),
+		Target:       github.Ptr(github.RulesetTargetTag),
+		Enforcement:  github.RulesetEnforcementActive,
+		BypassActors: bypassActors,
+		Conditions: &github.RepositoryRulesetConditions{
+			RefName: &github.RepositoryRulesetRefConditionParameters{
+				Include: []string{"refs/tags/" + pattern},
+				Exclude: []string{},
+			},
+		},
+		Rules: &github.RepositoryRulesetRules{
+			Creation: &github.EmptyRuleParameters{},
+			Update:   &github.UpdateRuleParameters{},
+			Deletion: &github.EmptyRuleParameters{},
+		},
+	}
+}
+
+// tagProtectionPattern returns the pattern of the tags protected by a tag
+// ruleset, or false if it does not protect a single pattern.
+func tagProtectionPattern(ruleset *github.RepositoryRuleset) (string, bool) {
+	if ruleset.Target == nil || *ruleset.Target != github.RulesetTargetTag || ruleset.Conditions == nil || ruleset.Conditions.RefName == nil {
+		return "", false
+	}
+	include := ruleset.Conditions.RefName.Include
+	if len(include) != 1 || !strings.HasPrefix(include[0], "refs/tags/") {
+		return "", false
+	}
+	return strings.TrimPrefix(include[0], "refs/tags/"), true
+}
+
+// findTagProtectionRuleset returns the ID of the tag ruleset of the repository
+// protecting exactly the pattern, such as the ones GitHub migrated the tag
+// protections to, or 0 if there is none.
+func findTagProtectionRuleset(ctx context.Context, meta any, repoName, pattern string) (int64, error) {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	opts := &github.RepositoryListRulesetsOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	for {
+		rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repoName, opts)
+		if err != nil {
+			return 0, err
+		}
+		for _, summary := range rulesets {
+			if summary.Target == nil || *summary.Target != github.RulesetTargetTag {
+				continue
+			}
+			// The conditions are not listed, only returned per ruleset.
+			ruleset, _, err := client.Repositories.GetRuleset(ctx, owner, repoName, summary.GetID(), false)
+			if err != nil {
+				return 0, err
+			}
+			if p, ok := tagProtectionPattern(ruleset); ok && p == pattern {
+				return ruleset.GetID(), nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return 0, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func resourceGithubRepositoryTagProtectionCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	pattern := d.Get("pattern").(string)
+	ctx := context.Background()
+
+	if err := waitForRepositoryVisible(ctx, meta, repoName); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating tag ruleset protecting %s/%s: %s", owner, repoName, pattern)
+	ruleset, _, err := client.Repositories.CreateRuleset(ctx, owner, repoName, *tagProtectionRuleset(pattern))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(ruleset.GetID(), 10))
+
+	return resourceGithubRepositoryTagProtectionRead(d, meta)
+}
+
+func resourceGithubRepositoryTagProtectionRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	// The states of the tag protections without an equivalent ruleset are
+	// upgraded without an ID, so that the ruleset is created.
+	if rulesetID == 0 {
+		log.Printf("[INFO] Removing tag protection %s/%s: %s from state because it has no ruleset yet",
+			owner, repoName, d.Get("pattern").(string))
+		d.SetId("")
+		return nil
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ruleset, _, err := client.Repositories.GetRuleset(ctx, owner, repoName, rulesetID, false)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing tag protection ruleset %s/%s: %d from state because it no longer exists in GitHub",
+				owner, repoName, rulesetID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	pattern, ok := tagProtectionPattern(ruleset)
+	if !ok {
+		return fmt.Errorf("ruleset %d of %s/%s does not protect a single tag pattern, manage it with github_repository_ruleset instead", rulesetID, owner, repoName)
+	}
+
+	_ = d.Set("pattern", pattern)
+	_ = d.Set("ruleset_id", ruleset.GetID())
+
+	return nil
+}
+
+func resourceGithubRepositoryTagProtectionDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting tag protection ruleset: %s/%s: %d", owner, repoName, rulesetID)
+	_, err = client.Repositories.DeleteRuleset(ctx, owner, repoName, rulesetID)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "tag protection ruleset %s/%s: %d", owner, repoName, rulesetID)
+	}
+	return nil
+}
+
+func resourceGithubRepositoryTagProtectionImport(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	repoName, rulesetIDStr, err := parseTwoPartID(d.Id(), "repository", "ruleset")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := strconv.ParseInt(rulesetIDStr, 10, 64); err != nil {
+		return nil, unconvertibleIdErr(rulesetIDStr, err)
+	}
+
+	_ = d.Set("repository", repoName)
+	d.SetId(rulesetIDStr)
+
+	return []*schema.ResourceData{d}, nil
+}
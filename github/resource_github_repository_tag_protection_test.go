@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestTagProtectionPattern(t *testing.T) {
+	if pattern, ok := tagProtectionPattern(tagProtectionRuleset("v*")); !ok || pattern != "v*" {
+		t.Errorf("expected the pattern of the tag protection ruleset to be v*, got %q", pattern)
+	}
+
+	ruleset := tagProtectionRuleset("v*")
+	ruleset.Conditions.RefName.Include = append(ruleset.Conditions.RefName.Include, "refs/tags/release-*")
+	if _, ok := tagProtectionPattern(ruleset); ok {
+		t.Error("expected a ruleset with several patterns not to be a tag protection")
+	}
+
+	ruleset = tagProtectionRuleset("v*")
+	ruleset.Target = github.Ptr(github.RulesetTargetBranch)
+	if _, ok := tagProtectionPattern(ruleset); ok {
+		t.Error("expected a branch ruleset not to be a tag protection")
+	}
+}
+
+func TestResourceGithubRepositoryTagProtectionUpgradeV0(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/org/repo/rulesets", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"id": 1, "name": "main", "target": "branch", "enforcement": "active"}, {"id": 2, "name": "v*", "target": "tag", "enforcement": "active"}]`)
+	})
+	mux.HandleFunc("GET /repos/org/repo/rulesets/2", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 2, "name": "v*", "target": "tag", "enforcement": "active", "conditions": {"ref_name": {"include": ["refs/tags/v*"], "exclude": []}}}`)
+	})
+
+	client := github.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+	client.BaseURL, _ = url.Parse("https://api.github.com/")
+	meta := &Owner{name: "org", v3client: client}
+
+	for pattern, want := range map[string]string{"v*": "2", "release-*": "0"} {
+		rawState := map[string]any{
+			"id":                "123",
+			"repository":        "repo",
+			"pattern":           pattern,
+			"tag_protection_id": 123,
+		}
+		upgraded, err := resourceGithubRepositoryTagProtectionUpgradeV0(context.Background(), rawState, meta)
+		if err != nil {
+			t.Fatalf("unexpected error upgrading tag protection %s: %s", pattern, err)
+		}
+		if upgraded["id"] != want {
+			t.Errorf("expected tag protection %s to be upgraded to ruleset %s, got %v", pattern, want, upgraded["id"])
+		}
+		if _, ok := upgraded["tag_protection_id"]; ok {
+			t.Errorf("expected tag_protection_id to be removed, got %v", upgraded)
+		}
+	}
+}
+
+func TestAccGithubRepositoryTagProtection(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("protects tags with a ruleset without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_tag_protection" "test" {
+				repository = github_repository.test.name
+				pattern    = "v*"
+			}
+		`, randomID)
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("github_repository_tag_protection.test", "pattern", "v*"),
+			resource.TestCheckResourceAttrSet("github_repository_tag_protection.test", "ruleset_id"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  check,
+					},
+					{
+						ResourceName:        "github_repository_tag_protection.test",
+						ImportState:         true,
+						ImportStateVerify:   true,
+						ImportStateIdPrefix: fmt.Sprintf("tf-acc-test-%s:", randomID),
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+	})
+}
@@ -0,0 +1,430 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryTemplateSync() *schema.Resource {
+	return &schema.Resource{
+		Description: "Re-applies files of a template repository to a repository created from it whenever the template changes.",
+		Create:      resourceGithubRepositoryTemplateSyncCreate,
+		Read:        resourceGithubRepositoryTemplateSyncRead,
+		Update:      resourceGithubRepositoryTemplateSyncUpdate,
+		Delete:      resourceGithubRepositoryTemplateSyncDelete,
+
+		CustomizeDiff: resourceGithubRepositoryTemplateSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The repository to apply the template files to.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The branch to apply the template files to, defaults to the default branch of the repository.",
+			},
+			"template_owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The owner of the template repository, defaults to the owner of the template the repository was created from.",
+			},
+			"template_repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the template repository, defaults to the template the repository was created from.",
+			},
+			"template_branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The branch of the template repository to track, defaults to its default branch.",
+			},
+			"files": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The paths of the template files to apply to the repository.",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when applying the template files.",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_email"},
+				Description:  "The commit author name, defaults to the authenticated user's name.",
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"commit_author"},
+				Description:  "The commit author email address, defaults to the authenticated user's email address.",
+			},
+			"template_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the template commit the files were last applied from.",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last applied template files to the repository, empty if the files never differed.",
+			},
+			"drifted_files": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The paths of the files which differ in the repository from the template commit they were last applied from, as of the last refresh.",
+			},
+		},
+	}
+}
+
+// getGithubBranchHeadSHA returns the SHA of the commit a branch points to.
+func getGithubBranchHeadSHA(ctx context.Context, client *github.Client, owner, repo, branch string) (string, error) {
+	ref, _, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+	if err != nil {
+		return "", fmt.Errorf("error querying GitHub branch reference %s/%s (refs/heads/%s): %w", owner, repo, branch, err)
+	}
+	return ref.GetObject().GetSHA(), nil
+}
+
+// listGithubTreeBlobs returns the blobs of the tree of a commit, keyed by path.
+func listGithubTreeBlobs(ctx context.Context, client *github.Client, owner, repo, treeSHA string) (map[string]*github.TreeEntry, error) {
+	tree, _, err := client.Git.GetTree(ctx, owner, repo, treeSHA, true)
+	if err != nil {
+		return nil, err
+	}
+	if tree.GetTruncated() {
+		return nil, fmt.Errorf("the tree of %s/%s is too large to be listed", owner, repo)
+	}
+
+	blobs := make(map[string]*github.TreeEntry, len(tree.Entries))
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" {
+			blobs[entry.GetPath()] = entry
+		}
+	}
+	return blobs, nil
+}
+
+// templateDriftedFiles returns the sorted files whose blob in the repository is
+// missing or differs from the blob in the template. Files missing from the
+// template are left out, applying them fails anyway.
+func templateDriftedFiles(files []string, templateBlobs, blobs map[string]*github.TreeEntry) []string {
+	drifted := make([]string, 0)
+	for _, file := range files {
+		templateBlob, ok := templateBlobs[file]
+		if !ok {
+			continue
+		}
+		if blob, ok := blobs[file]; !ok || blob.GetSHA() != templateBlob.GetSHA() || blob.GetMode() != templateBlob.GetMode() {
+			drifted = append(drifted, file)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted
+}
+
+// resolveGithubRepositoryTemplateSync fills in the repository, branch and
+// template defaults from the repository and its template.
+func resolveGithubRepositoryTemplateSync(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("branch").(string) == "" {
+		if err := d.Set("branch", repo.GetDefaultBranch()); err != nil {
+			return err
+		}
+	}
+
+	templateOwner := d.Get("template_owner").(string)
+	templateRepoName := d.Get("template_repository").(string)
+	if templateOwner == "" || templateRepoName == "" {
+		template := repo.GetTemplateRepository()
+		if template == nil {
+			return fmt.Errorf("repository %s/%s was not created from a template, template_owner and template_repository must be set", owner, repoName)
+		}
+		if templateOwner == "" {
+			templateOwner = template.GetOwner().GetLogin()
+		}
+		if templateRepoName == "" {
+			templateRepoName = template.GetName()
+		}
+	}
+	if err := d.Set("template_owner", templateOwner); err != nil {
+		return err
+	}
+	if err := d.Set("template_repository", templateRepoName); err != nil {
+		return err
+	}
+
+	if d.Get("template_branch").(string) == "" {
+		templateRepo, _, err := client.Repositories.Get(ctx, templateOwner, templateRepoName)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("template_branch", templateRepo.GetDefaultBranch()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncGithubRepositoryTemplateFiles applies the files of the template at
+// templateSHA to the repository in a single commit, skipping the files that
+// are already identical.
+func syncGithubRepositoryTemplateFiles(ctx context.Context, d *schema.ResourceData, meta any, templateSHA string) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+	templateOwner := d.Get("template_owner").(string)
+	templateRepoName := d.Get("template_repository").(string)
+
+	templateCommit, _, err := client.Git.GetCommit(ctx, templateOwner, templateRepoName, templateSHA)
+	if err != nil {
+		return err
+	}
+	templateBlobs, err := listGithubTreeBlobs(ctx, client, templateOwner, templateRepoName, templateCommit.GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	headSHA, err := getGithubBranchHeadSHA(ctx, client, owner, repoName, branch)
+	if err != nil {
+		return err
+	}
+	parent, _, err := client.Git.GetCommit(ctx, owner, repoName, headSHA)
+	if err != nil {
+		return err
+	}
+	blobs, err := listGithubTreeBlobs(ctx, client, owner, repoName, parent.GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	files := expandStringList(d.Get("files").(*schema.Set).List())
+	sort.Strings(files)
+	for _, file := range files {
+		if _, ok := templateBlobs[file]; !ok {
+			return fmt.Errorf("file %s does not exist in the template repository %s/%s at %s", file, templateOwner, templateRepoName, templateSHA)
+		}
+	}
+
+	// Blob SHAs only depend on the content, identical files are skipped.
+	entries := make([]*github.TreeEntry, 0)
+	for _, file := range templateDriftedFiles(files, templateBlobs, blobs) {
+		templateBlob := templateBlobs[file]
+
+		// Blobs are copied as the repository may not have the objects of the
+		// template repository.
+		content, _, err := client.Git.GetBlob(ctx, templateOwner, templateRepoName, templateBlob.GetSHA())
+		if err != nil {
+			return err
+		}
+		blob, _, err := client.Git.CreateBlob(ctx, owner, repoName, &github.Blob{
+			Content:  github.Ptr(strings.ReplaceAll(content.GetContent(), "\n", "")),
+			Encoding: github.Ptr(content.GetEncoding()),
+		})
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, &github.TreeEntry{
+			Path: github.Ptr(file),
+			Mode: github.Ptr(templateBlob.GetMode()),
+			Type: github.Ptr("blob"),
+			SHA:  blob.SHA,
+		})
+	}
+
+	if len(entries) == 0 {
+		log.Printf("[DEBUG] Template files of %s/%s are already up to date with %s/%s@%s", owner, repoName, templateOwner, templateRepoName, templateSHA)
+		return nil
+	}
+
+	tree, _, err := client.Git.CreateTree(ctx, owner, repoName, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Apply %s/%s@%.7s", templateOwner, templateRepoName, templateSHA)
+	if commitMessage, ok := d.GetOk("commit_message"); ok {
+		message = commitMessage.(string)
+	}
+	commit := &github.Commit{
+		Message: github.Ptr(message),
+		Tree:    tree,
+		Parents: []*github.Commit{{SHA: parent.SHA}},
+	}
+	if commitAuthor, ok := d.GetOk("commit_author"); ok {
+		author := &github.CommitAuthor{
+			Name:  github.Ptr(commitAuthor.(string)),
+			Email: github.Ptr(d.Get("commit_email").(string)),
+		}
+		commit.Author = author
+		commit.Committer = author
+	}
+
+	result, _, err := client.Git.CreateCommit(ctx, owner, repoName, commit, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Applying %d template files to %s/%s in commit %s", len(entries), owner, repoName, result.GetSHA())
+	if _, _, err := client.Git.UpdateRef(ctx, owner, repoName, &github.Reference{
+		Ref:    github.Ptr("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: result.SHA},
+	}, false); err != nil {
+		return err
+	}
+
+	return d.Set("commit_sha", result.GetSHA())
+}
+
+func resourceGithubRepositoryTemplateSyncCreate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	if err := resolveGithubRepositoryTemplateSync(ctx, d, meta); err != nil {
+		return err
+	}
+
+	templateSHA, err := getGithubBranchHeadSHA(ctx, client, d.Get("template_owner").(string), d.Get("template_repository").(string), d.Get("template_branch").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := syncGithubRepositoryTemplateFiles(ctx, d, meta, templateSHA); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(d.Get("repository").(string), d.Get("branch").(string)))
+	if err := d.Set("template_sha", templateSHA); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryTemplateSyncRead(d, meta)
+}
+
+func resourceGithubRepositoryTemplateSyncRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, branch, err := parseTwoPartID(d.Id(), "repository", "branch")
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	head, _, err := client.Repositories.GetBranch(ctx, owner, repoName, branch, 1)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository template sync %s", d.Id())
+	}
+
+	if err := d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err := d.Set("branch", branch); err != nil {
+		return err
+	}
+
+	// The files are compared with the template commit they were last applied
+	// from, the changes of the template itself are planned by the diff.
+	templateOwner := d.Get("template_owner").(string)
+	templateRepoName := d.Get("template_repository").(string)
+	templateCommit, _, err := client.Git.GetCommit(ctx, templateOwner, templateRepoName, d.Get("template_sha").(string))
+	if err != nil {
+		return err
+	}
+	templateBlobs, err := listGithubTreeBlobs(ctx, client, templateOwner, templateRepoName, templateCommit.GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+	blobs, err := listGithubTreeBlobs(ctx, client, owner, repoName, head.GetCommit().GetCommit().GetTree().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	drifted := templateDriftedFiles(expandStringList(d.Get("files").(*schema.Set).List()), templateBlobs, blobs)
+	if len(drifted) > 0 {
+		log.Printf("[DEBUG] Template files %v of %s/%s differ from %s/%s@%s", drifted, owner, repoName, templateOwner, templateRepoName, d.Get("template_sha").(string))
+	}
+	return d.Set("drifted_files", drifted)
+}
+
+func resourceGithubRepositoryTemplateSyncUpdate(d *schema.ResourceData, meta any) error {
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// The template commit to apply was resolved when planning.
+	if err := syncGithubRepositoryTemplateFiles(ctx, d, meta, d.Get("template_sha").(string)); err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryTemplateSyncRead(d, meta)
+}
+
+func resourceGithubRepositoryTemplateSyncDelete(d *schema.ResourceData, meta any) error {
+	// The applied files are part of the history of the repository, destroying
+	// the resource only stops tracking the template.
+	log.Printf("[INFO] Removing repository template sync %s from state, the repository is left unchanged", d.Id())
+	return nil
+}
+
+// resourceGithubRepositoryTemplateSyncCustomizeDiff plans an update whenever
+// the tracked template branch moved since the files were last applied, or the
+// files were changed in the repository since.
+func resourceGithubRepositoryTemplateSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || meta == nil {
+		return nil
+	}
+
+	if d.HasChange("files") {
+		if err := d.SetNewComputed("drifted_files"); err != nil {
+			return err
+		}
+	} else if err := planRefreshedDrift(d, "drifted_files"); err != nil {
+		return err
+	}
+
+	templateSHA, err := getGithubBranchHeadSHA(ctx, meta.(*Owner).v3client, d.Get("template_owner").(string), d.Get("template_repository").(string), d.Get("template_branch").(string))
+	if err != nil {
+		return err
+	}
+
+	if templateSHA != d.Get("template_sha").(string) {
+		log.Printf("[DEBUG] Template %s/%s moved to %s", d.Get("template_owner").(string), d.Get("template_repository").(string), templateSHA)
+		return d.SetNew("template_sha", templateSHA)
+	}
+
+	return nil
+}
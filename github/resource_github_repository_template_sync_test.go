@@ -0,0 +1,119 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubRepositoryTemplateSync(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("applies template files when the template changes", func(t *testing.T) {
+
+		config := `
+			resource "github_repository" "template" {
+				name        = "tf-acc-test-template-%[1]s"
+				auto_init   = true
+				is_template = true
+			}
+
+			resource "github_repository_file" "template" {
+				repository     = github_repository.template.name
+				file           = ".github/CODEOWNERS"
+				content        = "%[3]s"
+				commit_message = "Managed by Terraform"
+			}
+
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-template-sync-%[1]s"
+				auto_init = true
+			}
+
+			resource "github_repository_template_sync" "test" {
+				repository          = github_repository.test.name
+				template_owner      = "%[2]s"
+				template_repository = github_repository.template.name
+				files               = [".github/CODEOWNERS"]
+
+				depends_on = [github_repository_file.template]
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_template_sync.test", "branch", "main"),
+				resource.TestCheckResourceAttr("github_repository_template_sync.test", "template_branch", "main"),
+				resource.TestCheckResourceAttrSet("github_repository_template_sync.test", "template_sha"),
+				resource.TestCheckResourceAttrSet("github_repository_template_sync.test", "commit_sha"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrPair(
+					"github_repository_template_sync.test", "template_sha",
+					"github_repository_file.template", "commit_sha",
+				),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, randomID, testOwnerFunc(), "* @octocat"),
+						Check:  checks["before"],
+					},
+					{
+						// The template changes after the files were applied,
+						// the next apply picks the change up.
+						Config:             fmt.Sprintf(config, randomID, testOwnerFunc(), "* @hubot"),
+						ExpectNonEmptyPlan: true,
+					},
+					{
+						Config: fmt.Sprintf(config, randomID, testOwnerFunc(), "* @hubot"),
+						Check:  checks["after"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+
+}
+
+func TestTemplateDriftedFiles(t *testing.T) {
+	templateBlobs := map[string]*github.TreeEntry{
+		"ci.yml":     {SHA: github.Ptr("ci"), Mode: github.Ptr("100644")},
+		"CODEOWNERS": {SHA: github.Ptr("owners"), Mode: github.Ptr("100644")},
+		"build.sh":   {SHA: github.Ptr("build"), Mode: github.Ptr("100755")},
+		"Makefile":   {SHA: github.Ptr("make"), Mode: github.Ptr("100644")},
+	}
+	blobs := map[string]*github.TreeEntry{
+		"ci.yml":     {SHA: github.Ptr("ci"), Mode: github.Ptr("100644")},
+		"CODEOWNERS": {SHA: github.Ptr("edited"), Mode: github.Ptr("100644")},
+		"build.sh":   {SHA: github.Ptr("build"), Mode: github.Ptr("100644")},
+	}
+
+	files := []string{"ci.yml", "CODEOWNERS", "build.sh", "Makefile", "missing.txt"}
+	if drifted := templateDriftedFiles(files, templateBlobs, blobs); !reflect.DeepEqual(drifted, []string{"CODEOWNERS", "Makefile", "build.sh"}) {
+		t.Errorf("expected the edited, removed and re-moded files to drift, got %v", drifted)
+	}
+}
@@ -3,7 +3,6 @@ package github
 import (
 	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"strings"
 	"testing"
@@ -191,6 +190,14 @@ func TestAccGithubRepositories(t *testing.T) {
 						ImportState:       true,
 						ImportStateVerify: true,
 					},
+					{
+						ResourceName:      "github_repository.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateIdFunc: func(s *terraform.State) (string, error) {
+							return s.RootModule().Resources["github_repository.test"].Primary.Attributes["full_name"], nil
+						},
+					},
 				},
 			})
 		}
@@ -1591,39 +1598,6 @@ func TestGithubRepositoryTopicFailsValidationWhenOverMaxCharacters(t *testing.T)
 	}
 }
 
-func testSweepRepositories(region string) error {
-	meta, err := sharedConfigForRegion(region)
-	if err != nil {
-		return err
-	}
-
-	client := meta.(*Owner).v3client
-
-	repos, _, err := client.Repositories.ListByUser(context.TODO(), meta.(*Owner).name, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, r := range repos {
-		if name := r.GetName(); strings.HasPrefix(name, "tf-acc-") || strings.HasPrefix(name, "foo-") {
-			log.Printf("[DEBUG] Destroying Repository %s", name)
-
-			if _, err := client.Repositories.Delete(context.TODO(), meta.(*Owner).name, name); err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
-func init() {
-	resource.AddTestSweepers("github_repository", &resource.Sweeper{
-		Name: "github_repository",
-		F:    testSweepRepositories,
-	})
-}
-
 func reconfigureVisibility(config, visibility string) string {
 	re := regexp.MustCompile(`visibility = "(.*)"`)
 	newConfig := re.ReplaceAllString(
@@ -1700,3 +1674,88 @@ func TestGithubRepositoryNameFailsValidationWithSpace(t *testing.T) {
 		t.Error(fmt.Errorf("unexpected name validation failure; expected=%s; action=%s", expectedFailure, actualFailure))
 	}
 }
+
+func TestGithubRepositoryMergeSettingsFailValidationWithoutMergeMethod(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"name":               "test",
+		"allow_merge_commit": false,
+		"allow_squash_merge": false,
+		"allow_rebase_merge": false,
+	})
+	_, err := resourceGithubRepository().Diff(context.Background(), nil, config, nil)
+	expectedFailure := "at least one of allow_merge_commit, allow_squash_merge and allow_rebase_merge must be true"
+	if err == nil || !strings.Contains(err.Error(), expectedFailure) {
+		t.Errorf("unexpected merge settings validation failure; expected=%s; actual=%v", expectedFailure, err)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]any{
+		"name":               "test",
+		"allow_merge_commit": false,
+		"allow_squash_merge": false,
+	})
+	if _, err := resourceGithubRepository().Diff(context.Background(), nil, config, nil); err != nil {
+		t.Errorf("unexpected merge settings validation failure: %s", err)
+	}
+}
+
+func TestGithubRepositoryCommitTitleAndMessageValidation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		allowed  bool
+		config   map[string]cty.Value
+		title    string
+		message  string
+		expected string
+	}{
+		"defaults": {
+			allowed: true,
+			title:   "COMMIT_OR_PR_TITLE",
+			message: "COMMIT_MESSAGES",
+		},
+		"valid combination": {
+			allowed: true,
+			title:   "PR_TITLE",
+			message: "BLANK",
+		},
+		"invalid combination": {
+			allowed:  true,
+			title:    "COMMIT_OR_PR_TITLE",
+			message:  "PR_BODY",
+			expected: `squash_merge_commit_message must be one of COMMIT_MESSAGES when squash_merge_commit_title is "COMMIT_OR_PR_TITLE", got "PR_BODY"`,
+		},
+		"invalid title": {
+			allowed:  true,
+			title:    "MERGE_MESSAGE",
+			message:  "COMMIT_MESSAGES",
+			expected: `squash_merge_commit_title must be one of COMMIT_OR_PR_TITLE, PR_TITLE, got "MERGE_MESSAGE"`,
+		},
+		"defaults when disabled": {
+			title:   "COMMIT_OR_PR_TITLE",
+			message: "PR_BODY",
+		},
+		"configured when disabled": {
+			config:   map[string]cty.Value{"squash_merge_commit_message": cty.StringVal("PR_BODY")},
+			title:    "COMMIT_OR_PR_TITLE",
+			message:  "PR_BODY",
+			expected: "squash_merge_commit_message cannot be set when allow_squash_merge is false",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]cty.Value{
+				"squash_merge_commit_title":   cty.NullVal(cty.String),
+				"squash_merge_commit_message": cty.NullVal(cty.String),
+			}
+			for key, value := range tc.config {
+				config[key] = value
+			}
+
+			err := validateCommitTitleAndMessage(cty.ObjectVal(config), "squash_merge_commit", tc.allowed, "allow_squash_merge",
+				tc.title, tc.message, validSquashMergeCommitMessages)
+			if tc.expected == "" && err != nil {
+				t.Errorf("unexpected validation failure: %s", err)
+			}
+			if tc.expected != "" && (err == nil || err.Error() != tc.expected) {
+				t.Errorf("unexpected validation failure; expected=%s; actual=%v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -134,8 +134,13 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta any) error {
 	}
 	ctx := context.Background()
 
-	githubTeam, _, err := client.Teams.CreateTeam(ctx,
-		ownerName, newTeam)
+	var githubTeam *github.Team
+	err = retryOnSubmittedTooQuickly(ctx, "team "+name, func() error {
+		var err error
+		githubTeam, _, err = client.Teams.CreateTeam(ctx,
+			ownerName, newTeam)
+		return err
+	})
 	if err != nil {
 		return err
 	}
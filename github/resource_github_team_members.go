@@ -3,7 +3,7 @@ package github
 import (
 	"context"
 	"log"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,17 +12,13 @@ import (
 	"github.com/shurcooL/githubv4"
 )
 
-type MemberChange struct {
-	Old, New map[string]any
-}
-
 func resourceGithubTeamMembers() *schema.Resource {
 
 	return &schema.Resource{
 		Description: "Provides an authoritative GitHub team members resource.",
-		Create:      resourceGithubTeamMembersCreate,
+		Create:      resourceGithubTeamMembersCreateOrUpdate,
 		Read:        resourceGithubTeamMembersRead,
-		Update:      resourceGithubTeamMembersUpdate,
+		Update:      resourceGithubTeamMembersCreateOrUpdate,
 		Delete:      resourceGithubTeamMembersDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceGithubTeamMembersImport,
@@ -57,47 +53,154 @@ func resourceGithubTeamMembers() *schema.Resource {
 					},
 				},
 			},
+			"ignore_maintainers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the maintainers of the team not listed in 'members' are left unchanged, e.g. the creator of the team or maintainers managed elsewhere.",
+			},
 		},
 	}
 }
 
-func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta any) error {
-	client := meta.(*Owner).v3client
-	orgId := meta.(*Owner).id
+// expandTeamMembers returns the roles of the members keyed by lowercase
+// username, as usernames are case insensitive.
+func expandTeamMembers(members *schema.Set) map[string]string {
+	roles := make(map[string]string, members.Len())
+	for _, raw := range members.List() {
+		member := raw.(map[string]any)
+		roles[strings.ToLower(member["username"].(string))] = member["role"].(string)
+	}
+	return roles
+}
 
-	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
-	if err != nil {
-		return err
+// teamMembersChanges returns the memberships to add or whose role to change,
+// which both take a single request, and the members to remove so that the
+// team has the desired members. Maintainers not desired are kept when they
+// are ignored.
+func teamMembersChanges(current, desired map[string]string, ignoreMaintainers bool) (map[string]string, []string) {
+	add := make(map[string]string)
+	for username, role := range desired {
+		if current[username] != role {
+			add[username] = role
+		}
 	}
-	ctx := context.Background()
 
-	members := d.Get("members").(*schema.Set)
-	for _, mMap := range members.List() {
-		memb := mMap.(map[string]any)
-		username := memb["username"].(string)
-		role := memb["role"].(string)
+	var remove []string
+	for username, role := range current {
+		if _, ok := desired[username]; ok {
+			continue
+		}
+		if ignoreMaintainers && role == "maintainer" {
+			continue
+		}
+		remove = append(remove, username)
+	}
+	sort.Strings(remove)
 
-		log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
-		_, _, err = client.Teams.AddTeamMembershipByID(ctx,
-			orgId,
-			teamId,
-			username,
-			&github.TeamAddTeamMembershipOptions{
-				Role: role,
-			},
-		)
+	return add, remove
+}
+
+// listGithubTeamMembers returns the roles of the immediate members of the team
+// keyed by lowercase username, along with their logins.
+func listGithubTeamMembers(ctx context.Context, meta any, teamSlug string) (map[string]string, map[string]string, error) {
+	client := meta.(*Owner).v4client
+	orgName := meta.(*Owner).name
+
+	var q struct {
+		Organization struct {
+			Team struct {
+				Members struct {
+					Edges []struct {
+						Node struct {
+							Login string
+						}
+						Role string
+					}
+					PageInfo struct {
+						EndCursor   githubv4.String
+						HasNextPage bool
+					}
+				} `graphql:"members(membership:IMMEDIATE, first:100, after: $after)"`
+			} `graphql:"team(slug:$teamSlug)"`
+		} `graphql:"organization(login:$orgName)"`
+	}
+
+	variables := map[string]any{
+		"teamSlug": githubv4.String(teamSlug),
+		"orgName":  githubv4.String(orgName),
+		"after":    (*githubv4.String)(nil),
+	}
+
+	roles := make(map[string]string)
+	logins := make(map[string]string)
+	for {
+		if err := client.Query(ctx, &q, variables); err != nil {
+			return nil, nil, err
+		}
+
+		for _, member := range q.Organization.Team.Members.Edges {
+			username := strings.ToLower(member.Node.Login)
+			roles[username] = strings.ToLower(member.Role)
+			logins[username] = member.Node.Login
+		}
+		if !q.Organization.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = githubv4.NewString(q.Organization.Team.Members.PageInfo.EndCursor)
+	}
+
+	return roles, logins, nil
+}
+
+// listGithubTeamPendingInvitees returns the logins of the users invited to
+// the organization through the team, keyed by lowercase username. They only
+// become members of the team once they accept the invitation.
+func listGithubTeamPendingInvitees(ctx context.Context, meta any, teamSlug string) (map[string]string, error) {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	logins := make(map[string]string)
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		invitations, resp, err := client.Teams.ListPendingTeamInvitationsBySlug(ctx, orgName, teamSlug, options)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		for _, invitation := range invitations {
+			// Invitations by email have no login until they are accepted.
+			if login := invitation.GetLogin(); login != "" {
+				logins[strings.ToLower(login)] = login
+			}
+		}
+		if resp.NextPage == 0 {
+			break
 		}
+		options.Page = resp.NextPage
 	}
 
-	d.SetId(teamIdString)
+	return logins, nil
+}
 
-	return resourceGithubTeamMembersRead(d, meta)
+// addPendingTeamMembers adds the desired members with a pending invitation to
+// the current members with their desired role, so that they are neither
+// invited again nor reported as missing until they accept the invitation.
+func addPendingTeamMembers(current, desired, pending map[string]string) {
+	for username := range pending {
+		if _, ok := current[username]; ok {
+			continue
+		}
+		if role, ok := desired[username]; ok {
+			current[username] = role
+		}
+	}
 }
 
-func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta any) error {
+func resourceGithubTeamMembersCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 
@@ -106,67 +209,49 @@ func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta any) error {
 	if err != nil {
 		return err
 	}
+	teamSlug, err := getTeamSlug(teamIdString, meta)
+	if err != nil {
+		return err
+	}
 	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
 
-	o, n := d.GetChange("members")
-	vals := make(map[string]*MemberChange)
-	for _, raw := range o.(*schema.Set).List() {
-		obj := raw.(map[string]any)
-		k := obj["username"].(string)
-		vals[k] = &MemberChange{Old: obj}
+	// The changes are computed against the actual members of the team, so
+	// that only the required requests are made for large teams.
+	current, _, err := listGithubTeamMembers(ctx, meta, teamSlug)
+	if err != nil {
+		return err
 	}
-	for _, raw := range n.(*schema.Set).List() {
-		obj := raw.(map[string]any)
-		k := obj["username"].(string)
-		if _, ok := vals[k]; !ok {
-			vals[k] = &MemberChange{}
-		}
-		vals[k].New = obj
+	pending, err := listGithubTeamPendingInvitees(ctx, meta, teamSlug)
+	if err != nil {
+		return err
 	}
+	desired := expandTeamMembers(d.Get("members").(*schema.Set))
+	addPendingTeamMembers(current, desired, pending)
+	add, remove := teamMembersChanges(current, desired, d.Get("ignore_maintainers").(bool))
 
-	for username, change := range vals {
-		var create, delete bool
-
-		switch {
-		// create a new one if old is nil
-		case change.Old == nil:
-			create = true
-		// delete existing if new is nil
-		case change.New == nil:
-			delete = true
-			// no change
-		case reflect.DeepEqual(change.Old, change.New):
-			continue
-			// recreate - role changed
-		default:
-			delete = true
-			create = true
-		}
-
-		if delete {
-			log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, username)
-
-			_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
-			if err != nil {
-				return err
-			}
+	for _, username := range remove {
+		log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, username)
+		_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
+		if err != nil {
+			return err
 		}
+	}
 
-		if create {
-			role := change.New["role"].(string)
-
-			log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
-			_, _, err = client.Teams.AddTeamMembershipByID(ctx,
-				orgId,
-				teamId,
-				username,
-				&github.TeamAddTeamMembershipOptions{
-					Role: role,
-				},
-			)
-			if err != nil {
-				return err
-			}
+	for username, role := range add {
+		log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
+		_, _, err = client.Teams.AddTeamMembershipByID(ctx,
+			orgId,
+			teamId,
+			username,
+			&github.TeamAddTeamMembershipOptions{
+				Role: role,
+			},
+		)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -176,8 +261,10 @@ func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta any) error {
 }
 
 func resourceGithubTeamMembersRead(d *schema.ResourceData, meta any) error {
-	client := meta.(*Owner).v4client
-	orgName := meta.(*Owner).name
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	teamIdString := d.Get("team_id").(string)
 	if teamIdString == "" && !d.IsNewResource() {
 		log.Printf("[DEBUG] Importing team with id %q", d.Id())
@@ -199,48 +286,35 @@ func resourceGithubTeamMembersRead(d *schema.ResourceData, meta any) error {
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Reading team members: %s", teamIdString)
-	var q struct {
-		Organization struct {
-			Team struct {
-				Members struct {
-					Edges []struct {
-						Node struct {
-							Login string
-						}
-						Role string
-					}
-					PageInfo struct {
-						EndCursor   githubv4.String
-						HasNextPage bool
-					}
-				} `graphql:"members(membership:IMMEDIATE, first:100, after: $after)"`
-			} `graphql:"team(slug:$teamSlug)"`
-		} `graphql:"organization(login:$orgName)"`
+	roles, logins, err := listGithubTeamMembers(ctx, meta, teamSlug)
+	if err != nil {
+		return err
 	}
 
-	variables := map[string]any{
-		"teamSlug": githubv4.String(teamSlug),
-		"orgName":  githubv4.String(orgName),
-		"after":    (*githubv4.String)(nil),
-	}
+	// The ignored maintainers are only read when listed in the members.
+	managed := expandTeamMembers(d.Get("members").(*schema.Set))
+	ignoreMaintainers := d.Get("ignore_maintainers").(bool)
 
-	var teamMembersAndMaintainers []any
-	for {
-		if err := client.Query(ctx, &q, variables); err != nil {
-			return err
+	pending, err := listGithubTeamPendingInvitees(ctx, meta, teamSlug)
+	if err != nil {
+		return err
+	}
+	addPendingTeamMembers(roles, managed, pending)
+	for username, login := range pending {
+		if _, ok := logins[username]; !ok {
+			logins[username] = login
 		}
+	}
 
-		// Add all members to the list
-		for _, member := range q.Organization.Team.Members.Edges {
-			teamMembersAndMaintainers = append(teamMembersAndMaintainers, map[string]any{
-				"username": member.Node.Login,
-				"role":     strings.ToLower(member.Role),
-			})
-		}
-		if !q.Organization.Team.Members.PageInfo.HasNextPage {
-			break
+	teamMembersAndMaintainers := make([]any, 0, len(roles))
+	for username, role := range roles {
+		if _, ok := managed[username]; !ok && ignoreMaintainers && role == "maintainer" {
+			continue
 		}
-		variables["after"] = githubv4.NewString(q.Organization.Team.Members.PageInfo.EndCursor)
+		teamMembersAndMaintainers = append(teamMembersAndMaintainers, map[string]any{
+			"username": logins[username],
+			"role":     role,
+		})
 	}
 
 	if err := d.Set("members", teamMembersAndMaintainers); err != nil {
@@ -284,6 +358,9 @@ func resourceGithubTeamMembersImport(d *schema.ResourceData, meta any) ([]*schem
 	}
 
 	d.SetId(strconv.FormatInt(teamId, 10))
+	if err = d.Set("ignore_maintainers", false); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -12,6 +13,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestTeamMembersChanges(t *testing.T) {
+	current := map[string]string{
+		"alice": "member",
+		"bob":   "member",
+		"carol": "maintainer",
+		"dave":  "member",
+	}
+	desired := map[string]string{
+		"alice": "member",
+		"bob":   "maintainer",
+		"erin":  "member",
+	}
+
+	add, remove := teamMembersChanges(current, desired, false)
+	if !reflect.DeepEqual(add, map[string]string{"bob": "maintainer", "erin": "member"}) {
+		t.Errorf("expected bob's role to change and erin to be added, got %v", add)
+	}
+	if !reflect.DeepEqual(remove, []string{"carol", "dave"}) {
+		t.Errorf("expected carol and dave to be removed, got %v", remove)
+	}
+
+	_, remove = teamMembersChanges(current, desired, true)
+	if !reflect.DeepEqual(remove, []string{"dave"}) {
+		t.Errorf("expected only dave to be removed when ignoring maintainers, got %v", remove)
+	}
+}
+
+func TestAddPendingTeamMembers(t *testing.T) {
+	current := map[string]string{"alice": "member"}
+	desired := map[string]string{"alice": "member", "bob": "maintainer"}
+	pending := map[string]string{"bob": "Bob", "carol": "carol"}
+
+	addPendingTeamMembers(current, desired, pending)
+	if !reflect.DeepEqual(current, map[string]string{"alice": "member", "bob": "maintainer"}) {
+		t.Errorf("expected the invited desired member bob to be present, got %v", current)
+	}
+
+	add, remove := teamMembersChanges(current, desired, false)
+	if len(add) != 0 || len(remove) != 0 {
+		t.Errorf("expected no changes while bob's invitation is pending, got %v and %v", add, remove)
+	}
+}
+
 func TestAccGithubTeamMembers(t *testing.T) {
 	if testCollaborator == "" {
 		t.Skip("Skipping because `GITHUB_TEST_COLLABORATOR` is not set")
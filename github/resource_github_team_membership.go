@@ -65,6 +65,10 @@ func resourceGithubTeamMembership() *schema.Resource {
 }
 
 func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 
@@ -89,6 +93,7 @@ func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta any
 	if err != nil {
 		return err
 	}
+	meta.(*Owner).forgetCachedTeamMember(teamId, username)
 
 	d.SetId(buildTwoPartID(teamIdString, username))
 
@@ -96,6 +101,10 @@ func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta any
 }
 
 func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta any) error {
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 	teamIdString, username, err := parseTwoPartID(d.Id(), "team_id", "username")
@@ -123,6 +132,16 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta any) error {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
+	// Refreshes are served from the members of the team fetched at once, the
+	// API is only asked when the role is not the expected one, e.g. for
+	// pending members.
+	if !d.IsNewResource() {
+		if role, ok := meta.(*Owner).cachedTeamMemberRole(teamId, username); ok && role == d.Get("role").(string) {
+			log.Printf("[DEBUG] Read team membership %s from the team cache", d.Id())
+			return nil
+		}
+	}
+
 	membership, resp, err := client.Teams.GetTeamMembershipByID(ctx,
 		orgId, teamId, username)
 	if err != nil {
@@ -162,6 +181,7 @@ func resourceGithubTeamMembershipDelete(d *schema.ResourceData, meta any) error
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
+	meta.(*Owner).forgetCachedTeamMember(teamId, username)
 
 	return err
 }
@@ -95,6 +95,7 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta any) error
 	if err != nil {
 		return err
 	}
+	meta.(*Owner).forgetCachedTeamRepository(teamId, repoName)
 
 	d.SetId(buildTwoPartID(strconv.FormatInt(teamId, 10), repoName))
 
@@ -124,6 +125,15 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta any) error {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
+	// Refreshes are served from the repositories of the team fetched at once,
+	// the API is only asked when the permission is not the expected one.
+	if !d.IsNewResource() {
+		if permission, ok := meta.(*Owner).cachedTeamRepositoryPermission(teamId, repoName); ok && permission == d.Get("permission").(string) {
+			log.Printf("[DEBUG] Read team repository association %s from the team cache", d.Id())
+			return nil
+		}
+	}
+
 	repo, resp, repoErr := client.Teams.IsTeamRepoByID(ctx, orgId, teamId, orgName, repoName)
 	if repoErr != nil {
 		if ghErr, ok := repoErr.(*github.ErrorResponse); ok {
@@ -195,6 +205,7 @@ func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta any) error
 	if err != nil {
 		return err
 	}
+	meta.(*Owner).forgetCachedTeamRepository(teamId, repoName)
 	d.SetId(buildTwoPartID(teamIdString, repoName))
 
 	return resourceGithubTeamRepositoryRead(d, meta)
@@ -221,6 +232,7 @@ func resourceGithubTeamRepositoryDelete(d *schema.ResourceData, meta any) error
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 
 	resp, err := client.Teams.RemoveTeamRepoByID(ctx, orgId, teamId, orgName, repoName)
+	meta.(*Owner).forgetCachedTeamRepository(teamId, repoName)
 
 	if resp.StatusCode == 404 {
 		log.Printf("[DEBUG] Failed to find team %s to delete for repo: %s.", teamIdString, repoName)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,10 +21,19 @@ func resourceGithubTeamSyncGroupMapping() *schema.Resource {
 		Delete:      resourceGithubTeamSyncGroupMappingDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				if err := d.Set("team_slug", d.Id()); err != nil {
+				// The ID is the team slug, optionally followed by the provider
+				// type, e.g. "my-team:external_group".
+				slug, providerType, found := strings.Cut(d.Id(), ":")
+				if !found {
+					providerType = teamSyncProviderTypeTeamSync
+				}
+				if err := d.Set("team_slug", slug); err != nil {
+					return nil, err
+				}
+				if err := d.Set("provider_type", providerType); err != nil {
 					return nil, err
 				}
-				d.SetId(fmt.Sprintf("teams/%s/team-sync/group-mappings", d.Id()))
+				d.SetId(teamSyncGroupMappingID(slug, providerType))
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -33,6 +44,14 @@ func resourceGithubTeamSyncGroupMapping() *schema.Resource {
 				Required:    true,
 				Description: "Slug of the team.",
 			},
+			"provider_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          teamSyncProviderTypeTeamSync,
+				ValidateDiagFunc: validateValueFunc([]string{teamSyncProviderTypeTeamSync, teamSyncProviderTypeExternalGroup}),
+				Description:      "The API used to connect the team to its IdP group(s). Can be 'team_sync' for the legacy team synchronization or 'external_group' for the external groups of enterprise managed users, which are linked to at most one group. Default: 'team_sync'.",
+			},
 			"group": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -66,6 +85,18 @@ func resourceGithubTeamSyncGroupMapping() *schema.Resource {
 	}
 }
 
+const (
+	teamSyncProviderTypeTeamSync      = "team_sync"
+	teamSyncProviderTypeExternalGroup = "external_group"
+)
+
+func teamSyncGroupMappingID(slug, providerType string) string {
+	if providerType == teamSyncProviderTypeExternalGroup {
+		return fmt.Sprintf("teams/%s/external-groups", slug)
+	}
+	return fmt.Sprintf("teams/%s/team-sync/group-mappings", slug)
+}
+
 func resourceGithubTeamSyncGroupMappingCreate(d *schema.ResourceData, meta any) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -76,14 +107,19 @@ func resourceGithubTeamSyncGroupMappingCreate(d *schema.ResourceData, meta any)
 	ctx := context.Background()
 	orgName := meta.(*Owner).name
 	slug := d.Get("team_slug").(string)
+	providerType := d.Get("provider_type").(string)
 
-	idpGroupList := expandTeamSyncGroups(d)
-	_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	if providerType == teamSyncProviderTypeExternalGroup {
+		err = updateTeamExternalGroup(ctx, client, orgName, slug, d)
+	} else {
+		idpGroupList := expandTeamSyncGroups(d)
+		_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	}
 	if err != nil {
 		return err
 	}
 
-	d.SetId(fmt.Sprintf("teams/%s/team-sync/group-mappings", slug))
+	d.SetId(teamSyncGroupMappingID(slug, providerType))
 
 	return resourceGithubTeamSyncGroupMappingRead(d, meta)
 }
@@ -103,6 +139,16 @@ func resourceGithubTeamSyncGroupMappingRead(d *schema.ResourceData, meta any) er
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
+	// Mappings created before provider_type was introduced use team sync.
+	if d.Get("provider_type").(string) == "" {
+		if err = d.Set("provider_type", teamSyncProviderTypeTeamSync); err != nil {
+			return err
+		}
+	}
+	if d.Get("provider_type").(string) == teamSyncProviderTypeExternalGroup {
+		return resourceGithubTeamSyncGroupMappingReadExternalGroups(ctx, d, meta)
+	}
+
 	idpGroupList, resp, err := client.Teams.ListIDPGroupsForTeamBySlug(ctx, orgName, slug)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
@@ -145,8 +191,12 @@ func resourceGithubTeamSyncGroupMappingUpdate(d *schema.ResourceData, meta any)
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	slug := d.Get("team_slug").(string)
 
-	idpGroupList := expandTeamSyncGroups(d)
-	_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	if d.Get("provider_type").(string) == teamSyncProviderTypeExternalGroup {
+		err = updateTeamExternalGroup(ctx, client, orgName, slug, d)
+	} else {
+		idpGroupList := expandTeamSyncGroups(d)
+		_, _, err = client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, orgName, slug, *idpGroupList)
+	}
 	if err != nil {
 		return err
 	}
@@ -165,6 +215,11 @@ func resourceGithubTeamSyncGroupMappingDelete(d *schema.ResourceData, meta any)
 	ctx := context.WithValue(context.Background(), ctxId, d.Id())
 	slug := d.Get("team_slug").(string)
 
+	if d.Get("provider_type").(string) == teamSyncProviderTypeExternalGroup {
+		_, err = client.Teams.RemoveConnectedExternalGroup(ctx, orgName, slug)
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "team_sync_group mapping for %s/%s", orgName, slug)
+	}
+
 	groups := make([]*github.IDPGroup, 0)
 	emptyGroupList := github.IDPGroupList{Groups: groups}
 
@@ -172,6 +227,83 @@ func resourceGithubTeamSyncGroupMappingDelete(d *schema.ResourceData, meta any)
 	return err
 }
 
+// resourceGithubTeamSyncGroupMappingReadExternalGroups reads the external
+// group connected to a team, for organizations of enterprise managed users
+// where the legacy team synchronization endpoints are not available.
+func resourceGithubTeamSyncGroupMappingReadExternalGroups(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	slug := d.Get("team_slug").(string)
+
+	externalGroupList, resp, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, orgName, slug)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing team_sync_group mapping for %s/%s from state because it no longer exists in GitHub",
+					orgName, slug)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	// External groups have no description, the configured one is kept.
+	descriptions := make(map[string]string)
+	for _, group := range d.Get("group").(*schema.Set).List() {
+		m := group.(map[string]any)
+		descriptions[m["group_id"].(string)] = m["group_description"].(string)
+	}
+
+	groups := make([]any, 0)
+	for _, group := range externalGroupList.Groups {
+		groupID := strconv.FormatInt(group.GetGroupID(), 10)
+		groups = append(groups, map[string]any{
+			"group_id":          groupID,
+			"group_name":        group.GetGroupName(),
+			"group_description": descriptions[groupID],
+		})
+	}
+
+	if err = d.Set("group", groups); err != nil {
+		return fmt.Errorf("error setting groups: %s", err)
+	}
+	if err = d.Set("etag", resp.Header.Get("ETag")); err != nil {
+		return fmt.Errorf("error setting etag: %s", err)
+	}
+
+	return nil
+}
+
+// updateTeamExternalGroup connects a team to the external group configured in
+// the *schema.ResourceData, or disconnects it if no group is configured.
+func updateTeamExternalGroup(ctx context.Context, client *github.Client, orgName, slug string, d *schema.ResourceData) error {
+	groups := d.Get("group").(*schema.Set).List()
+	if len(groups) > 1 {
+		return fmt.Errorf("a team can only be connected to one external group, got %d groups", len(groups))
+	}
+
+	if len(groups) == 0 {
+		_, err := client.Teams.RemoveConnectedExternalGroup(ctx, orgName, slug)
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	groupID, err := strconv.ParseInt(groups[0].(map[string]any)["group_id"].(string), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse the ID of the external group: %w", err)
+	}
+	_, _, err = client.Teams.UpdateConnectedExternalGroup(ctx, orgName, slug, &github.ExternalGroup{
+		GroupID: github.Ptr(groupID),
+	})
+	return err
+}
+
 func flattenGithubIDPGroupList(idpGroupList *github.IDPGroupList) ([]any, error) {
 	if idpGroupList == nil {
 		return make([]any, 0), nil
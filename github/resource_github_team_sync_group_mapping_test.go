@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/google/go-github/v74/github"
@@ -144,6 +145,53 @@ func TestAccGithubTeamSyncGroupMapping_empty(t *testing.T) {
 	})
 }
 
+func TestAccGithubTeamSyncGroupMapping_externalGroup(t *testing.T) {
+	groupID := os.Getenv("GITHUB_TEST_EXTERNAL_GROUP_ID")
+	if groupID == "" {
+		t.Skip("Skipping because `GITHUB_TEST_EXTERNAL_GROUP_ID` is not set")
+	}
+	teamName := acctest.RandomWithPrefix("tf-acc-test-%s")
+	rn := "github_team_sync_group_mapping.test_mapping"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_team" "test_team" {
+  name        = "%s"
+  description = "Terraform acc test group"
+}
+
+resource "github_team_sync_group_mapping" "test_mapping" {
+  team_slug     = github_team.test_team.slug
+  provider_type = "external_group"
+
+  group {
+    group_id          = "%s"
+    group_name        = data.github_external_groups.test.external_groups[index(data.github_external_groups.test.external_groups.*.group_id, %[2]s)].group_name
+    group_description = "Terraform acc test external group"
+  }
+}
+
+data "github_external_groups" "test" {}
+`, teamName, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "group.#", "1"),
+					resource.TestCheckResourceAttr(rn, "group.0.group_id", groupID),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: testAccGithubTeamSyncGroupMappingExternalGroupImportStateIdFunc(rn),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGithubTeamSyncGroupMappingDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*Owner).v3client
 	orgName := testAccProvider.Meta().(*Owner).name
@@ -195,6 +243,17 @@ func testAccGithubTeamSyncGroupMappingImportStateIdFunc(resourceName string) res
 	}
 }
 
+func testAccGithubTeamSyncGroupMappingExternalGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["team_slug"], teamSyncProviderTypeExternalGroup), nil
+	}
+}
+
 func testAccGithubTeamSyncGroupMappingConfig(teamName string) string {
 	return fmt.Sprintf(`
 data "github_organization_team_sync_groups" "test_groups" {}
@@ -0,0 +1,260 @@
+package github
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubUserInvitationsAccepter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Accepts the pending repository and organization invitations of the authenticated user matching a filter, e.g. for machine users.",
+		Create:      resourceGithubUserInvitationsAccepterCreateOrUpdate,
+		Read:        resourceGithubUserInvitationsAccepterRead,
+		Update:      resourceGithubUserInvitationsAccepterCreateOrUpdate,
+		Delete:      resourceGithubUserInvitationsAccepterDelete,
+
+		CustomizeDiff: resourceGithubUserInvitationsAccepterDiff,
+
+		Schema: map[string]*schema.Schema{
+			"owners": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: toDiagFunc(validation.StringIsNotWhiteSpace, "owners"),
+				},
+				Set:         schema.HashString,
+				Description: "The users or organizations whose invitations are accepted. The invitations of other owners are left pending.",
+			},
+			"repository_name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: toDiagFunc(validation.StringIsValidRegExp, "repository_name_regex"),
+				Description:      "A regular expression the name of the repository of the repository invitations to accept must match.",
+			},
+			"accept_repository_invitations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to accept the invitations to collaborate on repositories.",
+			},
+			"accept_organization_invitations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to accept the invitations to join organizations.",
+			},
+			"accepted_invitations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The invitations accepted by the last apply.",
+				Elem:        userInvitationResource(),
+			},
+			"pending_invitations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pending invitations matching the filter, as of the last refresh. They are accepted by the next apply.",
+				Elem:        userInvitationResource(),
+			},
+		},
+	}
+}
+
+func userInvitationResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the invitation, 'repository' or 'organization'.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the repository or the login of the organization.",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The permission on the repository or the role in the organization.",
+			},
+		},
+	}
+}
+
+// userInvitation is a pending repository or organization invitation of the
+// authenticated user.
+type userInvitation struct {
+	repositoryInvitation *github.RepositoryInvitation
+	organization         string
+	role                 string
+}
+
+func (invitation *userInvitation) flatten() map[string]any {
+	if invitation.repositoryInvitation != nil {
+		return map[string]any{
+			"type":       "repository",
+			"name":       invitation.repositoryInvitation.GetRepo().GetFullName(),
+			"permission": invitation.repositoryInvitation.GetPermissions(),
+		}
+	}
+	return map[string]any{
+		"type":       "organization",
+		"name":       invitation.organization,
+		"permission": invitation.role,
+	}
+}
+
+// matchUserInvitation returns whether an invitation to the repository of the
+// owner, or to the organization if repoName is empty, matches the filter. The
+// invitations of owners not listed never match.
+func matchUserInvitation(owner, repoName string, owners []string, repoNameRegex *regexp.Regexp) bool {
+	found := false
+	for _, o := range owners {
+		if strings.EqualFold(o, owner) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	if repoName != "" && repoNameRegex != nil && !repoNameRegex.MatchString(repoName) {
+		return false
+	}
+	return true
+}
+
+func listGithubUserInvitations(ctx context.Context, d *schema.ResourceData, client *github.Client) ([]*userInvitation, error) {
+	owners := expandStringList(d.Get("owners").(*schema.Set).List())
+	var repoNameRegex *regexp.Regexp
+	if v, ok := d.GetOk("repository_name_regex"); ok {
+		repoNameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var invitations []*userInvitation
+
+	if d.Get("accept_repository_invitations").(bool) {
+		options := &github.ListOptions{PerPage: maxPerPage}
+		for {
+			result, resp, err := client.Users.ListInvitations(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			for _, invitation := range result {
+				if invitation.GetExpired() {
+					continue
+				}
+				repo := invitation.GetRepo()
+				if matchUserInvitation(repo.GetOwner().GetLogin(), repo.GetName(), owners, repoNameRegex) {
+					invitations = append(invitations, &userInvitation{repositoryInvitation: invitation})
+				}
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			options.Page = resp.NextPage
+		}
+	}
+
+	if d.Get("accept_organization_invitations").(bool) {
+		options := &github.ListOrgMembershipsOptions{State: "pending", ListOptions: github.ListOptions{PerPage: maxPerPage}}
+		for {
+			result, resp, err := client.Organizations.ListOrgMemberships(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			for _, membership := range result {
+				org := membership.GetOrganization().GetLogin()
+				if matchUserInvitation(org, "", owners, nil) {
+					invitations = append(invitations, &userInvitation{organization: org, role: membership.GetRole()})
+				}
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			options.Page = resp.NextPage
+		}
+	}
+
+	return invitations, nil
+}
+
+func resourceGithubUserInvitationsAccepterCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	invitations, err := listGithubUserInvitations(ctx, d, client)
+	if err != nil {
+		return err
+	}
+
+	accepted := make([]any, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.repositoryInvitation != nil {
+			log.Printf("[INFO] Accepting the invitation of %s to repository %s", user.GetLogin(), invitation.repositoryInvitation.GetRepo().GetFullName())
+			if _, err := client.Users.AcceptInvitation(ctx, invitation.repositoryInvitation.GetID()); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[INFO] Accepting the invitation of %s to organization %s", user.GetLogin(), invitation.organization)
+			if _, _, err := client.Organizations.EditOrgMembership(ctx, "", invitation.organization, &github.Membership{State: github.Ptr("active")}); err != nil {
+				return err
+			}
+		}
+		accepted = append(accepted, invitation.flatten())
+	}
+
+	d.SetId(user.GetLogin())
+	if err = d.Set("accepted_invitations", accepted); err != nil {
+		return err
+	}
+
+	return resourceGithubUserInvitationsAccepterRead(d, meta)
+}
+
+func resourceGithubUserInvitationsAccepterRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	invitations, err := listGithubUserInvitations(ctx, d, client)
+	if err != nil {
+		return err
+	}
+
+	pending := make([]any, 0, len(invitations))
+	for _, invitation := range invitations {
+		pending = append(pending, invitation.flatten())
+	}
+	if err = d.Set("pending_invitations", pending); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubUserInvitationsAccepterDelete(d *schema.ResourceData, meta any) error {
+	// Accepted invitations cannot be reverted, destroying the resource only
+	// stops accepting them.
+	log.Printf("[INFO] Removing user invitations accepter %s from state, the accepted invitations are left unchanged", d.Id())
+	return nil
+}
+
+// resourceGithubUserInvitationsAccepterDiff plans an update when the last
+// refresh found invitations from the configured owners, so that they are
+// accepted.
+func resourceGithubUserInvitationsAccepterDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	return planRefreshedDrift(diff, "pending_invitations", "accepted_invitations")
+}
@@ -0,0 +1,131 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMatchUserInvitation(t *testing.T) {
+	testCases := []struct {
+		owner       string
+		repoName    string
+		owners      []string
+		regex       *regexp.Regexp
+		matches     bool
+		description string
+	}{
+		{
+			owner:       "octo-org",
+			repoName:    "octo-repo",
+			matches:     false,
+			description: "no owners",
+		},
+		{
+			owner:       "Octo-Org",
+			repoName:    "octo-repo",
+			owners:      []string{"octo-org"},
+			matches:     true,
+			description: "owner is case insensitive",
+		},
+		{
+			owner:       "other-org",
+			repoName:    "octo-repo",
+			owners:      []string{"octo-org"},
+			matches:     false,
+			description: "other owner",
+		},
+		{
+			owner:       "octo-org",
+			repoName:    "octo-repo",
+			owners:      []string{"octo-org"},
+			regex:       regexp.MustCompile("^infra-"),
+			matches:     false,
+			description: "repository name not matching",
+		},
+		{
+			owner:       "octo-org",
+			repoName:    "infra-live",
+			owners:      []string{"octo-org"},
+			regex:       regexp.MustCompile("^infra-"),
+			matches:     true,
+			description: "repository name matching",
+		},
+		{
+			owner:       "octo-org",
+			owners:      []string{"octo-org"},
+			regex:       regexp.MustCompile("^infra-"),
+			matches:     true,
+			description: "organization invitation ignores the repository name",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if matches := matchUserInvitation(tc.owner, tc.repoName, tc.owners, tc.regex); matches != tc.matches {
+				t.Errorf("invitation to %s/%s: expected match=%v, got %v", tc.owner, tc.repoName, tc.matches, matches)
+			}
+		})
+	}
+}
+
+func TestAccGithubUserInvitationsAccepter(t *testing.T) {
+	inviteeToken := os.Getenv("GITHUB_TEST_COLLABORATOR_TOKEN")
+	if inviteeToken == "" {
+		t.Skip("GITHUB_TEST_COLLABORATOR_TOKEN was not provided, skipping test")
+	}
+
+	repoName := fmt.Sprintf("tf-acc-test-collab-%s", acctest.RandString(5))
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "github" {
+  alias = "main"
+}
+
+provider "github" {
+  alias = "invitee"
+  token = "%s"
+}
+
+resource "github_repository" "test" {
+  provider = github.main
+  name     = "%s"
+}
+
+resource "github_repository_collaborator" "test" {
+  provider   = github.main
+  repository = github_repository.test.name
+  username   = "%s"
+  permission = "push"
+}
+
+resource "github_user_invitations_accepter" "test" {
+  provider              = github.invitee
+  repository_name_regex = "^tf-acc-test-collab-"
+
+  depends_on = [github_repository_collaborator.test]
+}
+`, inviteeToken, repoName, testCollaborator),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("github_user_invitations_accepter.test", "accepted_invitations.*", map[string]string{
+						"type":       "repository",
+						"permission": "write",
+					}),
+					resource.TestCheckResourceAttr("github_user_invitations_accepter.test", "pending_invitations.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubWorkflowRepositoryPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the default permissions of the GITHUB_TOKEN of the workflows of a GitHub repository",
+		Create:      resourceGithubWorkflowRepositoryPermissionsCreateOrUpdate,
+		Read:        resourceGithubWorkflowRepositoryPermissionsRead,
+		Update:      resourceGithubWorkflowRepositoryPermissionsCreateOrUpdate,
+		Delete:      resourceGithubWorkflowRepositoryPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The GitHub repository.",
+				ValidateDiagFunc: toDiagFunc(validation.StringLenBetween(1, 100), "repository"),
+			},
+			"default_workflow_permissions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "read",
+				Description:      "The default permissions granted to the GITHUB_TOKEN when running workflows. Can be one of: 'read' or 'write'.",
+				ValidateDiagFunc: toDiagFunc(validation.StringInSlice([]string{"read", "write"}, false), "default_workflow_permissions"),
+			},
+			"can_approve_pull_request_reviews": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether GitHub Actions can approve pull requests. Enabling this can be a security risk.",
+			},
+		},
+	}
+}
+
+func resourceGithubWorkflowRepositoryPermissionsCreateOrUpdate(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	ctx := context.Background()
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	_, _, err := client.Repositories.EditDefaultWorkflowPermissions(ctx, owner, repoName, github.DefaultWorkflowPermissionRepository{
+		DefaultWorkflowPermissions:   github.Ptr(d.Get("default_workflow_permissions").(string)),
+		CanApprovePullRequestReviews: github.Ptr(d.Get("can_approve_pull_request_reviews").(bool)),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+	return resourceGithubWorkflowRepositoryPermissionsRead(d, meta)
+}
+
+func resourceGithubWorkflowRepositoryPermissionsRead(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	permissions, _, err := client.Repositories.GetDefaultWorkflowPermissions(ctx, owner, repoName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "workflow permissions of repository %s", repoName)
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return err
+	}
+	if err = d.Set("default_workflow_permissions", permissions.GetDefaultWorkflowPermissions()); err != nil {
+		return err
+	}
+	if err = d.Set("can_approve_pull_request_reviews", permissions.GetCanApprovePullRequestReviews()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubWorkflowRepositoryPermissionsDelete(d *schema.ResourceData, meta any) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	// Reset the repo to the restricted defaults of new repositories
+	_, _, err := client.Repositories.EditDefaultWorkflowPermissions(ctx, owner, repoName, github.DefaultWorkflowPermissionRepository{
+		DefaultWorkflowPermissions:   github.Ptr("read"),
+		CanApprovePullRequestReviews: github.Ptr(false),
+	})
+	return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "workflow permissions of repository %s", repoName)
+}
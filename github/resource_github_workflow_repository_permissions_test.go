@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGithubWorkflowRepositoryPermissions(t *testing.T) {
+
+	t.Run("manages the default workflow permissions of a repository", func(t *testing.T) {
+
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name        = "tf-acc-test-%[1]s"
+				description = "Terraform acceptance tests %[1]s"
+			}
+
+			resource "github_workflow_repository_permissions" "test" {
+				repository                       = github_repository.test.name
+				default_workflow_permissions     = "%[2]s"
+				can_approve_pull_request_reviews = %[3]t
+			}
+		`, randomID, "write", true)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("github_workflow_repository_permissions.test", "default_workflow_permissions", "write"),
+							resource.TestCheckResourceAttr("github_workflow_repository_permissions.test", "can_approve_pull_request_reviews", "true"),
+						),
+					},
+					{
+						ResourceName:      "github_workflow_repository_permissions.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -1,13 +1,224 @@
 package github
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"path"
 	"reflect"
+	"slices"
 	"sort"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// pushRulesetsMinimumEnterpriseVersion is the first GitHub Enterprise Server
+// release supporting rulesets with target `push`.
+var pushRulesetsMinimumEnterpriseVersion = version.Must(version.NewVersion("3.15"))
+
+// The bypass actor types of enterprise managed users, not defined by go-github.
+const (
+	bypassActorTypeEnterpriseOwner github.BypassActorType = "EnterpriseOwner"
+	bypassActorTypeEnterpriseTeam  github.BypassActorType = "EnterpriseTeam"
+)
+
+// bypassActorTypes are the valid `actor_type` of the bypass actors of rulesets.
+var bypassActorTypes = []string{
+	string(github.BypassActorTypeRepositoryRole),
+	string(github.BypassActorTypeTeam),
+	string(github.BypassActorTypeIntegration),
+	string(github.BypassActorTypeOrganizationAdmin),
+	string(github.BypassActorTypeDeployKey),
+	string(bypassActorTypeEnterpriseOwner),
+	string(bypassActorTypeEnterpriseTeam),
+}
+
+// pushRules are the rules that only apply to rulesets with target `push`.
+var pushRules = []string{"file_path_restriction", "max_file_size", "max_file_path_length", "file_extension_restriction"}
+
+// customizeDiffPushRuleset validates the push rules of a ruleset and, for
+// rulesets with target `push`, that the connected instance supports them, so
+// that push rulesets activate automatically once GitHub ships them.
+func customizeDiffPushRuleset(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	target := d.Get("target").(string)
+
+	// Push rulesets apply to every push of the targeted repositories, they
+	// cannot be limited to refs.
+	_, hasConditions := d.GetOk("conditions")
+	_, hasRefName := d.GetOk("conditions.0.ref_name")
+	if target == string(github.RulesetTargetPush) && hasRefName {
+		return fmt.Errorf("conditions.ref_name is not supported for rulesets with target `push`")
+	}
+	if target != string(github.RulesetTargetPush) && hasConditions && !hasRefName {
+		return fmt.Errorf("conditions.ref_name is required for rulesets with target `%s`", target)
+	}
+
+	if target != string(github.RulesetTargetPush) {
+		for _, rule := range pushRules {
+			if v, ok := d.GetOk(fmt.Sprintf("rules.0.%s", rule)); ok && len(v.([]any)) > 0 {
+				return fmt.Errorf("rule %s only applies to rulesets with target `push`", rule)
+			}
+		}
+		return nil
+	}
+
+	if meta == nil || !d.HasChange("target") {
+		return nil
+	}
+
+	enterpriseVersion, err := meta.(*Owner).EnterpriseVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("could not detect whether push rulesets are supported: %w", err)
+	}
+	if enterpriseVersion == "" {
+		return nil
+	}
+
+	v, err := version.NewVersion(enterpriseVersion)
+	if err != nil {
+		return fmt.Errorf("could not parse GitHub Enterprise Server version %q: %w", enterpriseVersion, err)
+	}
+	if v.LessThan(pushRulesetsMinimumEnterpriseVersion) {
+		return fmt.Errorf("rulesets with target `push` require GitHub Enterprise Server %s or later, connected instance runs %s",
+			pushRulesetsMinimumEnterpriseVersion, enterpriseVersion)
+	}
+
+	return nil
+}
+
+// requiredDeploymentEnvironmentsKey is the environments of the
+// required_deployments rule of repository rulesets.
+const requiredDeploymentEnvironmentsKey = "rules.0.required_deployments.0.required_deployment_environments"
+
+// requiredDeploymentEnvironmentsWarnings warns about the environments required
+// by the required_deployments rule of a repository ruleset which do not exist
+// in the repository, as no deployment to them can then succeed and the rule
+// blocks the matching refs. Environments may be created after the ruleset, so
+// they are not errors, and the check is skipped if the environments cannot be
+// listed.
+func requiredDeploymentEnvironmentsWarnings(ctx context.Context, meta any, repo string, environments []any) diag.Diagnostics {
+	if len(environments) == 0 {
+		return nil
+	}
+
+	missing, err := missingRepositoryEnvironments(ctx, meta, repo, expandStringList(environments))
+	if err != nil {
+		log.Printf("[WARN] Unable to check the required deployment environments of %s: %s", repo, err)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, env := range missing {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Required deployment environment %q not found", env),
+			Detail: fmt.Sprintf("The environment %q required by the required_deployments rule does not exist in repository %s, "+
+				"refs matching the ruleset cannot be merged until it is created and deployed to.", env, repo),
+		})
+	}
+	return diags
+}
+
+// requiredCodeScanningToolsKey is the tools of the required_code_scanning rule
+// of repository rulesets.
+const requiredCodeScanningToolsKey = "rules.0.required_code_scanning.0.required_code_scanning_tool"
+
+// requiredCodeScanningToolsWarnings warns about the tools required by the
+// required_code_scanning rule of a repository ruleset which are not enabled
+// in the repository, as they never provide results and the rule silently
+// blocks the matching refs. The check is skipped if the code scanning
+// analyses cannot be listed.
+func requiredCodeScanningToolsWarnings(ctx context.Context, meta any, repo string, tools *schema.Set) diag.Diagnostics {
+	if tools == nil || tools.Len() == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, tools.Len())
+	for _, tool := range tools.List() {
+		names = append(names, tool.(map[string]any)["tool"].(string))
+	}
+	sort.Strings(names)
+
+	missing, err := missingRepositoryCodeScanningTools(ctx, meta, repo, names)
+	if err != nil {
+		log.Printf("[WARN] Unable to check the required code scanning tools of %s: %s", repo, err)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, tool := range missing {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Required code scanning tool %q not enabled", tool),
+			Detail: fmt.Sprintf("The code scanning tool %q required by the required_code_scanning rule has no analysis in repository %s, "+
+				"refs matching the ruleset cannot be merged until it is enabled and provides results.", tool, repo),
+		})
+	}
+	return diags
+}
+
+// validateEnvironmentPattern validates the required deployment environments,
+// which may be patterns matched with path.Match.
+func validateEnvironmentPattern(v any, k string) (ws []string, errs []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid pattern: %s", k, err))
+	}
+	return
+}
+
+// expandRulesetRequiredDeploymentEnvironments replaces the patterns of the
+// required deployment environments of a ruleset with the environments of the
+// repository they match. The environments are listed afresh, as they may have
+// been created earlier in the same apply.
+func expandRulesetRequiredDeploymentEnvironments(ctx context.Context, meta any, repo string, ruleset *github.RepositoryRuleset) error {
+	if ruleset.Rules == nil || ruleset.Rules.RequiredDeployments == nil ||
+		!slices.ContainsFunc(ruleset.Rules.RequiredDeployments.RequiredDeploymentEnvironments, isEnvironmentPattern) {
+		return nil
+	}
+
+	names, err := listRepositoryEnvironmentNames(ctx, meta, repo)
+	if err != nil {
+		return fmt.Errorf("error expanding the required deployment environments: %w", err)
+	}
+	environments := ruleset.Rules.RequiredDeployments.RequiredDeploymentEnvironments
+	ruleset.Rules.RequiredDeployments.RequiredDeploymentEnvironments = expandEnvironmentPatterns(environments, names)
+	log.Printf("[DEBUG] Expanded the required deployment environments %v of %s to %v",
+		environments, repo, ruleset.Rules.RequiredDeployments.RequiredDeploymentEnvironments)
+	return nil
+}
+
+// keepRequiredDeploymentEnvironmentPatterns keeps the configured patterns of
+// the required deployment environments in the flattened rules of a ruleset as
+// long as they expand to the environments required by the ruleset. When an
+// environment matching them is created, or the rule is changed outside of
+// Terraform, the environments are kept instead, so that the plan shows the
+// drift and the update expands the patterns again.
+func keepRequiredDeploymentEnvironmentPatterns(ctx context.Context, meta any, repo string, rules []any, patterns []any) {
+	if len(rules) == 0 || !slices.ContainsFunc(expandStringList(patterns), isEnvironmentPattern) {
+		return
+	}
+	requiredDeployments, ok := rules[0].(map[string]any)["required_deployments"].([]map[string]any)
+	if !ok || len(requiredDeployments) == 0 {
+		return
+	}
+
+	names, err := listRepositoryEnvironmentNames(ctx, meta, repo)
+	if err != nil {
+		log.Printf("[WARN] Unable to expand the required deployment environments of %s: %s", repo, err)
+		return
+	}
+	expanded := expandEnvironmentPatterns(expandStringList(patterns), names)
+	environments := slices.Clone(requiredDeployments[0]["required_deployment_environments"].([]string))
+	slices.Sort(expanded)
+	slices.Sort(environments)
+	if slices.Equal(expanded, environments) {
+		requiredDeployments[0]["required_deployment_environments"] = expandStringList(patterns)
+	}
+}
+
 func resourceGithubRulesetObject(d *schema.ResourceData, org string) *github.RepositoryRuleset {
 	isOrgLevel := len(org) > 0
 
@@ -49,6 +260,11 @@ func expandBypassActors(input []any) []*github.BypassActor {
 		if v, ok := inputMap["actor_type"].(string); ok {
 			actorType := github.BypassActorType(v)
 			actor.ActorType = &actorType
+			// The enterprise owners are not a single actor, GitHub expects
+			// no ID for them.
+			if actorType == bypassActorTypeEnterpriseOwner {
+				actor.ActorID = nil
+			}
 		}
 
 		if v, ok := inputMap["bypass_mode"].(string); ok {
@@ -71,6 +287,9 @@ func flattenBypassActors(bypassActors []*github.BypassActor) []any {
 
 		actorMap["actor_id"] = v.GetActorID()
 		actorMap["actor_type"] = v.GetActorType()
+		if v.ActorType != nil && *v.ActorType == bypassActorTypeEnterpriseOwner {
+			actorMap["actor_id"] = int64(1)
+		}
 		actorMap["bypass_mode"] = v.GetBypassMode()
 
 		actorsSlice = append(actorsSlice, actorMap)
@@ -147,6 +366,20 @@ func expandConditions(input []any, org bool) *github.RepositoryRulesetConditions
 			}
 
 			rulesetConditions.RepositoryID = &github.RepositoryRulesetRepositoryIDsConditionParameters{RepositoryIDs: repositoryIDs}
+		} else if v, ok := inputConditions["repository_property"].([]any); ok && v != nil && len(v) != 0 {
+			include := make([]*github.RepositoryRulesetRepositoryPropertyTargetParameters, 0)
+			exclude := make([]*github.RepositoryRulesetRepositoryPropertyTargetParameters, 0)
+
+			if v[0] != nil {
+				inputRepositoryProperty := v[0].(map[string]any)
+				include = expandRepositoryPropertyConditionTargets(inputRepositoryProperty["include"].([]any))
+				exclude = expandRepositoryPropertyConditionTargets(inputRepositoryProperty["exclude"].([]any))
+			}
+
+			rulesetConditions.RepositoryProperty = &github.RepositoryRulesetRepositoryPropertyConditionParameters{
+				Include: include,
+				Exclude: exclude,
+			}
 		}
 	}
 
@@ -154,19 +387,26 @@ func expandConditions(input []any, org bool) *github.RepositoryRulesetConditions
 }
 
 func flattenConditions(conditions *github.RepositoryRulesetConditions, org bool) []any {
-	if conditions == nil || conditions.RefName == nil {
+	if conditions == nil {
+		return []any{}
+	}
+	// Organization push rulesets only have repository conditions
+	if conditions.RefName == nil && (!org || (conditions.RepositoryName == nil && conditions.RepositoryID == nil && conditions.RepositoryProperty == nil)) {
 		return []any{}
 	}
 
 	conditionsMap := make(map[string]any)
-	refNameSlice := make([]map[string]any, 0)
 
-	refNameSlice = append(refNameSlice, map[string]any{
-		"include": conditions.RefName.Include,
-		"exclude": conditions.RefName.Exclude,
-	})
+	if conditions.RefName != nil {
+		refNameSlice := make([]map[string]any, 0)
 
-	conditionsMap["ref_name"] = refNameSlice
+		refNameSlice = append(refNameSlice, map[string]any{
+			"include": conditions.RefName.Include,
+			"exclude": conditions.RefName.Exclude,
+		})
+
+		conditionsMap["ref_name"] = refNameSlice
+	}
 
 	// org-only fields
 	if org {
@@ -190,11 +430,74 @@ func flattenConditions(conditions *github.RepositoryRulesetConditions, org bool)
 		if conditions.RepositoryID != nil {
 			conditionsMap["repository_id"] = conditions.RepositoryID.RepositoryIDs
 		}
+
+		if conditions.RepositoryProperty != nil {
+			conditionsMap["repository_property"] = []map[string]any{
+				{
+					"include": flattenRepositoryPropertyConditionTargets(conditions.RepositoryProperty.Include),
+					"exclude": flattenRepositoryPropertyConditionTargets(conditions.RepositoryProperty.Exclude),
+				},
+			}
+		}
 	}
 
 	return []any{conditionsMap}
 }
 
+func expandRepositoryPropertyConditionTargets(input []any) []*github.RepositoryRulesetRepositoryPropertyTargetParameters {
+	targets := make([]*github.RepositoryRulesetRepositoryPropertyTargetParameters, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		inputTarget := v.(map[string]any)
+
+		propertyValues := make([]string, 0)
+		for _, value := range inputTarget["property_values"].([]any) {
+			if value != nil {
+				propertyValues = append(propertyValues, value.(string))
+			}
+		}
+
+		target := &github.RepositoryRulesetRepositoryPropertyTargetParameters{
+			Name:           inputTarget["name"].(string),
+			PropertyValues: propertyValues,
+		}
+		if source, ok := inputTarget["source"].(string); ok && source != "" {
+			target.Source = github.Ptr(source)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+func flattenRepositoryPropertyConditionTargets(targets []*github.RepositoryRulesetRepositoryPropertyTargetParameters) []map[string]any {
+	targetsSlice := make([]map[string]any, 0)
+
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		// The API omits the source of custom properties
+		source := "custom"
+		if target.Source != nil {
+			source = *target.Source
+		}
+
+		targetsSlice = append(targetsSlice, map[string]any{
+			"name":            target.Name,
+			"property_values": target.PropertyValues,
+			"source":          source,
+		})
+	}
+
+	return targetsSlice
+}
+
 func expandRules(input []any, org bool) *github.RepositoryRulesetRules {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -417,6 +720,35 @@ func expandRules(input []any, org bool) *github.RepositoryRulesetRules {
 		}
 	}
 
+	// Push rules
+	if v, ok := rulesMap["file_path_restriction"].([]any); ok && len(v) != 0 && v[0] != nil {
+		filePathRestrictionMap := v[0].(map[string]any)
+		rules.FilePathRestriction = &github.FilePathRestrictionRuleParameters{
+			RestrictedFilePaths: expandStringList(filePathRestrictionMap["restricted_file_paths"].([]any)),
+		}
+	}
+
+	if v, ok := rulesMap["max_file_size"].([]any); ok && len(v) != 0 && v[0] != nil {
+		maxFileSizeMap := v[0].(map[string]any)
+		rules.MaxFileSize = &github.MaxFileSizeRuleParameters{
+			MaxFileSize: int64(maxFileSizeMap["max_file_size"].(int)),
+		}
+	}
+
+	if v, ok := rulesMap["max_file_path_length"].([]any); ok && len(v) != 0 && v[0] != nil {
+		maxFilePathLengthMap := v[0].(map[string]any)
+		rules.MaxFilePathLength = &github.MaxFilePathLengthRuleParameters{
+			MaxFilePathLength: maxFilePathLengthMap["max_file_path_length"].(int),
+		}
+	}
+
+	if v, ok := rulesMap["file_extension_restriction"].([]any); ok && len(v) != 0 && v[0] != nil {
+		fileExtensionRestrictionMap := v[0].(map[string]any)
+		rules.FileExtensionRestriction = &github.FileExtensionRestrictionRuleParameters{
+			RestrictedFileExtensions: expandStringList(fileExtensionRestrictionMap["restricted_file_extensions"].(*schema.Set).List()),
+		}
+	}
+
 	return rules
 }
 
@@ -457,6 +789,7 @@ func flattenRules(rules *github.RepositoryRulesetRules, org bool) []any {
 	if !org && rules.RequiredDeployments != nil {
 		rule := make(map[string]any)
 		rule["required_deployment_environments"] = rules.RequiredDeployments.RequiredDeploymentEnvironments
+		rule["expanded_environments"] = rules.RequiredDeployments.RequiredDeploymentEnvironments
 		rulesMap["required_deployments"] = []map[string]any{rule}
 	}
 
@@ -589,6 +922,31 @@ func flattenRules(rules *github.RepositoryRulesetRules, org bool) []any {
 		rulesMap["required_code_scanning"] = []map[string]any{rule}
 	}
 
+	// Push rules
+	if rules.FilePathRestriction != nil {
+		rule := make(map[string]any)
+		rule["restricted_file_paths"] = rules.FilePathRestriction.RestrictedFilePaths
+		rulesMap["file_path_restriction"] = []map[string]any{rule}
+	}
+
+	if rules.MaxFileSize != nil {
+		rule := make(map[string]any)
+		rule["max_file_size"] = rules.MaxFileSize.MaxFileSize
+		rulesMap["max_file_size"] = []map[string]any{rule}
+	}
+
+	if rules.MaxFilePathLength != nil {
+		rule := make(map[string]any)
+		rule["max_file_path_length"] = rules.MaxFilePathLength.MaxFilePathLength
+		rulesMap["max_file_path_length"] = []map[string]any{rule}
+	}
+
+	if rules.FileExtensionRestriction != nil {
+		rule := make(map[string]any)
+		rule["restricted_file_extensions"] = rules.FileExtensionRestriction.RestrictedFileExtensions
+		rulesMap["file_extension_restriction"] = []map[string]any{rule}
+	}
+
 	return []any{rulesMap}
 }
 
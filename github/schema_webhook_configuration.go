@@ -19,9 +19,10 @@ func webhookConfigurationSchema() *schema.Schema {
 					Description: "The URL of the webhook.",
 				},
 				"content_type": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The content type for the payload. Valid values are either 'form' or 'json'.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validateValueFunc([]string{"form", "json"}),
+					Description:      "The content type for the payload. Valid values are either 'form' or 'json'.",
 				},
 				"secret": {
 					Type:        schema.TypeString,
@@ -38,3 +39,32 @@ func webhookConfigurationSchema() *schema.Schema {
 		},
 	}
 }
+
+// webhookConfigurationDataSourceSchema is the configuration of a webhook as
+// read by data sources, without its secret.
+func webhookConfigurationDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Configuration of the webhook, without its secret.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL of the webhook.",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The content type for the payload.",
+				},
+				"insecure_ssl": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the SSL certificate of the URL is not verified.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateSkipRefreshPattern validates the resource type patterns of
+// skip_refresh_resources, matched with path.Match.
+func validateSkipRefreshPattern(v any, k string) (ws []string, errs []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid pattern: %s", k, err))
+	}
+	return
+}
+
+// skipsRefresh returns whether the Read of the resource keeps its state as
+// is, when its type matches one of the skip_refresh_resources patterns and it
+// was not imported by this run.
+func (o *Owner) skipsRefresh(resourceType, id string) bool {
+	if _, imported := o.importedResources.Load(resourceType + ":" + id); imported {
+		return false
+	}
+	for _, pattern := range o.skipRefreshResources {
+		if matched, _ := path.Match(pattern, resourceType); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapSkipRefresh makes the Read of a resource a no-op when skipsRefresh, so
+// that Terraform keeps its state during refresh. The Create and Update
+// functions call the wrapped Read directly, so that the resources changed by
+// an apply are always read back. The imported resources are recorded, as they
+// have no state to keep yet.
+func wrapSkipRefresh(resourceType string, r *schema.Resource) {
+	skip := func(d *schema.ResourceData, meta any) bool {
+		owner, ok := meta.(*Owner)
+		if !ok || !owner.skipsRefresh(resourceType, d.Id()) {
+			return false
+		}
+		log.Printf("[DEBUG] Skipping the refresh of %s %s", resourceType, d.Id())
+		return true
+	}
+
+	if read := r.Read; read != nil { //nolint:staticcheck
+		r.Read = func(d *schema.ResourceData, meta any) error { //nolint:staticcheck
+			if skip(d, meta) {
+				return nil
+			}
+			return read(d, meta)
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if skip(d, meta) {
+				return nil
+			}
+			return read(ctx, d, meta)
+		}
+	}
+	if read := r.ReadWithoutTimeout; read != nil {
+		r.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if skip(d, meta) {
+				return nil
+			}
+			return read(ctx, d, meta)
+		}
+	}
+
+	if r.Importer == nil {
+		return
+	}
+	record := func(states []*schema.ResourceData, meta any) {
+		if owner, ok := meta.(*Owner); ok {
+			for _, state := range states {
+				owner.importedResources.Store(resourceType+":"+state.Id(), struct{}{})
+			}
+		}
+	}
+	if importState := r.Importer.State; importState != nil { //nolint:staticcheck
+		r.Importer.State = func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) { //nolint:staticcheck
+			states, err := importState(d, meta)
+			record(states, meta)
+			return states, err
+		}
+	}
+	if importState := r.Importer.StateContext; importState != nil {
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			states, err := importState(ctx, d, meta)
+			record(states, meta)
+			return states, err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWrapSkipRefresh(t *testing.T) {
+	reads := 0
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta any) error {
+			reads++
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	wrapSkipRefresh("github_team_members", r)
+
+	meta := &Owner{skipRefreshResources: []string{"github_repository", "github_team_*"}}
+	d := r.TestResourceData()
+	d.SetId("123")
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 0 {
+		t.Errorf("expected the refresh of a matching resource to be skipped, got %d reads", reads)
+	}
+
+	if _, err := r.Importer.State(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 1 {
+		t.Errorf("expected an imported resource to be read, got %d reads", reads)
+	}
+
+	d.SetId("456")
+	if err := r.Read(d, &Owner{skipRefreshResources: []string{"github_repository"}}); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 2 {
+		t.Errorf("expected a resource not matching any pattern to be read, got %d reads", reads)
+	}
+}
+
+func TestValidateSkipRefreshPattern(t *testing.T) {
+	if _, errs := validateSkipRefreshPattern("github_team_*", "skip_refresh_resources"); len(errs) != 0 {
+		t.Errorf("expected a valid pattern, got %v", errs)
+	}
+	if _, errs := validateSkipRefreshPattern("github_[", "skip_refresh_resources"); len(errs) == 0 {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
@@ -1,36 +1,245 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// Sweepers delete the resources left behind by failed acceptance tests in the
+// owner under test, e.g. to clean up the organization used by a CI pipeline:
+//
+//	make sweep
+//
+// Only the resources whose name starts with one of the sweep prefixes are
+// deleted, see sweepPrefixes.
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
 
+func init() {
+	resource.AddTestSweepers("github_repository", &resource.Sweeper{
+		Name: "github_repository",
+		F:    testSweepRepositories,
+	})
+	resource.AddTestSweepers("github_team", &resource.Sweeper{
+		Name: "github_team",
+		F:    testSweepTeams,
+	})
+	resource.AddTestSweepers("github_organization_ruleset", &resource.Sweeper{
+		Name: "github_organization_ruleset",
+		F:    testSweepOrganizationRulesets,
+	})
+}
+
+// sharedConfigForRegion returns the meta of a provider configured from the
+// environment. The region is the argument of the -sweep flag, it is not
+// relevant to GitHub.
 func sharedConfigForRegion(region string) (any, error) {
 	if os.Getenv("GITHUB_TOKEN") == "" {
 		return nil, fmt.Errorf("empty GITHUB_TOKEN")
 	}
 
-	if os.Getenv("GITHUB_OWNER") == "" {
+	owner := os.Getenv("GITHUB_OWNER")
+	if owner == "" {
+		owner = os.Getenv("GITHUB_ORGANIZATION")
+	}
+	if owner == "" {
 		return nil, fmt.Errorf("empty GITHUB_OWNER")
 	}
 
+	baseURL := os.Getenv("GITHUB_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.github.com/"
+	}
+
 	config := Config{
 		Token:   os.Getenv("GITHUB_TOKEN"),
-		Owner:   os.Getenv("GITHUB_OWNER"),
-		BaseURL: "",
+		Owner:   owner,
+		BaseURL: baseURL,
 	}
 
 	meta, err := config.Meta()
 	if err != nil {
-		return nil, fmt.Errorf("error getting GitHub meta parameter")
+		return nil, fmt.Errorf("error getting GitHub meta parameter: %w", err)
 	}
 
 	return meta, nil
 }
+
+// sweepPrefixes returns the prefixes of the names of the resources to sweep,
+// the comma separated GITHUB_SWEEP_PREFIXES or those used by the acceptance
+// tests.
+func sweepPrefixes() []string {
+	if prefixes := os.Getenv("GITHUB_SWEEP_PREFIXES"); prefixes != "" {
+		return strings.Split(prefixes, ",")
+	}
+	return []string{"tf-acc-", "foo-"}
+}
+
+func isSweepable(name string) bool {
+	for _, prefix := range sweepPrefixes() {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotFoundError(err error) bool {
+	ghErr, ok := err.(*github.ErrorResponse)
+	return ok && ghErr.Response.StatusCode == http.StatusNotFound
+}
+
+func testSweepRepositories(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	ctx := context.Background()
+
+	var names []string
+	if meta.(*Owner).IsOrganization {
+		options := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+		for {
+			repos, resp, err := client.Repositories.ListByOrg(ctx, owner, options)
+			if err != nil {
+				return err
+			}
+			for _, r := range repos {
+				names = append(names, r.GetName())
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			options.Page = resp.NextPage
+		}
+	} else {
+		options := &github.RepositoryListByAuthenticatedUserOptions{Affiliation: "owner", ListOptions: github.ListOptions{PerPage: maxPerPage}}
+		for {
+			repos, resp, err := client.Repositories.ListByAuthenticatedUser(ctx, options)
+			if err != nil {
+				return err
+			}
+			for _, r := range repos {
+				names = append(names, r.GetName())
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			options.Page = resp.NextPage
+		}
+	}
+
+	for _, name := range names {
+		if !isSweepable(name) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Destroying Repository %s", name)
+		if _, err := client.Repositories.Delete(ctx, owner, name); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testSweepTeams(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+	if !meta.(*Owner).IsOrganization {
+		log.Printf("[INFO] Skipping teams sweep, %s is not an organization", meta.(*Owner).name)
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	var slugs []string
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		teams, resp, err := client.Teams.ListTeams(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+		for _, team := range teams {
+			if isSweepable(team.GetName()) || isSweepable(team.GetSlug()) {
+				slugs = append(slugs, team.GetSlug())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	for _, slug := range slugs {
+		log.Printf("[DEBUG] Destroying Team %s", slug)
+		// Child teams are deleted along with their parent.
+		if _, err := client.Teams.DeleteTeamBySlug(ctx, orgName, slug); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testSweepOrganizationRulesets(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+	if !meta.(*Owner).IsOrganization {
+		log.Printf("[INFO] Skipping organization rulesets sweep, %s is not an organization", meta.(*Owner).name)
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	ctx := context.Background()
+
+	var rulesets []*github.RepositoryRuleset
+	options := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		result, resp, err := client.Organizations.GetAllRepositoryRulesets(ctx, orgName, options)
+		if err != nil {
+			return err
+		}
+		rulesets = append(rulesets, result...)
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	for _, ruleset := range rulesets {
+		// Rulesets of repositories are deleted along with them.
+		if ruleset.SourceType != nil && *ruleset.SourceType != github.RulesetSourceTypeOrganization {
+			continue
+		}
+		if !isSweepable(ruleset.Name) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Destroying Organization Ruleset %s (%d)", ruleset.Name, ruleset.GetID())
+		if _, err := client.Organizations.DeleteRepositoryRuleset(ctx, orgName, ruleset.GetID()); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package github
 
 import (
 	"bytes"
+	"container/list"
 	"io"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -43,6 +45,201 @@ func NewEtagTransport(rt http.RoundTripper) *etagTransport {
 	return &etagTransport{transport: rt}
 }
 
+// correlationIDTransport tags every request with a correlation ID, such as the
+// ID of the Terraform run, in the X-Correlation-Id header and the User-Agent,
+// so that the entries of the GitHub audit log can be traced back to the run.
+type correlationIDTransport struct {
+	transport     http.RoundTripper
+	correlationID string
+}
+
+func (cit *correlationIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Correlation-Id", cit.correlationID)
+	req.Header.Set("User-Agent", strings.TrimSpace(req.Header.Get("User-Agent")+" correlation-id/"+cit.correlationID))
+
+	return cit.transport.RoundTrip(req)
+}
+
+func NewCorrelationIDTransport(rt http.RoundTripper, correlationID string) *correlationIDTransport {
+	return &correlationIDTransport{transport: rt, correlationID: correlationID}
+}
+
+// defaultEtagCacheSize is the number of responses kept by the etag cache.
+const defaultEtagCacheSize = 1000
+
+// EtagCacheTransport is a read-through cache of GET responses shared by all
+// resources and data sources of a provider. Cached responses are revalidated
+// with a conditional request, which does not count against the rate limit
+// when the object is unchanged.
+type EtagCacheTransport struct {
+	transport http.RoundTripper
+	size      int
+
+	m       sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type etagCacheEntry struct {
+	key    string
+	path   string
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+func NewEtagCacheTransport(rt http.RoundTripper, size int) *EtagCacheTransport {
+	return &EtagCacheTransport{
+		transport: rt,
+		size:      size,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+}
+
+func (ect *EtagCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		resp, err := ect.transport.RoundTrip(req)
+		if err == nil && isWriteMethod(req.Method) {
+			ect.invalidate(req.URL.Path)
+		}
+		return resp, err
+	}
+
+	// Requests carrying their own etag expect a 304 when unchanged.
+	if req.Header.Get("If-None-Match") != "" {
+		return ect.transport.RoundTrip(req)
+	}
+
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+	entry := ect.get(key)
+	if entry != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := ect.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		log.Printf("[DEBUG] Serving %s from the etag cache", req.URL.Path)
+		header := entry.header.Clone()
+		// Keep the rate limit headers of the conditional request up to date.
+		for name, values := range resp.Header {
+			header[name] = values
+		}
+		resp.Body.Close()
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       req,
+		}, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	ect.put(&etagCacheEntry{
+		key:    key,
+		path:   req.URL.Path,
+		etag:   etag,
+		header: resp.Header.Clone(),
+		body:   body,
+	})
+
+	return resp, nil
+}
+
+func (ect *EtagCacheTransport) get(key string) *etagCacheEntry {
+	ect.m.Lock()
+	defer ect.m.Unlock()
+
+	e, ok := ect.entries[key]
+	if !ok {
+		return nil
+	}
+	ect.lru.MoveToFront(e)
+	return e.Value.(*etagCacheEntry)
+}
+
+func (ect *EtagCacheTransport) put(entry *etagCacheEntry) {
+	ect.m.Lock()
+	defer ect.m.Unlock()
+
+	if e, ok := ect.entries[entry.key]; ok {
+		e.Value = entry
+		ect.lru.MoveToFront(e)
+		return
+	}
+
+	ect.entries[entry.key] = ect.lru.PushFront(entry)
+	for ect.lru.Len() > ect.size {
+		oldest := ect.lru.Back()
+		ect.lru.Remove(oldest)
+		delete(ect.entries, oldest.Value.(*etagCacheEntry).key)
+	}
+}
+
+// invalidate drops the cached responses of path and the paths below it, as
+// the etags of some objects do not change with every write, and those of the
+// paths above it, which list the objects created, changed or deleted.
+func (ect *EtagCacheTransport) invalidate(path string) {
+	ect.m.Lock()
+	defer ect.m.Unlock()
+
+	path = strings.TrimSuffix(path, "/")
+	for key, e := range ect.entries {
+		p := e.Value.(*etagCacheEntry).path
+		if p == path || strings.HasPrefix(p, path+"/") || strings.HasPrefix(path, p+"/") {
+			ect.lru.Remove(e)
+			delete(ect.entries, key)
+		}
+	}
+}
+
+// ConcurrencyLimitTransport bounds the number of API calls in flight across
+// all resources and data sources of a provider, independently of the
+// parallelism of Terraform, to avoid secondary rate limits.
+type ConcurrencyLimitTransport struct {
+	transport http.RoundTripper
+	semaphore chan struct{}
+}
+
+func (clt *ConcurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case clt.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-clt.semaphore }()
+
+	return clt.transport.RoundTrip(req)
+}
+
+// NewConcurrencyLimitTransport returns a transport making at most
+// maxConcurrentRequests API calls at a time.
+func NewConcurrencyLimitTransport(rt http.RoundTripper, maxConcurrentRequests int) *ConcurrencyLimitTransport {
+	return &ConcurrencyLimitTransport{transport: rt, semaphore: make(chan struct{}, maxConcurrentRequests)}
+}
+
 // RateLimitTransport implements GitHub's best practices
 // for avoiding rate limits
 // https://developer.github.com/v3/guides/best-practices-for-integrators/#dealing-with-abuse-rate-limits
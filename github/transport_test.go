@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
@@ -46,6 +47,167 @@ func TestEtagTransport(t *testing.T) {
 	}
 }
 
+func TestCorrelationIDTransport(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ExpectedHeaders: map[string]string{
+				"X-Correlation-Id": "run-1",
+				"User-Agent":       fmt.Sprintf("go-github/%s correlation-id/run-1", github.Version),
+			},
+
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewCorrelationIDTransport(http.DefaultTransport, "run-1")}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	r, _, err := client.Repositories.Get(context.Background(), "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetID() != 1234 {
+		t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+	}
+}
+
+func TestEtagCacheTransport(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ExpectedHeaders: map[string]string{
+				"If-None-Match": "",
+			},
+
+			ResponseBody: `{"id": 1234}`,
+			ResponseHeaders: map[string]string{
+				"ETag": `"abc"`,
+			},
+			StatusCode: 200,
+		},
+		{
+			ExpectedUri: "/repos/test/blah",
+			ExpectedHeaders: map[string]string{
+				"If-None-Match": `"abc"`,
+			},
+
+			StatusCode: 304,
+		},
+		{
+			ExpectedUri:    "/repos/test/blah",
+			ExpectedMethod: "PATCH",
+
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri: "/repos/test/blah",
+			ExpectedHeaders: map[string]string{
+				"If-None-Match": "",
+			},
+
+			ResponseBody: `{"id": 1234, "name": "blah"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewEtagCacheTransport(http.DefaultTransport, defaultEtagCacheSize)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		r, _, err := client.Repositories.Get(ctx, "test", "blah")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.GetID() != 1234 {
+			t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+		}
+	}
+
+	// Writes invalidate the cached responses
+	_, _, err := client.Repositories.Edit(ctx, "test", "blah", &github.Repository{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetName() != "blah" {
+		t.Fatalf("Expected name to be blah, got: %q", r.GetName())
+	}
+}
+
+func TestEtagCacheTransport_invalidatesLists(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah/labels",
+			ExpectedHeaders: map[string]string{
+				"If-None-Match": "",
+			},
+
+			ResponseBody: `[{"name": "bug"}]`,
+			ResponseHeaders: map[string]string{
+				"ETag": `"abc"`,
+			},
+			StatusCode: 200,
+		},
+		{
+			ExpectedUri:    "/repos/test/blah/labels/bug",
+			ExpectedMethod: "DELETE",
+
+			StatusCode: 204,
+		},
+		{
+			ExpectedUri: "/repos/test/blah/labels",
+			ExpectedHeaders: map[string]string{
+				"If-None-Match": "",
+			},
+
+			ResponseBody: `[]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewEtagCacheTransport(http.DefaultTransport, defaultEtagCacheSize)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.ListLabels(ctx, "test", "blah", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleting an object invalidates the cached lists containing it
+	if _, err := client.Issues.DeleteLabel(ctx, "test", "blah", "bug"); err != nil {
+		t.Fatal(err)
+	}
+
+	labels, _, err := client.Issues.ListLabels(ctx, "test", "blah", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("Expected no labels, got: %d", len(labels))
+	}
+}
+
 func githubApiMock(responseSequence []*mockResponse) *httptest.Server {
 	position := github.Ptr(0)
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -342,6 +504,48 @@ func TestRateLimitTransport_smart_lock(t *testing.T) {
 	})
 }
 
+func TestConcurrencyLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight int
+	var m sync.Mutex
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		m.Unlock()
+
+		<-release
+
+		m.Lock()
+		inFlight--
+		m.Unlock()
+	})
+
+	client := &http.Client{Transport: NewConcurrencyLimitTransport(localRoundTripper{handler: handler}, 2)}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("https://api.github.com/")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got: %d", maxInFlight)
+	}
+}
+
 func TestRetryTransport_retry_post_error(t *testing.T) {
 	ts := githubApiMock([]*mockResponse{
 		{
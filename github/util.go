@@ -3,30 +3,55 @@ package github
 import (
 	"context"
 	"crypto/md5"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"regexp"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/nacl/box"
 )
 
 const (
 	// https://developer.github.com/guides/traversing-with-pagination/#basics-of-pagination
 	maxPerPage = 100
+
+	// submittedTooQuicklyMaxRetries is the number of times the creation of an
+	// object is retried when GitHub rejects it for being submitted too quickly.
+	submittedTooQuicklyMaxRetries = 5
 )
 
+// submittedTooQuicklyBaseDelay is the delay before the first retry of a
+// creation submitted too quickly, doubled on each retry.
+var submittedTooQuicklyBaseDelay = 2 * time.Second
+
+// organizationRequiredError is the error of the resources and data sources
+// which only apply to organizations, when the owner of the provider is a user.
+type organizationRequiredError struct {
+	owner string
+}
+
+func (e *organizationRequiredError) Error() string {
+	return fmt.Sprintf("this resource can only be used in the context of an organization, %q is a user: "+
+		"set the owner of the provider to an organization", e.owner)
+}
+
+// checkOrganization returns an *organizationRequiredError unless the owner of
+// the provider is an organization.
 func checkOrganization(meta any) error {
 	if !meta.(*Owner).IsOrganization {
-		return fmt.Errorf("this resource can only be used in the context of an organization, %q is a user", meta.(*Owner).name)
+		return &organizationRequiredError{owner: meta.(*Owner).name}
 	}
 
 	return nil
@@ -258,6 +283,123 @@ func validateSecretNameFunc(v any, path cty.Path) diag.Diagnostics {
 	return wrapErrors(errs)
 }
 
+const (
+	// https://docs.github.com/en/actions/security-for-github-actions/security-guides/using-secrets-in-github-actions#limits-for-secrets
+	maxSecretValueSize = 48 * 1024
+
+	// https://docs.github.com/en/actions/writing-workflows/choosing-what-your-workflow-does/store-information-in-variables#limits-for-configuration-variables
+	maxVariableValueSize = 48 * 1024
+)
+
+// validateSecretValueFunc validates the size of the plaintext value of a
+// secret, which GitHub otherwise only rejects once it is encrypted.
+func validateSecretValueFunc(v any, path cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+	if !ok {
+		return wrapErrors([]error{fmt.Errorf("expected type of %s to be string", path)})
+	}
+
+	if len(value) > maxSecretValueSize {
+		return wrapErrors([]error{fmt.Errorf("secret values are limited to %d bytes, got %d", maxSecretValueSize, len(value))})
+	}
+
+	return nil
+}
+
+// validateEncryptedSecretValueFunc validates that the encrypted value of a
+// secret is base64 encoded and that the value it encrypts is within the size
+// limit of secrets.
+func validateEncryptedSecretValueFunc(v any, path cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+	if !ok {
+		return wrapErrors([]error{fmt.Errorf("expected type of %s to be string", path)})
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return wrapErrors([]error{fmt.Errorf("expected %s to be a base64 encoded string", path)})
+	}
+
+	// Values are encrypted in anonymous sealed boxes, which add a fixed
+	// number of bytes to them.
+	if size := len(encrypted) - box.AnonymousOverhead; size > maxSecretValueSize {
+		return wrapErrors([]error{fmt.Errorf("secret values are limited to %d bytes, got %d", maxSecretValueSize, size)})
+	}
+
+	return nil
+}
+
+// organizationSecretValue returns the encrypted value to send when writing an
+// organization secret, or "" to leave the value out of an update. GitHub does
+// not return the values of secrets, so an update only sends the value when it
+// can be encrypted with the current public key of the organization: from
+// plaintext_value, or from an encrypted_value whose key_id is the current one.
+// Otherwise, e.g. for an imported secret or after the key was rotated, GitHub
+// keeps the value and only the access to the secret is updated.
+func organizationSecretValue(d *schema.ResourceData, keyID, publicKey string) (string, error) {
+	if encryptedValue, ok := d.GetOk("encrypted_value"); ok {
+		if !d.IsNewResource() && d.Get("key_id").(string) != keyID {
+			return "", nil
+		}
+		return encryptedValue.(string), nil
+	}
+
+	plaintextValue := d.Get("plaintext_value").(string)
+	if !d.IsNewResource() && plaintextValue == "" {
+		return "", nil
+	}
+	encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encryptedBytes), nil
+}
+
+// updateOrganizationSecretAccess updates the visibility and the selected
+// repositories of an organization secret of the given API, e.g. "actions",
+// without sending its value, which go-github always sends.
+func updateOrganizationSecretAccess(ctx context.Context, meta any, api, secretName, visibility string, selectedRepositoryIDs []int64) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	req, err := client.NewRequest("PUT", fmt.Sprintf("orgs/%s/%s/secrets/%s", owner, api, secretName), &struct {
+		Visibility            string  `json:"visibility"`
+		SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+	}{visibility, selectedRepositoryIDs})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// recordOrganizationSecretWrite records the key the value of an organization
+// secret was sent encrypted with, if it was sent, and clears its update date,
+// so that the read following the write records the new date instead of
+// mistaking it for an external update.
+func recordOrganizationSecretWrite(d *schema.ResourceData, keyID, encryptedValue string) error {
+	if encryptedValue != "" {
+		if err := d.Set("key_id", keyID); err != nil {
+			return err
+		}
+	}
+	return d.Set("updated_at", "")
+}
+
+// validateVariableValueFunc validates the size of the value of a variable.
+func validateVariableValueFunc(v any, path cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+	if !ok {
+		return wrapErrors([]error{fmt.Errorf("expected type of %s to be string", path)})
+	}
+
+	if len(value) > maxVariableValueSize {
+		return wrapErrors([]error{fmt.Errorf("variable values are limited to %d bytes, got %d", maxVariableValueSize, len(value))})
+	}
+
+	return nil
+}
+
 // deleteResourceOn404AndSwallow304OtherwiseReturnError will log and delete resource if error is 404 which indicates resource (or any of its ancestors)
 // doesn't exist.
 // resourceDescription represents a formatting string that represents the resource
@@ -276,3 +418,81 @@ func deleteResourceOn404AndSwallow304OtherwiseReturnError(err error, d *schema.R
 	}
 	return err
 }
+
+// isSubmittedTooQuicklyError reports whether err is GitHub rejecting the
+// creation of an object because too many were created in quick succession,
+// e.g. while onboarding many teams or repositories.
+func isSubmittedTooQuicklyError(err error) bool {
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return true
+	}
+
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) || ghErr.Response == nil {
+		return false
+	}
+	switch ghErr.Response.StatusCode {
+	case http.StatusConflict, http.StatusUnprocessableEntity:
+		return strings.Contains(strings.ToLower(ghErr.Error()), "submitted too quickly")
+	}
+	return false
+}
+
+// retryOnSubmittedTooQuickly calls create until it succeeds, returns an error
+// other than the creation being submitted too quickly, or the retries are
+// exhausted. Retries are delayed with exponential backoff and jitter so that
+// parallel creations do not collide again.
+func retryOnSubmittedTooQuickly(ctx context.Context, description string, create func() error) error {
+	err := create()
+	for retry := 0; retry < submittedTooQuicklyMaxRetries && isSubmittedTooQuicklyError(err); retry++ {
+		delay := submittedTooQuicklyBaseDelay << retry
+		delay += rand.N(submittedTooQuicklyBaseDelay + 1)
+
+		var abuseErr *github.AbuseRateLimitError
+		if errors.As(err, &abuseErr) && abuseErr.GetRetryAfter() > delay {
+			delay = abuseErr.GetRetryAfter()
+		}
+
+		log.Printf("[DEBUG] Creation of %s was submitted too quickly, retrying in %s", description, delay)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		err = create()
+	}
+	return err
+}
+
+// planRefreshedDrift plans an update of a resource whose refresh reports in
+// the computed attribute drift what its apply has to reconcile, e.g. pending
+// invitations or open alerts. When the last refresh found any, drift and the
+// other attributes recomputed by the apply are marked unknown, so that the
+// plan shows an update. Resources being created are reconciled anyway.
+func planRefreshedDrift(diff *schema.ResourceDiff, drift string, recomputed ...string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	found := false
+	switch v := diff.Get(drift).(type) {
+	case []any:
+		found = len(v) > 0
+	case *schema.Set:
+		found = v.Len() > 0
+	case bool:
+		found = v
+	}
+	if !found {
+		return nil
+	}
+
+	for _, key := range append(recomputed, drift) {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
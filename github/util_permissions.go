@@ -5,8 +5,16 @@ const (
 	pushPermission  string = "push"
 	writePermission string = "write"
 	readPermission  string = "read"
+
+	triagePermission   string = "triage"
+	maintainPermission string = "maintain"
+	adminPermission    string = "admin"
 )
 
+// basePermissions are the five base permissions on repositories, from the
+// highest to the lowest.
+var basePermissions = []string{adminPermission, maintainPermission, pushPermission, triagePermission, pullPermission}
+
 func getPermission(permission string) string {
 	// Permissions for some GitHub API routes are expressed as "read",
 	// "write", and "admin"; in other places, they are expressed as "pull",
@@ -20,3 +28,29 @@ func getPermission(permission string) string {
 		return permission
 	}
 }
+
+// getBasePermission returns the highest base permission of the permissions
+// of a collaborator or team on a repository, e.g. "maintain" for a custom role
+// based on it, or the empty string if there is none.
+func getBasePermission(permissions map[string]bool) string {
+	for _, permission := range basePermissions {
+		if permissions[permission] {
+			return permission
+		}
+	}
+	return ""
+}
+
+// getRoleName returns the name of the role of a permission, which is the
+// permission itself except for "pull" and "push", the "read" and "write"
+// roles. Custom roles are returned as is.
+func getRoleName(permission string) string {
+	switch permission {
+	case pullPermission:
+		return readPermission
+	case pushPermission:
+		return writePermission
+	default:
+		return permission
+	}
+}
@@ -1,10 +1,21 @@
 package github
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 	"unicode"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestAccValidateTeamIDFunc(t *testing.T) {
@@ -157,3 +168,195 @@ func TestAccGithubUtilValidateSecretName(t *testing.T) {
 		}
 	}
 }
+
+func TestAccGithubUtilValidateSecretAndVariableValues(t *testing.T) {
+	encrypt := func(size int) string {
+		return base64.StdEncoding.EncodeToString(make([]byte, size+box.AnonymousOverhead))
+	}
+
+	cases := []struct {
+		Name     string
+		Validate schema.SchemaValidateDiagFunc
+		Value    string
+		Error    bool
+	}{
+		{
+			Name:     "secret within limit",
+			Validate: validateSecretValueFunc,
+			Value:    strings.Repeat("s", maxSecretValueSize),
+		},
+		{
+			Name:     "secret over limit",
+			Validate: validateSecretValueFunc,
+			Value:    strings.Repeat("s", maxSecretValueSize+1),
+			Error:    true,
+		},
+		{
+			Name:     "encrypted secret within limit",
+			Validate: validateEncryptedSecretValueFunc,
+			Value:    encrypt(maxSecretValueSize),
+		},
+		{
+			Name:     "encrypted secret over limit",
+			Validate: validateEncryptedSecretValueFunc,
+			Value:    encrypt(maxSecretValueSize + 1),
+			Error:    true,
+		},
+		{
+			Name:     "encrypted secret not base64",
+			Validate: validateEncryptedSecretValueFunc,
+			Value:    "not base64!",
+			Error:    true,
+		},
+		{
+			Name:     "variable within limit",
+			Validate: validateVariableValueFunc,
+			Value:    strings.Repeat("v", maxVariableValueSize),
+		},
+		{
+			Name:     "variable over limit",
+			Validate: validateVariableValueFunc,
+			Value:    strings.Repeat("v", maxVariableValueSize+1),
+			Error:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		diags := tc.Validate(tc.Value, cty.Path{cty.GetAttrStep{Name: ""}})
+
+		if tc.Error != (len(diags) != 0) {
+			if tc.Error {
+				t.Fatalf("expected error, got none (%s)", tc.Name)
+			} else {
+				t.Fatalf("unexpected error(s): %v (%s)", diags, tc.Name)
+			}
+		}
+	}
+}
+
+func TestAccGithubUtilRetryOnSubmittedTooQuickly(t *testing.T) {
+	baseDelay := submittedTooQuicklyBaseDelay
+	submittedTooQuicklyBaseDelay = time.Millisecond
+	defer func() { submittedTooQuicklyBaseDelay = baseDelay }()
+
+	tooQuickly := &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusConflict},
+		Message:  "Repository creation failed.",
+		Errors: []github.Error{
+			{Resource: "Repository", Code: "custom", Message: "was submitted too quickly"},
+		},
+	}
+	nameTaken := &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "Repository creation failed.",
+		Errors: []github.Error{
+			{Resource: "Repository", Code: "custom", Field: "name", Message: "name already exists on this account"},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		errs          []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "succeeds without retrying",
+			errs:          []error{nil},
+			expectedCalls: 1,
+		},
+		{
+			name:          "retries until the creation succeeds",
+			errs:          []error{tooQuickly, tooQuickly, nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "does not retry other errors",
+			errs:          []error{nameTaken},
+			expectedCalls: 1,
+			expectedErr:   nameTaken,
+		},
+		{
+			name:          "gives up after the maximum number of retries",
+			errs:          []error{tooQuickly, tooQuickly, tooQuickly, tooQuickly, tooQuickly, tooQuickly, nil},
+			expectedCalls: submittedTooQuicklyMaxRetries + 1,
+			expectedErr:   tooQuickly,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			err := retryOnSubmittedTooQuickly(context.Background(), "repository test", func() error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+
+			if err != tc.expectedErr {
+				t.Fatalf("Expected error %v, got: %v", tc.expectedErr, err)
+			}
+			if calls != tc.expectedCalls {
+				t.Fatalf("Expected %d calls, got: %d", tc.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestGithubUtilCheckOrganization(t *testing.T) {
+	if err := checkOrganization(&Owner{name: "acme", IsOrganization: true}); err != nil {
+		t.Fatalf("expected no error for an organization, got %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepository().Schema, map[string]any{
+		"name":       "app",
+		"visibility": "internal",
+	})
+	err := checkInternalVisibility(d, &Owner{name: "octocat"})
+	var orgErr *organizationRequiredError
+	if !errors.As(err, &orgErr) {
+		t.Fatalf("expected an organizationRequiredError, got %v", err)
+	}
+	if orgErr.owner != "octocat" {
+		t.Errorf("expected the owner to be octocat, got %s", orgErr.owner)
+	}
+}
+
+func TestGithubUtilPlanRefreshedDrift(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":    {Type: schema.TypeString, Optional: true},
+			"pending": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"applied": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+			return planRefreshedDrift(diff, "pending", "applied")
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{"name": "test"})
+
+	for _, tc := range []struct {
+		pending  []string
+		expected bool
+	}{
+		{pending: nil, expected: false},
+		{pending: []string{"one"}, expected: true},
+	} {
+		attributes := map[string]string{"id": "test", "name": "test", "pending.#": "0", "applied.#": "0"}
+		for i, v := range tc.pending {
+			attributes["pending.#"] = strconv.Itoa(len(tc.pending))
+			attributes["pending."+strconv.Itoa(i)] = v
+		}
+		state := &terraform.InstanceState{ID: "test", Attributes: attributes}
+
+		diff, err := r.SimpleDiff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		planned := diff != nil && diff.Attributes["pending.#"] != nil && diff.Attributes["pending.#"].NewComputed &&
+			diff.Attributes["applied.#"] != nil && diff.Attributes["applied.#"].NewComputed
+		if planned != tc.expected {
+			t.Errorf("expected an update to be planned for %v: %t, got %v", tc.pending, tc.expected, diff)
+		}
+	}
+}
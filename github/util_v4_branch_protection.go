@@ -2,8 +2,10 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -121,7 +123,39 @@ type BranchProtectionResourceData struct {
 	LockBranch                     bool
 }
 
-func branchProtectionResourceData(d *schema.ResourceData, meta any) (BranchProtectionResourceData, error) {
+// branchProtectionGetter reads the settings of a branch protection rule, from
+// a github_branch_protection resource or a rule of github_branch_protections.
+type branchProtectionGetter interface {
+	GetOk(key string) (any, bool)
+}
+
+// branchProtectionRuleMap is a rule of github_branch_protections, whose
+// settings are read like those of a github_branch_protection resource.
+type branchProtectionRuleMap map[string]any
+
+// GetOk returns the value of a setting and whether it is set to a non-zero
+// value, like schema.ResourceData.GetOk.
+func (m branchProtectionRuleMap) GetOk(key string) (any, bool) {
+	v, ok := m[key]
+	if !ok || v == nil {
+		return v, false
+	}
+	switch value := v.(type) {
+	case bool:
+		return value, value
+	case int:
+		return value, value != 0
+	case string:
+		return value, value != ""
+	case []any:
+		return value, len(value) > 0
+	case *schema.Set:
+		return value, value.Len() > 0
+	}
+	return v, true
+}
+
+func branchProtectionResourceData(d branchProtectionGetter, meta any) (BranchProtectionResourceData, error) {
 	data := BranchProtectionResourceData{}
 
 	if v, ok := d.GetOk(REPOSITORY_ID); ok {
@@ -283,7 +317,7 @@ func branchProtectionResourceData(d *schema.ResourceData, meta any) (BranchProte
 	return data, nil
 }
 
-func branchProtectionResourceDataActors(d *schema.ResourceData, meta any) (BranchProtectionResourceData, error) {
+func branchProtectionResourceDataActors(d branchProtectionGetter, meta any) (BranchProtectionResourceData, error) {
 	data := BranchProtectionResourceData{}
 	if v, ok := d.GetOk(PROTECTION_REQUIRES_APPROVING_REVIEWS); ok {
 		vL := v.([]any)
@@ -366,6 +400,83 @@ func branchProtectionResourceDataActors(d *schema.ResourceData, meta any) (Branc
 	return data, nil
 }
 
+// resolveBranchProtectionActorIDs replaces the actor names of the settings of a
+// branch protection rule with their node IDs.
+func resolveBranchProtectionActorIDs(data *BranchProtectionResourceData, getActorIds func([]string, any) ([]string, error), meta any) error {
+	var err error
+	if data.ReviewDismissalActorIDs, err = getActorIds(data.ReviewDismissalActorIDs, meta); err != nil {
+		return fmt.Errorf("invalid %s of pattern %q: %w", PROTECTION_REVIEW_DISMISSAL_ALLOWANCES, data.Pattern, err)
+	}
+	if data.PushActorIDs, err = getActorIds(data.PushActorIDs, meta); err != nil {
+		return fmt.Errorf("invalid %s of pattern %q: %w", PROTECTION_PUSH_ALLOWANCES, data.Pattern, err)
+	}
+	if data.BypassForcePushActorIDs, err = getActorIds(data.BypassForcePushActorIDs, meta); err != nil {
+		return fmt.Errorf("invalid %s of pattern %q: %w", PROTECTION_FORCE_PUSHES_BYPASSERS, data.Pattern, err)
+	}
+	if data.BypassPullRequestActorIDs, err = getActorIds(data.BypassPullRequestActorIDs, meta); err != nil {
+		return fmt.Errorf("invalid %s of pattern %q: %w", PROTECTION_PULL_REQUESTS_BYPASSERS, data.Pattern, err)
+	}
+	return nil
+}
+
+func branchProtectionCreateInput(data BranchProtectionResourceData) githubv4.CreateBranchProtectionRuleInput {
+	return githubv4.CreateBranchProtectionRuleInput{
+		AllowsDeletions:                githubv4.NewBoolean(githubv4.Boolean(data.AllowsDeletions)),
+		AllowsForcePushes:              githubv4.NewBoolean(githubv4.Boolean(data.AllowsForcePushes)),
+		BlocksCreations:                githubv4.NewBoolean(githubv4.Boolean(data.BlocksCreations)),
+		BypassForcePushActorIDs:        githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassForcePushActorIDs)),
+		BypassPullRequestActorIDs:      githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassPullRequestActorIDs)),
+		DismissesStaleReviews:          githubv4.NewBoolean(githubv4.Boolean(data.DismissesStaleReviews)),
+		IsAdminEnforced:                githubv4.NewBoolean(githubv4.Boolean(data.IsAdminEnforced)),
+		Pattern:                        githubv4.String(data.Pattern),
+		PushActorIDs:                   githubv4NewIDSlice(githubv4IDSlice(data.PushActorIDs)),
+		RepositoryID:                   githubv4.NewID(githubv4.ID(data.RepositoryID)),
+		RequiredApprovingReviewCount:   githubv4.NewInt(githubv4.Int(data.RequiredApprovingReviewCount)),
+		RequiredStatusCheckContexts:    githubv4NewStringSlice(githubv4StringSliceEmpty(data.RequiredStatusCheckContexts)),
+		RequiresApprovingReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresApprovingReviews)),
+		RequiresCodeOwnerReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCodeOwnerReviews)),
+		RequiresCommitSignatures:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCommitSignatures)),
+		RequiresConversationResolution: githubv4.NewBoolean(githubv4.Boolean(data.RequiresConversationResolution)),
+		RequiresLinearHistory:          githubv4.NewBoolean(githubv4.Boolean(data.RequiresLinearHistory)),
+		RequiresStatusChecks:           githubv4.NewBoolean(githubv4.Boolean(data.RequiresStatusChecks)),
+		RequiresStrictStatusChecks:     githubv4.NewBoolean(githubv4.Boolean(data.RequiresStrictStatusChecks)),
+		RestrictsPushes:                githubv4.NewBoolean(githubv4.Boolean(data.RestrictsPushes)),
+		RestrictsReviewDismissals:      githubv4.NewBoolean(githubv4.Boolean(data.RestrictsReviewDismissals)),
+		ReviewDismissalActorIDs:        githubv4NewIDSlice(githubv4IDSlice(data.ReviewDismissalActorIDs)),
+		LockBranch:                     githubv4.NewBoolean(githubv4.Boolean(data.LockBranch)),
+		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
+	}
+}
+
+func branchProtectionUpdateInput(id string, data BranchProtectionResourceData) githubv4.UpdateBranchProtectionRuleInput {
+	return githubv4.UpdateBranchProtectionRuleInput{
+		BranchProtectionRuleID:         id,
+		AllowsDeletions:                githubv4.NewBoolean(githubv4.Boolean(data.AllowsDeletions)),
+		AllowsForcePushes:              githubv4.NewBoolean(githubv4.Boolean(data.AllowsForcePushes)),
+		BlocksCreations:                githubv4.NewBoolean(githubv4.Boolean(data.BlocksCreations)),
+		BypassForcePushActorIDs:        githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassForcePushActorIDs)),
+		BypassPullRequestActorIDs:      githubv4NewIDSlice(githubv4IDSliceEmpty(data.BypassPullRequestActorIDs)),
+		DismissesStaleReviews:          githubv4.NewBoolean(githubv4.Boolean(data.DismissesStaleReviews)),
+		IsAdminEnforced:                githubv4.NewBoolean(githubv4.Boolean(data.IsAdminEnforced)),
+		Pattern:                        githubv4.NewString(githubv4.String(data.Pattern)),
+		PushActorIDs:                   githubv4NewIDSlice(githubv4IDSlice(data.PushActorIDs)),
+		RequiredApprovingReviewCount:   githubv4.NewInt(githubv4.Int(data.RequiredApprovingReviewCount)),
+		RequiredStatusCheckContexts:    githubv4NewStringSlice(githubv4StringSliceEmpty(data.RequiredStatusCheckContexts)),
+		RequiresApprovingReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresApprovingReviews)),
+		RequiresCodeOwnerReviews:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCodeOwnerReviews)),
+		RequiresCommitSignatures:       githubv4.NewBoolean(githubv4.Boolean(data.RequiresCommitSignatures)),
+		RequiresConversationResolution: githubv4.NewBoolean(githubv4.Boolean(data.RequiresConversationResolution)),
+		RequiresLinearHistory:          githubv4.NewBoolean(githubv4.Boolean(data.RequiresLinearHistory)),
+		RequiresStatusChecks:           githubv4.NewBoolean(githubv4.Boolean(data.RequiresStatusChecks)),
+		RequiresStrictStatusChecks:     githubv4.NewBoolean(githubv4.Boolean(data.RequiresStrictStatusChecks)),
+		RestrictsPushes:                githubv4.NewBoolean(githubv4.Boolean(data.RestrictsPushes)),
+		RestrictsReviewDismissals:      githubv4.NewBoolean(githubv4.Boolean(data.RestrictsReviewDismissals)),
+		ReviewDismissalActorIDs:        githubv4NewIDSlice(githubv4IDSlice(data.ReviewDismissalActorIDs)),
+		LockBranch:                     githubv4.NewBoolean(githubv4.Boolean(data.LockBranch)),
+		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
+	}
+}
+
 func setDismissalActorIDs(actors []DismissalActorTypes, data BranchProtectionResourceData, meta any) []string {
 	dismissalActors := make([]string, 0, len(actors))
 	orgName := meta.(*Owner).name
@@ -627,7 +738,9 @@ func getActorIds(data []string, meta any) ([]string, error) {
 // node id of the user or team it is referring to. Team slugs must be provided
 // with the organization name as prefix (Ex.: exampleorg/exampleteam). Usernames
 // must be provided with the "/" prefix otherwise getNodeIDv4 assumes that
-// the provided string is a node ID.
+// the provided string is the node ID of a user, team or app, which is checked.
+// Errors name the actor and how it was resolved, as those of the mutations do
+// not tell which actor is invalid.
 func getNodeIDv4(userOrSlug string, meta any) (string, error) {
 	orgName := meta.(*Owner).name
 	ctx := context.Background()
@@ -636,7 +749,7 @@ func getNodeIDv4(userOrSlug string, meta any) (string, error) {
 	if strings.HasPrefix(userOrSlug, orgName+"/") {
 		var queryTeam struct {
 			Organization struct {
-				Team struct {
+				Team *struct {
 					ID string
 				} `graphql:"team(slug: $slug)"`
 			} `graphql:"organization(login: $organization)"`
@@ -649,14 +762,17 @@ func getNodeIDv4(userOrSlug string, meta any) (string, error) {
 
 		err := client.Query(ctx, &queryTeam, variablesTeam)
 		if err != nil {
-			return "", err
+			return "", fmt.Errorf("could not resolve actor %q as team %q of organization %s: %w", userOrSlug, teamName, orgName, err)
+		}
+		if queryTeam.Organization.Team == nil {
+			return "", fmt.Errorf("could not resolve actor %q as team %q of organization %s: team not found", userOrSlug, teamName, orgName)
 		}
 		log.Printf("[DEBUG] Retrieved node ID for team %s. ID is %s", userOrSlug, queryTeam.Organization.Team.ID)
 		return queryTeam.Organization.Team.ID, nil
 	} else if strings.HasPrefix(userOrSlug, "/") {
 		// The "/" prefix indicates a username
 		var queryUser struct {
-			User struct {
+			User *struct {
 				ID string
 			} `graphql:"user(login: $user)"`
 		}
@@ -667,12 +783,104 @@ func getNodeIDv4(userOrSlug string, meta any) (string, error) {
 
 		err := client.Query(ctx, &queryUser, variablesUser)
 		if err != nil {
-			return "", err
+			return "", fmt.Errorf("could not resolve actor %q as user %q: %w", userOrSlug, userName, err)
+		}
+		if queryUser.User == nil {
+			return "", fmt.Errorf("could not resolve actor %q as user %q: user not found", userOrSlug, userName)
 		}
 		log.Printf("[DEBUG] Retrieved node ID for user %s. ID is %s", userOrSlug, queryUser.User.ID)
 		return queryUser.User.ID, nil
 	} else {
 		// If userOrSlug does not contain the team or username prefix, assume it is a node ID
+		var queryNode struct {
+			Node *struct {
+				Typename string `graphql:"__typename"`
+			} `graphql:"node(id: $id)"`
+		}
+		variablesNode := map[string]any{
+			"id": githubv4.ID(userOrSlug),
+		}
+
+		hint := fmt.Sprintf("users must be prefixed with '/' and teams with '%s/'", orgName)
+		err := client.Query(ctx, &queryNode, variablesNode)
+		if err != nil {
+			return "", fmt.Errorf("could not resolve actor %q as node ID, %s: %w", userOrSlug, hint, err)
+		}
+		if queryNode.Node == nil {
+			return "", fmt.Errorf("could not resolve actor %q as node ID, %s: node not found", userOrSlug, hint)
+		}
+		switch queryNode.Node.Typename {
+		case "App", "Team", "User":
+		default:
+			return "", fmt.Errorf("could not resolve actor %q as node ID: node is a %s, not an app, team or user", userOrSlug, queryNode.Node.Typename)
+		}
 		return userOrSlug, nil
 	}
 }
+
+// branchProtectionActorNodeID matches the node IDs of users, teams and apps,
+// e.g. U_kgDOABCDEF, which cannot be mistaken for usernames or team slugs as
+// those never contain underscores.
+var branchProtectionActorNodeID = regexp.MustCompile(`^[A-Z]+_[A-Za-z0-9_-]+$`)
+
+// branchProtectionActorLegacyNodeID matches the decoded legacy node IDs of
+// users, teams and apps, e.g. 04:User1.
+var branchProtectionActorLegacyNodeID = regexp.MustCompile(`^\d+:[A-Za-z]+\d+$`)
+
+// validateBranchProtectionActor checks the form of an actor as resolved by
+// getNodeIDv4, so that an actor such as a username without its '/' prefix
+// fails the plan rather than the apply.
+func validateBranchProtectionActor(v any, k string) ([]string, []error) {
+	actor := v.(string)
+	if strings.HasPrefix(actor, "/") {
+		if name := strings.TrimPrefix(actor, "/"); name == "" || strings.Contains(name, "/") {
+			return nil, []error{fmt.Errorf("%s: %q is not a valid user, expected a username prefixed with '/'", k, actor)}
+		}
+		return nil, nil
+	}
+	if branchProtectionActorNodeID.MatchString(actor) {
+		return nil, nil
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(actor); err == nil && branchProtectionActorLegacyNodeID.Match(decoded) {
+		return nil, nil
+	}
+	// The organization prefixing a team slug is checked when resolving it.
+	if org, slug, ok := strings.Cut(actor, "/"); ok && org != "" && slug != "" && !strings.Contains(slug, "/") {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%s: %q is not a valid actor, expected a username prefixed with '/', "+
+		"a team slug prefixed with the organization name and '/', or the node ID of a user, team or app", k, actor)}
+}
+
+// branchProtectionRequiresMergeQueue returns whether the branch of a branch
+// protection pattern has a merge queue. Merge queues are per branch, patterns
+// with wildcards never have one. Instances whose API does not know merge
+// queues report none.
+func branchProtectionRequiresMergeQueue(ctx context.Context, meta any, repoID githubv4.ID, pattern string) (bool, error) {
+	if strings.ContainsAny(pattern, "*?[") {
+		return false, nil
+	}
+
+	var query struct {
+		Node struct {
+			Repository struct {
+				MergeQueue *struct {
+					ID githubv4.ID
+				} `graphql:"mergeQueue(branch: $branch)"`
+			} `graphql:"... on Repository"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id":     repoID,
+		"branch": githubv4.String(pattern),
+	}
+
+	if err := meta.(*Owner).v4client.Query(ctx, &query, variables); err != nil {
+		if strings.Contains(err.Error(), "Field 'mergeQueue' doesn't exist") {
+			log.Printf("[DEBUG] Merge queues are not supported, branch %s of repository %s has none", pattern, repoID)
+			return false, nil
+		}
+		return false, fmt.Errorf("error reading the merge queue of branch %s of repository %s: %w", pattern, repoID, err)
+	}
+	return query.Node.Repository.MergeQueue != nil, nil
+}
@@ -18,6 +18,7 @@ const (
 	PROTECTION_REQUIRES_COMMIT_SIGNATURES       = "require_signed_commits"
 	PROTECTION_REQUIRES_CONVERSATION_RESOLUTION = "require_conversation_resolution"
 	PROTECTION_REQUIRES_LINEAR_HISTORY          = "required_linear_history"
+	PROTECTION_REQUIRES_MERGE_QUEUE             = "requires_merge_queue"
 	PROTECTION_REQUIRES_STATUS_CHECKS           = "required_status_checks"
 	PROTECTION_REQUIRES_STRICT_STATUS_CHECKS    = "strict"
 	PROTECTION_REQUIRE_LAST_PUSH_APPROVAL       = "require_last_push_approval"
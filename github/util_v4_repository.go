@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"log"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -46,6 +50,27 @@ func getRepositoryID(name string, meta any) (githubv4.ID, error) {
 	return query.Repository.ID, nil
 }
 
+// waitForRepositoryIDVisible is the counterpart of waitForRepositoryVisible
+// for a repository given by node ID or name, as resolved by getRepositoryID.
+func waitForRepositoryIDVisible(ctx context.Context, meta any, name string) error {
+	deadline, ok := repositoryVisibilityDeadline(meta, name)
+	if !ok {
+		return nil
+	}
+
+	return retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
+		_, err := getRepositoryID(name, meta)
+		if err != nil {
+			if strings.Contains(err.Error(), "Could not resolve") {
+				log.Printf("[DEBUG] Waiting for repository %s to be visible", name)
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 func repositoryNodeIDExists(name string, meta any) (bool, error) {
 
 	// API check if node ID exists
@@ -1,6 +1,13 @@
 package github
 
-import "github.com/shurcooL/githubv4"
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/shurcooL/githubv4"
+)
 
 type TeamsQuery struct {
 	Organization struct {
@@ -33,3 +40,188 @@ type TeamsQuery struct {
 		} `graphql:"teams(first:$first, after:$cursor, rootTeamsOnly:$rootTeamsOnly)"`
 	} `graphql:"organization(login:$login)"`
 }
+
+type teamRepositoriesQuery struct {
+	Organization struct {
+		Team struct {
+			Repositories struct {
+				Edges []struct {
+					Permission githubv4.String
+					Node       struct {
+						Name githubv4.String
+					}
+				}
+				PageInfo PageInfo
+			} `graphql:"repositories(first:$first, after:$cursor)"`
+		} `graphql:"team(slug:$slug)"`
+	} `graphql:"organization(login:$login)"`
+}
+
+type teamMembersQuery struct {
+	Organization struct {
+		Team struct {
+			Members struct {
+				Edges []struct {
+					Role githubv4.String
+					Node struct {
+						Login githubv4.String
+					}
+				}
+				PageInfo PageInfo
+			} `graphql:"members(first:$first, after:$cursor, membership:IMMEDIATE)"`
+		} `graphql:"team(slug:$slug)"`
+	} `graphql:"organization(login:$login)"`
+}
+
+// teamCache holds the repositories and members of the teams of the
+// organization, fetched once per team and provider instance, so that
+// refreshing many github_team_repository and github_team_membership resources
+// does not cost a REST request each.
+type teamCache struct {
+	mu    sync.Mutex
+	teams map[int64]*teamCacheEntry
+}
+
+type teamCacheEntry struct {
+	once sync.Once
+	// repositories maps the lowercase names of the repositories of the team
+	// to the permission of the team, e.g. 'push'.
+	repositories map[string]string
+	// members maps the lowercase logins of the members of the team to their
+	// role, 'member' or 'maintainer'.
+	members map[string]string
+	err     error
+}
+
+func (o *Owner) teamCacheEntry(teamId int64) *teamCacheEntry {
+	o.teamCache.mu.Lock()
+	if o.teamCache.teams == nil {
+		o.teamCache.teams = make(map[int64]*teamCacheEntry)
+	}
+	entry, ok := o.teamCache.teams[teamId]
+	if !ok {
+		entry = &teamCacheEntry{}
+		o.teamCache.teams[teamId] = entry
+	}
+	o.teamCache.mu.Unlock()
+
+	// The team is fetched without holding the lock, concurrent reads of the
+	// same team wait for the first one.
+	entry.once.Do(func() {
+		repositories, members, err := o.fetchTeam(teamId)
+		if err != nil {
+			log.Printf("[WARN] Unable to cache the repositories and members of team %d: %s", teamId, err)
+		}
+
+		o.teamCache.mu.Lock()
+		entry.repositories, entry.members, entry.err = repositories, members, err
+		o.teamCache.mu.Unlock()
+	})
+
+	return entry
+}
+
+// cachedTeamRepositoryPermission returns the permission of a team on a
+// repository from the cache, and whether the repository is a cached
+// repository of the team.
+func (o *Owner) cachedTeamRepositoryPermission(teamId int64, repoName string) (string, bool) {
+	entry := o.teamCacheEntry(teamId)
+	if entry.err != nil {
+		return "", false
+	}
+
+	o.teamCache.mu.Lock()
+	defer o.teamCache.mu.Unlock()
+	permission, ok := entry.repositories[strings.ToLower(repoName)]
+	return permission, ok
+}
+
+// cachedTeamMemberRole returns the role of a member of a team from the cache,
+// and whether the user is a cached member of the team. Pending members are
+// not cached.
+func (o *Owner) cachedTeamMemberRole(teamId int64, username string) (string, bool) {
+	entry := o.teamCacheEntry(teamId)
+	if entry.err != nil {
+		return "", false
+	}
+
+	o.teamCache.mu.Lock()
+	defer o.teamCache.mu.Unlock()
+	role, ok := entry.members[strings.ToLower(username)]
+	return role, ok
+}
+
+// forgetCachedTeamRepository drops a repository of a team from the cache once
+// it was changed, so that it is read from the API again.
+func (o *Owner) forgetCachedTeamRepository(teamId int64, repoName string) {
+	o.teamCache.mu.Lock()
+	defer o.teamCache.mu.Unlock()
+
+	if entry, ok := o.teamCache.teams[teamId]; ok {
+		delete(entry.repositories, strings.ToLower(repoName))
+	}
+}
+
+// forgetCachedTeamMember drops a member of a team from the cache once it was
+// changed, so that it is read from the API again.
+func (o *Owner) forgetCachedTeamMember(teamId int64, username string) {
+	o.teamCache.mu.Lock()
+	defer o.teamCache.mu.Unlock()
+
+	if entry, ok := o.teamCache.teams[teamId]; ok {
+		delete(entry.members, strings.ToLower(username))
+	}
+}
+
+func (o *Owner) fetchTeam(teamId int64) (map[string]string, map[string]string, error) {
+	ctx := o.StopContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	team, _, err := o.v3client.Teams.GetTeamByID(ctx, o.id, teamId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variables := map[string]any{
+		"login":  githubv4.String(o.name),
+		"slug":   githubv4.String(team.GetSlug()),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	repositories := make(map[string]string)
+	for {
+		var query teamRepositoriesQuery
+		if err := o.v4client.Query(ctx, &query, variables); err != nil {
+			return nil, nil, err
+		}
+		for _, edge := range query.Organization.Team.Repositories.Edges {
+			repositories[strings.ToLower(string(edge.Node.Name))] = getPermission(strings.ToLower(string(edge.Permission)))
+		}
+		if !query.Organization.Team.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.Team.Repositories.PageInfo.EndCursor)
+	}
+
+	variables["cursor"] = (*githubv4.String)(nil)
+	members := make(map[string]string)
+	for {
+		var query teamMembersQuery
+		if err := o.v4client.Query(ctx, &query, variables); err != nil {
+			return nil, nil, err
+		}
+		for _, edge := range query.Organization.Team.Members.Edges {
+			members[strings.ToLower(string(edge.Node.Login))] = strings.ToLower(string(edge.Role))
+		}
+		if !query.Organization.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.Team.Members.PageInfo.EndCursor)
+	}
+
+	log.Printf("[DEBUG] Cached %d repositories and %d members of team %s", len(repositories), len(members), team.GetSlug())
+	return repositories, members, nil
+}
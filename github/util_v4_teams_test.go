@@ -0,0 +1,92 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestTeamCache(t *testing.T) {
+	var teamRequests, graphqlRequests int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/organizations/1/team/2", func(w http.ResponseWriter, req *http.Request) {
+		teamRequests++
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 2, "slug": "tf-acc-team"}`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, req *http.Request) {
+		graphqlRequests++
+		body := mustRead(req.Body)
+		if !strings.Contains(body, `"slug":"tf-acc-team"`) {
+			t.Fatalf("Unexpected team in query %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body, "repositories(") && !strings.Contains(body, `"cursor":"page2"`):
+			mustWrite(w, `{"data": {"organization": {"team": {"repositories": {
+				"edges": [{"permission": "WRITE", "node": {"name": "Repo-A"}}],
+				"pageInfo": {"endCursor": "page2", "hasNextPage": true}}}}}}`)
+		case strings.Contains(body, "repositories("):
+			mustWrite(w, `{"data": {"organization": {"team": {"repositories": {
+				"edges": [{"permission": "READ", "node": {"name": "repo-b"}}, {"permission": "MAINTAIN", "node": {"name": "repo-c"}}],
+				"pageInfo": {"endCursor": "", "hasNextPage": false}}}}}}`)
+		case strings.Contains(body, "members("):
+			mustWrite(w, `{"data": {"organization": {"team": {"members": {
+				"edges": [{"role": "MAINTAINER", "node": {"login": "Octocat"}}, {"role": "MEMBER", "node": {"login": "hubot"}}],
+				"pageInfo": {"endCursor": "", "hasNextPage": false}}}}}}`)
+		default:
+			t.Fatalf("Unknown GraphQL Call %s", body)
+		}
+	})
+
+	httpClient := &http.Client{Transport: localRoundTripper{handler: mux}}
+	meta := &Owner{
+		v3client: github.NewClient(httpClient),
+		v4client: githubv4.NewClient(httpClient),
+		name:     "tf-acc-org",
+		id:       1,
+	}
+
+	repositories := map[string]string{
+		"repo-a": "push",
+		"REPO-B": "pull",
+		"repo-c": "maintain",
+	}
+	for repoName, want := range repositories {
+		got, ok := meta.cachedTeamRepositoryPermission(2, repoName)
+		if !ok || got != want {
+			t.Errorf("Permission on %s is %q (%t), expected %q", repoName, got, ok, want)
+		}
+	}
+	if _, ok := meta.cachedTeamRepositoryPermission(2, "repo-d"); ok {
+		t.Error("Repository repo-d is not expected to be cached")
+	}
+
+	members := map[string]string{
+		"octocat": "maintainer",
+		"hubot":   "member",
+	}
+	for username, want := range members {
+		got, ok := meta.cachedTeamMemberRole(2, username)
+		if !ok || got != want {
+			t.Errorf("Role of %s is %q (%t), expected %q", username, got, ok, want)
+		}
+	}
+
+	meta.forgetCachedTeamRepository(2, "Repo-A")
+	if _, ok := meta.cachedTeamRepositoryPermission(2, "repo-a"); ok {
+		t.Error("Repository repo-a is not expected to be cached once forgotten")
+	}
+	meta.forgetCachedTeamMember(2, "hubot")
+	if _, ok := meta.cachedTeamMemberRole(2, "hubot"); ok {
+		t.Error("Member hubot is not expected to be cached once forgotten")
+	}
+
+	if teamRequests != 1 || graphqlRequests != 3 {
+		t.Errorf("The team was fetched with %d REST and %d GraphQL requests, expected 1 and 3", teamRequests, graphqlRequests)
+	}
+}
@@ -8,4 +8,7 @@ import (
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: github.Provider})
+
+	// Terraform stops the provider once it is done with it
+	github.FlushMetrics()
 }